@@ -0,0 +1,494 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/jackc/pgx/v5"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/storage"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+const (
+	maxAttachmentSize = 10 << 20
+	attachmentQuota   = 100 << 20 // NOTE: Per user, across all job applications
+)
+
+var errAttachmentQuotaExceeded = fmt.Errorf("attachments exceed the %d MB storage quota", attachmentQuota>>20)
+
+// newStorageKey namespaces objects by user and never reuses a key, so that a re-uploaded file can't overwrite an earlier version
+func newStorageKey(userId string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return userId + "/" + hex.EncodeToString(b), nil
+}
+
+// createAttachment saves the attachment unless it would take the user over their quota.
+// The user row is locked for the duration of the transaction, so that concurrent uploads can't both squeeze under the quota.
+func (h *Handler) createAttachment(params db.CreateAttachmentParams) (db.CreateAttachmentRow, error) {
+	tx, err := h.conn.Begin(h.ctx)
+	if err != nil {
+		return db.CreateAttachmentRow{}, err
+	}
+	defer tx.Rollback(h.ctx)
+
+	qtx := h.queries.WithTx(tx)
+
+	if err := qtx.LockUser(h.ctx, params.UserID); err != nil {
+		return db.CreateAttachmentRow{}, err
+	}
+
+	usage, err := qtx.GetAttachmentsUsage(h.ctx, params.UserID)
+	if err != nil {
+		return db.CreateAttachmentRow{}, err
+	}
+	if usage+params.Size > attachmentQuota {
+		return db.CreateAttachmentRow{}, errAttachmentQuotaExceeded
+	}
+
+	attachment, err := qtx.CreateAttachment(h.ctx, params)
+	if err != nil {
+		return db.CreateAttachmentRow{}, err
+	}
+
+	if err := tx.Commit(h.ctx); err != nil {
+		return db.CreateAttachmentRow{}, err
+	}
+
+	return attachment, nil
+}
+
+// deleteBlobs removes the contents of attachments whose rows are already gone. Failures only leave orphaned objects behind, so they're not reported to the client.
+func (h *Handler) deleteBlobs(keys []string) {
+	for _, key := range keys {
+		h.storage.Delete(h.ctx, key)
+	}
+}
+
+// Attachments godoc
+//
+//	@Summary		Get attachments
+//	@Description	Lists files attached to job applications, newest first. Filtering by checksum shows every application a particular file, e.g. a specific CV version, was sent with.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Attachment
+//	@Accept			json
+//	@Produce		json
+//	@Param			job_application_id	query		string	false	"Job application uuid"
+//	@Param			kind				query		string	false	"Attachment kind"	Enums(CV, COVER_LETTER, OTHER)
+//	@Param			checksum			query		string	false	"SHA-256 checksum of the file contents"
+//	@Failure		400					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.AttachmentsResBody
+//	@Router			/attachments [get]
+func (h *Handler) Attachments(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var queryParams models.AttachmentsQueryParams
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	attachments, err := h.queries.GetAttachments(h.ctx, models.NewGetAttachmentsParams(uuid, queryParams))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewAttachmentsResBody(attachments)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// JobApplicationAttachments godoc
+//
+//	@Summary		Get job application attachments
+//	@Description	Lists files attached to a specific job application, newest first
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Attachment
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			kind				query		string	false	"Attachment kind"	Enums(CV, COVER_LETTER, OTHER)
+//	@Failure		400					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.AttachmentsResBody
+//	@Router			/job-applications/{jobApplicationId}/attachments [get]
+func (h *Handler) JobApplicationAttachments(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var queryParams models.AttachmentsQueryParams
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	queryParams.JobApplicationID = c.Param("jobApplicationId")
+
+	if _, err := utils.ToUUID(queryParams.JobApplicationID); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	attachments, err := h.queries.GetAttachments(h.ctx, models.NewGetAttachmentsParams(uuid, queryParams))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewAttachmentsResBody(attachments)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// Attachment godoc
+//
+//	@Summary		Download an attachment
+//	@Description	Returns the contents of an attached file exactly as it was uploaded
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Attachment
+//	@Accept			json
+//	@Produce		octet-stream
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			attachmentId		path		string	true	"Attachment uuid"
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{file}		file
+//	@Router			/job-applications/{jobApplicationId}/attachments/{attachmentId} [get]
+func (h *Handler) Attachment(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	attachmentId, err := utils.ToUUID(c.Param("attachmentId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	attachment, err := h.queries.GetAttachment(h.ctx, db.GetAttachmentParams{
+		ID:               attachmentId,
+		JobApplicationID: jobApplicationId,
+		UserID:           uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	file, err := h.storage.Get(h.ctx, attachment.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename})
+	if disposition == "" {
+		disposition = "attachment"
+	}
+
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, file, map[string]string{
+		"Content-Disposition":    disposition,
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// CreateAttachment godoc
+//
+//	@Summary		Upload an attachment
+//	@Description	Attaches a file, e.g. the CV or cover letter sent with the application, to a job application.
+//	@Description	The type is detected from the contents, only PDF, Word (.doc, .docx), OpenDocument (.odt), plain text, PNG and JPEG files are accepted.
+//	@Description	Files are limited to 10 MB each and 100 MB in total per user.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Attachment
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			file				formData	file	true	"File to attach"
+//	@Param			kind				formData	string	false	"Attachment kind"	Enums(CV, COVER_LETTER, OTHER)	default(OTHER)
+//	@Failure		400					{object}	models.Error
+//	@Failure		404					{object}	models.Error
+//	@Failure		413					{object}	models.Error
+//	@Failure		415					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		201					{object}	models.CreateAttachmentResBody
+//	@Router			/job-applications/{jobApplicationId}/attachments [post]
+func (h *Handler) CreateAttachment(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// NOTE: Leaves room for the multipart headers and the other fields
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxAttachmentSize+1<<20)
+
+	var body models.CreateAttachmentReqBody
+
+	if err := c.ShouldBindWith(&body, binding.FormMultipart); err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{
+				"error": fmt.Sprintf("file exceeds the %d MB limit", maxAttachmentSize>>20),
+			})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if fileHeader.Size > maxAttachmentSize {
+		c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{
+			"error": fmt.Sprintf("file exceeds the %d MB limit", maxAttachmentSize>>20),
+		})
+		return
+	}
+
+	if fileHeader.Size == 0 {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "file is empty",
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	// NOTE: http.DetectContentType considers at most the first 512 bytes
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contentType, err := models.NewAttachmentContentType(head[:n], fileHeader.Filename)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnsupportedMediaType, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	key, err := newStorageKey(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// NOTE: The checksum is computed while the file is being stored, so that it's read only once
+	hash := sha256.New()
+
+	if err := h.storage.Put(h.ctx, key, io.TeeReader(file, hash), fileHeader.Size, contentType); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+
+	attachment, err := h.createAttachment(models.NewCreateAttachmentParams(jobApplicationId, uuid, body, fileHeader.Filename, contentType, fileHeader.Size, checksum, key))
+	if err != nil {
+		h.deleteBlobs([]string{key})
+	}
+	if err == errAttachmentQuotaExceeded {
+		c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	// NOTE: No rows are inserted when the job application doesn't belong to the user
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewCreateAttachmentResBody(attachment)
+
+	c.JSON(http.StatusCreated, resBody)
+}
+
+// DeleteAttachment godoc
+//
+//	@Summary		Delete an attachment
+//	@Description	Deletes an attached file along with its contents
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Attachment
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			attachmentId		path		string	true	"Attachment uuid"
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.DeleteAttachmentResBody
+//	@Router			/job-applications/{jobApplicationId}/attachments/{attachmentId} [delete]
+func (h *Handler) DeleteAttachment(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	attachmentId, err := utils.ToUUID(c.Param("attachmentId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	attachment, err := h.queries.DeleteAttachment(h.ctx, db.DeleteAttachmentParams{
+		ID:               attachmentId,
+		JobApplicationID: jobApplicationId,
+		UserID:           uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	h.deleteBlobs([]string{attachment.StorageKey})
+
+	resBody := models.NewDeleteAttachmentResBody(attachment)
+
+	c.JSON(http.StatusOK, resBody)
+}
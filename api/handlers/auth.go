@@ -1,16 +1,12 @@
 package handlers
 
 import (
-	"bytes"
 	"fmt"
-	"html/template"
+	"log"
 	"net/http"
-	"net/smtp"
-	"path/filepath"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jakub-szewczyk/career-compass-gin/api/models"
@@ -22,13 +18,18 @@ import (
 //
 //	@Summary		User sign up
 //	@Description	Registers a new user account with the provided details, including email, password, and other relevant information. Verification email will be sent.
+//	@Description	Retrying with the same Idempotency-Key header and email within a day returns the original response instead of registering the user again. The replayed response leaves out the tokens, so the user has to sign in.
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body		models.SignUpReqBody	true	"User sign up data"
-//	@Failure		400		{object}	models.Error
-//	@Failure		500		{object}	models.Error
-//	@Success		201		{object}	models.SignUpResBody
+//	@Param			body			body		models.SignUpReqBody	true	"User sign up data"
+//	@Param			Idempotency-Key	header		string					false	"Unique key of the request, at most 255 characters"
+//	@Failure		400				{object}	models.Error
+//	@Failure		409				{object}	models.Error
+//	@Failure		422				{object}	models.Error
+//	@Failure		500				{object}	models.Error
+//	@Success		201				{object}	models.SignUpResBody
+//	@Header			201				{string}	Idempotent-Replayed	"Set to true when the response is replayed"
 //	@Router			/sign-up [post]
 func (h *Handler) SignUp(c *gin.Context) {
 	var body models.SignUpReqBody
@@ -48,7 +49,19 @@ func (h *Handler) SignUp(c *gin.Context) {
 		return
 	}
 
-	user, err := h.queries.CreateUser(h.ctx, db.CreateUserParams{
+	tx, err := h.conn.Begin(h.ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	// NOTE: The welcome email is queued in the same transaction, so that no account is created without one
+	queries := h.queries.WithTx(tx)
+
+	user, err := queries.CreateUser(h.ctx, db.CreateUserParams{
 		Email:     body.Email,
 		FirstName: body.FirstName,
 		LastName:  body.LastName,
@@ -68,13 +81,7 @@ func (h *Handler) SignUp(c *gin.Context) {
 		return
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"uid": user.ID,
-		"sub": user.Email,
-		"exp": jwt.NewNumericDate(time.Now().Add(time.Hour * 24)),
-	})
-
-	signed, err := token.SignedString([]byte(h.env.JWTSecret))
+	session, err := queries.CreateSession(h.ctx, user.ID)
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
@@ -82,7 +89,7 @@ func (h *Handler) SignUp(c *gin.Context) {
 		return
 	}
 
-	resBody, err := models.NewSignUpResBody(user, signed)
+	signed, err := h.signAccessToken(user.ID, user.Email, session.ID)
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
@@ -90,17 +97,15 @@ func (h *Handler) SignUp(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, resBody)
-
-	// TODO: Consider using goroutines
-	tmpl, err := template.ParseFiles(filepath.Join("templates", "sign-up.html"))
+	resBody, err := models.NewSignUpResBody(user, signed, session.RefreshToken)
 	if err != nil {
-		fmt.Println("error loading template:", err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
 		return
 	}
 
-	var html bytes.Buffer
-	if err := tmpl.Execute(&html, struct {
+	if err := h.enqueueEmail(queries, user.Email, fmt.Sprintf("Welcome to Career Compass, %v!", user.FirstName), "sign-up.html", struct {
 		FirstName string
 		Link      string
 		Year      int
@@ -109,25 +114,27 @@ func (h *Handler) SignUp(c *gin.Context) {
 		Link:      h.env.EmailVerificationURL + fmt.Sprintf("?token=%v", user.VerificationToken),
 		Year:      time.Now().Year(),
 	}); err != nil {
-		fmt.Println("error rendering template:", err)
+		log.Println("error enqueueing email:", err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
 		return
 	}
 
-	mime := "MIME-version: 1.0;\nContent-Type: text/html; charset=\"UTF-8\";\n\n"
-	subject := fmt.Sprintf("Subject: Welcome to Career Compass, %v!\n", user.FirstName)
-
-	auth := smtp.PlainAuth(h.env.SMTPIdentity, h.env.SMTPUsername, h.env.SMTPPassword, h.env.SMTPHost)
-	err = smtp.SendMail(h.env.SMTPHost+":"+h.env.SMTPPORT, auth, h.env.SMTPUsername, []string{user.Email}, []byte(subject+mime+html.String()))
-	if err != nil {
-		fmt.Println("error sending email:", err)
+	if err := tx.Commit(h.ctx); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
 		return
 	}
+
+	c.JSON(http.StatusCreated, resBody)
 }
 
 // SignIn godoc
 //
 //	@Summary		User sign in
-//	@Description	Authenticates a user and returns a JWT token for session management. Valid credentials are required to access the system.
+//	@Description	Authenticates a user and returns a short-lived JWT access token along with a refresh token for session management. Valid credentials are required to access the system. When two-factor authentication is enabled, an MFA challenge token is returned instead and has to be exchanged via /sign-in/mfa.
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
@@ -136,6 +143,7 @@ func (h *Handler) SignUp(c *gin.Context) {
 //	@Failure		401		{object}	models.Error
 //	@Failure		500		{object}	models.Error
 //	@Success		200		{object}	models.SignInResBody
+//	@Success		202		{object}	models.MFAChallengeResBody
 //	@Router			/sign-in [post]
 func (h *Handler) SignIn(c *gin.Context) {
 	var body models.SignInReqBody
@@ -163,13 +171,28 @@ func (h *Handler) SignIn(c *gin.Context) {
 		return
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"uid": user.ID,
-		"sub": user.Email,
-		"exp": jwt.NewNumericDate(time.Now().Add(time.Hour * 24)),
-	})
+	if user.IsTotpEnabled {
+		signed, err := h.signMFAToken(user.ID, user.Email)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, models.NewMFAChallengeResBody(signed))
+		return
+	}
+
+	session, err := h.queries.CreateSession(h.ctx, user.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
 
-	signed, err := token.SignedString([]byte(h.env.JWTSecret))
+	signed, err := h.signAccessToken(user.ID, user.Email, session.ID)
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
@@ -177,7 +200,7 @@ func (h *Handler) SignIn(c *gin.Context) {
 		return
 	}
 
-	resBody, err := models.NewSignInResBody(user, signed)
+	resBody, err := models.NewSignInResBody(user, signed, session.RefreshToken)
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
@@ -0,0 +1,445 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+// abortWithCompanyNameError reports names clashing with an existing company after normalisation as a client error
+func abortWithCompanyNameError(c *gin.Context, err error) {
+	if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == pgerrcode.UniqueViolation && pgErr.ConstraintName == "unique_company_name" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "a company with this name already exists",
+		})
+		return
+	}
+
+	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+		"error": err.Error(),
+	})
+}
+
+// Companies godoc
+//
+//	@Summary		Get companies
+//	@Description	Retrieves a list of companies along with the number of job applications sent to each of them
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Company
+//	@Accept			json
+//	@Produce		json
+//	@Param			page	query		int		false	"Page number (zero-indexed)"	minimum(0)	default(0)
+//	@Param			size	query		int		false	"Page size"						minimum(0)	default(10)
+//	@Param			search	query		string	false	"Company name"
+//	@Failure		400		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.CompaniesResBody
+//	@Router			/companies [get]
+func (h *Handler) Companies(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var queryParams models.CompaniesQueryParams
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if c.Query("size") == "" {
+		queryParams.Size = 10
+	}
+
+	companies, err := h.queries.GetCompanies(h.ctx, db.GetCompaniesParams{
+		UserID: uuid,
+		Limit:  int32(queryParams.Size),
+		Offset: int32(queryParams.Page * queryParams.Size),
+		Search: queryParams.Search,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewCompaniesResBody(queryParams.Page, queryParams.Size, companies)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// Company godoc
+//
+//	@Summary		Retrieve company details
+//	@Description	Fetches the details of a specific company along with every job application sent to it and their outcome counts
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Company
+//	@Accept			json
+//	@Produce		json
+//	@Param			companyId	path		string	true	"Company uuid"
+//	@Failure		404			{object}	models.Error
+//	@Failure		500			{object}	models.Error
+//	@Success		200			{object}	models.CompanyResBody
+//	@Router			/companies/{companyId} [get]
+func (h *Handler) Company(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	companyId, err := utils.ToUUID(c.Param("companyId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	company, err := h.queries.GetCompany(h.ctx, db.GetCompanyParams{
+		ID:     companyId,
+		UserID: uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplications, err := h.queries.GetCompanyJobApplications(h.ctx, db.GetCompanyJobApplicationsParams{
+		CompanyID: companyId,
+		UserID:    uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewCompanyResBody(company, jobApplications)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// CreateCompany godoc
+//
+//	@Summary		Create a company
+//	@Description	Creates a new company. Names are compared after normalisation, so e.g. "Evil Corp" clashes with "Evil Corp Inc."
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Company
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.CreateCompanyReqBody	true	"Company details"
+//	@Failure		400		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		201		{object}	models.CreateCompanyResBody
+//	@Router			/companies [post]
+func (h *Handler) CreateCompany(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.CreateCompanyReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	company, err := h.queries.CreateCompany(h.ctx, models.NewCreateCompanyParams(uuid, body))
+	if err != nil {
+		abortWithCompanyNameError(c, err)
+		return
+	}
+
+	resBody := models.NewCreateCompanyResBody(company)
+
+	c.JSON(http.StatusCreated, resBody)
+}
+
+// UpdateCompany godoc
+//
+//	@Summary		Update a company
+//	@Description	Updates an existing company with the provided details. Renaming a company renames it on every job application sent to it.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Company
+//	@Accept			json
+//	@Produce		json
+//	@Param			companyId	path		string						true	"Company uuid"
+//	@Param			body		body		models.UpdateCompanyReqBody	true	"Company details"
+//	@Failure		400			{object}	models.Error
+//	@Failure		404			{object}	models.Error
+//	@Failure		500			{object}	models.Error
+//	@Success		200			{object}	models.UpdateCompanyResBody
+//	@Router			/companies/{companyId} [put]
+func (h *Handler) UpdateCompany(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	companyId, err := utils.ToUUID(c.Param("companyId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.UpdateCompanyReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	company, err := h.queries.UpdateCompany(h.ctx, models.NewUpdateCompanyParams(companyId, uuid, body))
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		abortWithCompanyNameError(c, err)
+		return
+	}
+
+	resBody := models.NewUpdateCompanyResBody(company)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// DeleteCompany godoc
+//
+//	@Summary		Delete a company
+//	@Description	Deletes an existing company. Companies with job applications can't be deleted, merge them instead.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Company
+//	@Accept			json
+//	@Produce		json
+//	@Param			companyId	path		string	true	"Company uuid"
+//	@Failure		400			{object}	models.Error
+//	@Failure		404			{object}	models.Error
+//	@Failure		500			{object}	models.Error
+//	@Success		200			{object}	models.DeleteCompanyResBody
+//	@Router			/companies/{companyId} [delete]
+func (h *Handler) DeleteCompany(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	companyId, err := utils.ToUUID(c.Param("companyId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	company, err := h.queries.DeleteCompany(h.ctx, db.DeleteCompanyParams{
+		ID:     companyId,
+		UserID: uuid,
+	})
+	if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == pgerrcode.ForeignKeyViolation {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "company is still assigned to job applications",
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewDeleteCompanyResBody(company)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// MergeCompanies godoc
+//
+//	@Summary		Merge companies
+//	@Description	Moves every job application of the given companies to the one in the path, fills in its missing details from them, and deletes them
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Company
+//	@Accept			json
+//	@Produce		json
+//	@Param			companyId	path		string							true	"Company uuid"
+//	@Param			body		body		models.MergeCompaniesReqBody	true	"Companies to merge"
+//	@Failure		400			{object}	models.Error
+//	@Failure		404			{object}	models.Error
+//	@Failure		500			{object}	models.Error
+//	@Success		200			{object}	models.CompanyResBody
+//	@Router			/companies/{companyId}/merge [post]
+func (h *Handler) MergeCompanies(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	companyId, err := utils.ToUUID(c.Param("companyId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.MergeCompaniesReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	sourceIds := []pgtype.UUID{}
+	seen := map[string]bool{}
+	for _, id := range body.CompanyIDs {
+		sourceId, err := utils.ToUUID(id)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if sourceId == companyId {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "a company can't be merged into itself",
+			})
+			return
+		}
+		if !seen[sourceId.String()] {
+			seen[sourceId.String()] = true
+			sourceIds = append(sourceIds, sourceId)
+		}
+	}
+
+	if _, err := h.queries.GetCompany(h.ctx, db.GetCompanyParams{
+		ID:     companyId,
+		UserID: uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	found, err := h.queries.GetCompaniesByIds(h.ctx, db.GetCompaniesByIdsParams{
+		UserID: uuid,
+		Ids:    sourceIds,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if len(found) != len(sourceIds) {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": "one or more companies to merge don't exist",
+		})
+		return
+	}
+
+	if _, err := h.queries.MergeCompanies(h.ctx, db.MergeCompaniesParams{
+		ID:        companyId,
+		UserID:    uuid,
+		SourceIds: sourceIds,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	company, err := h.queries.GetCompany(h.ctx, db.GetCompanyParams{
+		ID:     companyId,
+		UserID: uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplications, err := h.queries.GetCompanyJobApplications(h.ctx, db.GetCompanyJobApplicationsParams{
+		CompanyID: companyId,
+		UserID:    uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewCompanyResBody(company, jobApplications)
+
+	c.JSON(http.StatusOK, resBody)
+}
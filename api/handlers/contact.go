@@ -0,0 +1,453 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+// Contacts godoc
+//
+//	@Summary		Get contacts
+//	@Description	Retrieves the address book of recruiters and other contacts, searchable by name, email, company, or role
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Contact
+//	@Accept			json
+//	@Produce		json
+//	@Param			page	query		int		false	"Page number (zero-indexed)"	minimum(0)	default(0)
+//	@Param			size	query		int		false	"Page size"						minimum(0)	default(10)
+//	@Param			search	query		string	false	"Name, email, company, or role"
+//	@Failure		400		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.ContactsResBody
+//	@Router			/contacts [get]
+func (h *Handler) Contacts(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var queryParams models.ContactsQueryParams
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if c.Query("size") == "" {
+		queryParams.Size = 10
+	}
+
+	contacts, err := h.queries.GetContacts(h.ctx, db.GetContactsParams{
+		UserID: uuid,
+		Limit:  int32(queryParams.Size),
+		Offset: int32(queryParams.Page * queryParams.Size),
+		Search: queryParams.Search,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewContactsResBody(queryParams.Page, queryParams.Size, contacts)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// Contact godoc
+//
+//	@Summary		Retrieve contact details
+//	@Description	Fetches the details of a specific contact by its id, including the job applications it is linked to
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Contact
+//	@Accept			json
+//	@Produce		json
+//	@Param			contactId	path		string	true	"Contact uuid"
+//	@Failure		404			{object}	models.Error
+//	@Failure		500			{object}	models.Error
+//	@Success		200			{object}	models.ContactResBody
+//	@Router			/contacts/{contactId} [get]
+func (h *Handler) Contact(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contactId, err := utils.ToUUID(c.Param("contactId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contact, err := h.queries.GetContact(h.ctx, db.GetContactParams{
+		ID:     contactId,
+		UserID: uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplications, err := h.queries.GetContactJobApplications(h.ctx, db.GetContactJobApplicationsParams{
+		ContactID: contactId,
+		UserID:    uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewContactResBody(contact, jobApplications)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// CreateContact godoc
+//
+//	@Summary		Create a contact
+//	@Description	Adds a new recruiter or other contact to the address book
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Contact
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.CreateContactReqBody	true	"Contact details"
+//	@Failure		400		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		201		{object}	models.CreateContactResBody
+//	@Router			/contacts [post]
+func (h *Handler) CreateContact(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.CreateContactReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contact, err := h.queries.CreateContact(h.ctx, models.NewCreateContactParams(uuid, body))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewCreateContactResBody(contact)
+
+	c.JSON(http.StatusCreated, resBody)
+}
+
+// UpdateContact godoc
+//
+//	@Summary		Update a contact
+//	@Description	Updates an existing contact with the provided details
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Contact
+//	@Accept			json
+//	@Produce		json
+//	@Param			contactId	path		string						true	"Contact uuid"
+//	@Param			body		body		models.UpdateContactReqBody	true	"Contact details"
+//	@Failure		400			{object}	models.Error
+//	@Failure		404			{object}	models.Error
+//	@Failure		500			{object}	models.Error
+//	@Success		200			{object}	models.UpdateContactResBody
+//	@Router			/contacts/{contactId} [put]
+func (h *Handler) UpdateContact(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contactId, err := utils.ToUUID(c.Param("contactId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.UpdateContactReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contact, err := h.queries.UpdateContact(h.ctx, models.NewUpdateContactParams(contactId, uuid, body))
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewUpdateContactResBody(contact)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// DeleteContact godoc
+//
+//	@Summary		Delete a contact
+//	@Description	Deletes an existing contact and unlinks it from every job application
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Contact
+//	@Accept			json
+//	@Produce		json
+//	@Param			contactId	path		string	true	"Contact uuid"
+//	@Failure		404			{object}	models.Error
+//	@Failure		500			{object}	models.Error
+//	@Success		200			{object}	models.DeleteContactResBody
+//	@Router			/contacts/{contactId} [delete]
+func (h *Handler) DeleteContact(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contactId, err := utils.ToUUID(c.Param("contactId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contact, err := h.queries.DeleteContact(h.ctx, db.DeleteContactParams{
+		ID:     contactId,
+		UserID: uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewDeleteContactResBody(contact)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// LinkJobApplicationContact godoc
+//
+//	@Summary		Link a contact to a job application
+//	@Description	Links an existing contact to a job application. Linking an already linked contact is a no-op.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Contact
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			contactId			path		string	true	"Contact uuid"
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.JobApplicationContactsResBody
+//	@Router			/job-applications/{jobApplicationId}/contacts/{contactId} [put]
+func (h *Handler) LinkJobApplicationContact(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contactId, err := utils.ToUUID(c.Param("contactId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.queries.GetJobApplication(h.ctx, db.GetJobApplicationParams{
+		ID:     jobApplicationId,
+		UserID: uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.queries.GetContact(h.ctx, db.GetContactParams{
+		ID:     contactId,
+		UserID: uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.queries.LinkJobApplicationContact(h.ctx, db.LinkJobApplicationContactParams{
+		JobApplicationID: jobApplicationId,
+		ContactID:        contactId,
+		UserID:           uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contacts, err := h.queries.GetJobApplicationContacts(h.ctx, db.GetJobApplicationContactsParams{
+		JobApplicationID: jobApplicationId,
+		UserID:           uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewJobApplicationContactsResBody(contacts)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// UnlinkJobApplicationContact godoc
+//
+//	@Summary		Unlink a contact from a job application
+//	@Description	Removes the link between a contact and a job application without deleting the contact
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Contact
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			contactId			path		string	true	"Contact uuid"
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.JobApplicationContactsResBody
+//	@Router			/job-applications/{jobApplicationId}/contacts/{contactId} [delete]
+func (h *Handler) UnlinkJobApplicationContact(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contactId, err := utils.ToUUID(c.Param("contactId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.queries.UnlinkJobApplicationContact(h.ctx, db.UnlinkJobApplicationContactParams{
+		JobApplicationID: jobApplicationId,
+		ContactID:        contactId,
+		UserID:           uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contacts, err := h.queries.GetJobApplicationContacts(h.ctx, db.GetJobApplicationContactsParams{
+		JobApplicationID: jobApplicationId,
+		UserID:           uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewJobApplicationContactsResBody(contacts)
+
+	c.JSON(http.StatusOK, resBody)
+}
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"github.com/jakub-szewczyk/career-compass-gin/mailer"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+// NOTE: Emails are only queued here, delivery happens in the background (see mailer.Outbox).
+// The queries are taken as an argument, so that an email can be queued in the same transaction as the change it's about.
+func (h *Handler) enqueueEmail(queries *db.Queries, to, subject, template string, data any) error {
+	html, err := mailer.Render(template, data)
+	if err != nil {
+		return err
+	}
+
+	_, err = queries.EnqueueEmail(h.ctx, db.EnqueueEmailParams{
+		Recipient: to,
+		Subject:   subject,
+		Body:      html,
+	})
+
+	return err
+}
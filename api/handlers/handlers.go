@@ -3,7 +3,9 @@ package handlers
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/storage"
 )
 
 type Env struct {
@@ -11,45 +13,43 @@ type Env struct {
 	DatabaseURL string
 	JWTSecret   string
 
-	SMTPIdentity string
-	SMTPUsername string
-	SMTPPassword string
-	SMTPHost     string
-	SMTPPORT     string
-
 	FrontendURL          string
 	EmailVerificationURL string
 	ResetPasswordURL     string
 }
 
-func NewEnv(port, databaseURL, jwtSecret, smtpIdentity, smtpUsername, smtpPassword, smtpHost, smtpPort, frontendURL, emailVerificationURL, resetPasswordURL string) Env {
+func NewEnv(port, databaseURL, jwtSecret, frontendURL, emailVerificationURL, resetPasswordURL string) Env {
 	return Env{
 		Port:        port,
 		DatabaseURL: databaseURL,
 		JWTSecret:   jwtSecret,
 
-		SMTPIdentity: smtpIdentity,
-		SMTPUsername: smtpUsername,
-		SMTPPassword: smtpPassword,
-		SMTPHost:     smtpHost,
-		SMTPPORT:     smtpPort,
-
 		FrontendURL:          frontendURL,
 		EmailVerificationURL: emailVerificationURL,
 		ResetPasswordURL:     resetPasswordURL,
 	}
 }
 
+// Conn starts transactions spanning several queries, satisfied by both *pgx.Conn and *pgxpool.Pool
+type Conn interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
 type Handler struct {
 	ctx     context.Context
 	env     Env
+	conn    Conn
 	queries *db.Queries
+	storage storage.Storage
 }
 
-func NewHandler(ctx context.Context, env Env, queries *db.Queries) *Handler {
+func NewHandler(ctx context.Context, env Env, conn Conn, queries *db.Queries, storage storage.Storage) *Handler {
 	return &Handler{
 		ctx:     ctx,
 		env:     env,
+		conn:    conn,
 		queries: queries,
+		storage: storage,
 	}
 }
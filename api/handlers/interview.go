@@ -0,0 +1,407 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+// Interviews godoc
+//
+//	@Summary		Get job application interviews
+//	@Description	Retrieves every interview scheduled for a specific job application, ordered by scheduled time
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Interview
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.InterviewsResBody
+//	@Router			/job-applications/{jobApplicationId}/interviews [get]
+func (h *Handler) Interviews(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.queries.GetJobApplication(h.ctx, db.GetJobApplicationParams{
+		ID:     jobApplicationId,
+		UserID: uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	interviews, err := h.queries.GetInterviews(h.ctx, db.GetInterviewsParams{
+		JobApplicationID: jobApplicationId,
+		UserID:           uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewInterviewsResBody(interviews)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// Interview godoc
+//
+//	@Summary		Retrieve interview details
+//	@Description	Fetches the details of a specific interview by its id
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Interview
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			interviewId			path		string	true	"Interview uuid"
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.InterviewResBody
+//	@Router			/job-applications/{jobApplicationId}/interviews/{interviewId} [get]
+func (h *Handler) Interview(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	interviewId, err := utils.ToUUID(c.Param("interviewId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	interview, err := h.queries.GetInterview(h.ctx, db.GetInterviewParams{
+		ID:               interviewId,
+		JobApplicationID: jobApplicationId,
+		UserID:           uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewInterviewResBody(interview)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// CreateInterview godoc
+//
+//	@Summary		Schedule an interview
+//	@Description	Adds a new interview to an existing job application
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Interview
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string							true	"Job application uuid"
+//	@Param			body				body		models.CreateInterviewReqBody	true	"Interview details"
+//	@Failure		400					{object}	models.Error
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		201					{object}	models.CreateInterviewResBody
+//	@Router			/job-applications/{jobApplicationId}/interviews [post]
+func (h *Handler) CreateInterview(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.CreateInterviewReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// NOTE: No rows are inserted when the job application doesn't belong to the user
+	interview, err := h.queries.CreateInterview(h.ctx, models.NewCreateInterviewParams(jobApplicationId, uuid, body))
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewCreateInterviewResBody(interview)
+
+	c.JSON(http.StatusCreated, resBody)
+}
+
+// UpdateInterview godoc
+//
+//	@Summary		Update an interview
+//	@Description	Updates an existing interview with the provided details, e.g. to reschedule it or record its outcome
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Interview
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string							true	"Job application uuid"
+//	@Param			interviewId			path		string							true	"Interview uuid"
+//	@Param			body				body		models.UpdateInterviewReqBody	true	"Interview details"
+//	@Failure		400					{object}	models.Error
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.UpdateInterviewResBody
+//	@Router			/job-applications/{jobApplicationId}/interviews/{interviewId} [put]
+func (h *Handler) UpdateInterview(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	interviewId, err := utils.ToUUID(c.Param("interviewId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.UpdateInterviewReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	interview, err := h.queries.UpdateInterview(h.ctx, models.NewUpdateInterviewParams(interviewId, jobApplicationId, uuid, body))
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewUpdateInterviewResBody(interview)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// DeleteInterview godoc
+//
+//	@Summary		Delete an interview
+//	@Description	Deletes an existing interview
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Interview
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			interviewId			path		string	true	"Interview uuid"
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.DeleteInterviewResBody
+//	@Router			/job-applications/{jobApplicationId}/interviews/{interviewId} [delete]
+func (h *Handler) DeleteInterview(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	interviewId, err := utils.ToUUID(c.Param("interviewId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	interview, err := h.queries.DeleteInterview(h.ctx, db.DeleteInterviewParams{
+		ID:               interviewId,
+		JobApplicationID: jobApplicationId,
+		UserID:           uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewDeleteInterviewResBody(interview)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// UpcomingInterviews godoc
+//
+//	@Summary		Get upcoming interviews
+//	@Description	Lists interviews across all job applications scheduled within the given time range. Without the from param, only interviews from now on are returned.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Interview
+//	@Accept			json
+//	@Produce		json
+//	@Param			from	query		string	false	"Range start (RFC 3339)"
+//	@Param			to		query		string	false	"Range end, exclusive (RFC 3339)"
+//	@Failure		400		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.UpcomingInterviewsResBody
+//	@Router			/interviews [get]
+func (h *Handler) UpcomingInterviews(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var queryParams models.UpcomingInterviewsQueryParams
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	from := time.Now()
+	if queryParams.From != "" {
+		from, err = time.Parse(time.RFC3339, queryParams.From)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+	}
+
+	var to pgtype.Timestamptz
+	if queryParams.To != "" {
+		t, err := time.Parse(time.RFC3339, queryParams.To)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		to = pgtype.Timestamptz{Time: t, Valid: true}
+	}
+
+	interviews, err := h.queries.GetUpcomingInterviews(h.ctx, db.GetUpcomingInterviewsParams{
+		UserID:        uuid,
+		ScheduledFrom: pgtype.Timestamptz{Time: from, Valid: true},
+		ScheduledTo:   to,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewUpcomingInterviewsResBody(interviews)
+
+	c.JSON(http.StatusOK, resBody)
+}
@@ -1,32 +1,140 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jakub-szewczyk/career-compass-gin/api/models"
 	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
 	"github.com/jakub-szewczyk/career-compass-gin/utils"
 )
 
+// jobApplicationErrorMessage translates stage and company constraint violations raised while saving a job application
+func jobApplicationErrorMessage(err error) (string, bool) {
+	if pgErr, ok := err.(*pgconn.PgError); ok {
+		switch {
+		case pgErr.Code == pgerrcode.ForeignKeyViolation && pgErr.ConstraintName == "job_applications_stage_id_fkey":
+			return "stage doesn't exist", true
+		case pgErr.Code == pgerrcode.NotNullViolation && pgErr.ColumnName == "stage_id":
+			return "stage is required when no stages are defined", true
+		case pgErr.Code == pgerrcode.ForeignKeyViolation && pgErr.ConstraintName == "job_applications_company_id_fkey":
+			return "company doesn't exist", true
+		}
+	}
+
+	return "", false
+}
+
+func abortWithJobApplicationError(c *gin.Context, err error) {
+	if message, ok := jobApplicationErrorMessage(err); ok {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": message,
+		})
+		return
+	}
+
+	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+		"error": err.Error(),
+	})
+}
+
+// lockJobApplication locks the job application until the transaction ends and checks it against the If-Match header, if given,
+// so that a change made in the meantime, e.g. from another device, isn't silently overwritten
+func (h *Handler) lockJobApplication(c *gin.Context, queries *db.Queries, jobApplicationId, userId pgtype.UUID) bool {
+	version, err := queries.LockJobApplication(h.ctx, db.LockJobApplicationParams{
+		ID:     jobApplicationId,
+		UserID: userId,
+	})
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return false
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return false
+	}
+
+	if ifMatch := c.GetHeader("If-Match"); ifMatch != "" && !utils.MatchETag(ifMatch, models.NewJobApplicationETag(version), false) {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, gin.H{
+			"error": "job application has been changed in the meantime",
+		})
+		return false
+	}
+
+	return true
+}
+
+// checkSalaryCurrency makes sure there's an exchange rate to convert salaries to the requested currency with
+func (h *Handler) checkSalaryCurrency(c *gin.Context, queries *db.Queries, currency string) bool {
+	_, err := queries.GetExchangeRate(h.ctx, currency)
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("no exchange rate for %s", currency),
+		})
+		return false
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return false
+	}
+
+	return true
+}
+
+// checkRequestedSalaryCurrency is checkSalaryCurrency for the currency salaries are filtered and sorted in. The preferred currency
+// passes even once its exchange rate is gone, in which case salaries in other currencies are just left unconverted.
+func (h *Handler) checkRequestedSalaryCurrency(c *gin.Context, queries *db.Queries, currency string, preferences db.GetUserPreferencesRow) bool {
+	if currency == preferences.Currency {
+		return true
+	}
+	return h.checkSalaryCurrency(c, queries, currency)
+}
+
 // JobApplications godoc
 //
 //	@Summary		Get job applications
-//	@Description	Retrieves a list of job applications with support for sorting, filtering, and pagination
+//	@Description	Retrieves a list of job applications with support for sorting, filtering, and pagination.
+//	@Description	Pages are numbered by default. Passing the cursor param, empty for the first page, switches to cursor-based pagination instead, where each response carries the nextCursor to pass on, stays stable while job applications are added or removed, and skips counting the total. The response then carries size, nextCursor, which is null on the last page, and data, instead of page, size, total and data.
+//	@Description	Passing the view param applies the params saved in that view, which any param given alongside overrides.
 //
 //	@Security		BearerAuth
 //
 //	@Tags			Job application
 //	@Accept			json
 //	@Produce		json
-//	@Param			page						query		int		false	"Page number (zero-indexed)"	minimum(0)																																			default(0)
-//	@Param			size						query		int		false	"Page size"						minimum(0)																																			default(10)
-//	@Param			sort						query		string	false	"Sortable column name"			Enums(company_name, -company_name, job_title, -job_title, date_applied, -date_applied, status, -status, salary, -salary, is_replied, -is_replied)	default(-date_applied)
-//	@Param			company_name_or_job_title	query		string	false	"Company name or job title"
-//	@Param			date_applied				query		string	false	"Date applied"
-//	@Param			status						query		string	false	"Status"	Enums(IN_PROGRESS, REJECTED, ACCEPTED)
+//	@Param			view						query		string		false	"Saved view uuid, or default for the default view, whose params apply unless given explicitly"
+//	@Param			cursor						query		string		false	"Opaque cursor returned as nextCursor by the previous page, empty for the first page. Must be used with the same sort and filters it was issued for."
+//	@Param			page						query		int			false	"Page number (zero-indexed)"																																													minimum(0)	default(0)
+//	@Param			size						query		int			false	"Page size"																																																		minimum(0)	default(10)
+//	@Param			sort						query		string		false	"Comma-separated sortable column names, each prefixed with - to sort descending, from company_name, job_title, date_applied, stage, salary and is_replied, or relevance. Ties are broken by the next column."	default(-date_applied)
+//	@Param			q							query		string		false	"Full-text search across company name, job title, notes, and job posting url, matching word prefixes. Sorts by relevance unless another sort is given."
+//	@Param			company_name_or_job_title	query		string		false	"Company name or job title"
+//	@Param			date_applied				query		string		false	"Date applied (YYYY-MM-DD)"
+//	@Param			date_applied_from			query		string		false	"Earliest date applied (YYYY-MM-DD)"
+//	@Param			date_applied_to				query		string		false	"Latest date applied (YYYY-MM-DD)"
+//	@Param			applied_within_days			query		int			false	"Applied within the given number of days, today in the time zone of the user included"	minimum(1)
+//	@Param			stage_id					query		[]string	false	"Stage uuids"																			collectionFormat(multi)
+//	@Param			outcome						query		string		false	"Stage outcome"																			Enums(NEUTRAL, POSITIVE, NEGATIVE)
+//	@Param			min_salary					query		number		false	"Lowest acceptable salary, matched against the upper end of the salary range"			minimum(0)
+//	@Param			max_salary					query		number		false	"Highest acceptable salary, matched against the lower end of the salary range"			minimum(0)
+//	@Param			salary_currency				query		string		false	"ISO 4217 code of the currency salaries are filtered and sorted in, converted with the configured exchange rates, the preferred currency of the user by default, in which salaries in other currencies are left out while it has no exchange rate"
+//	@Param			salary_period				query		string		false	"Period salaries are filtered and sorted in, converted assuming 168 working hours a month"						Enums(HOUR, MONTH, YEAR)	default(MONTH)
+//	@Param			salary_type					query		string		false	"Gross or net, since salaries of the two are compared as they are, without being converted into one another"	Enums(GROSS, NET)
+//	@Param			is_replied					query		boolean		false	"Whether a reply was received"
+//	@Param			tags						query		[]string	false	"Tag uuids"														collectionFormat(multi)
+//	@Param			tags_match					query		string		false	"Whether applications must have any or all of the given tags"	Enums(any, all)	default(any)
 //	@Failure		400							{object}	models.Error
 //	@Failure		500							{object}	models.Error
 //	@Success		200							{object}	models.JobApplicationsResBody
@@ -44,54 +152,55 @@ func (h *Handler) JobApplications(c *gin.Context) {
 
 	var queryParams models.JobApplicationsQueryParams
 
-	if err := c.ShouldBindQuery(&queryParams); err != nil {
+	values, ok := h.bindJobApplicationsQuery(c, uuid, &queryParams)
+	if !ok {
+		return
+	}
+
+	if values.Get("size") == "" {
+		queryParams.Size = 10
+	}
+
+	preferences, ok := h.userPreferences(c, uuid)
+	if !ok {
+		return
+	}
+
+	isCursor := values.Has("cursor")
+
+	var params db.GetJobApplicationsParams
+	if isCursor {
+		params, err = models.NewGetJobApplicationsCursorParams(uuid, queryParams, preferences)
+	} else {
+		params, err = models.NewGetJobApplicationsParams(uuid, queryParams, preferences)
+	}
+	if err != nil {
 		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
 
-	if c.Query("size") == "" {
-		queryParams.Size = 10
+	if !h.checkRequestedSalaryCurrency(c, h.queries, params.SalaryCurrency, preferences) {
+		return
 	}
 
-	if c.Query("sort") == "" {
-		queryParams.Sort = models.DateAppliedDesc
+	jobApplications, err := h.queries.GetJobApplications(h.ctx, params)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
 	}
 
-	var dateApplied time.Time
-	if queryParams.DateApplied != "" {
-		dateApplied, err = time.Parse(time.DateOnly, queryParams.DateApplied)
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
-			return
-		}
+	jobApplicationIds := []pgtype.UUID{}
+	for _, jobApplication := range jobApplications {
+		jobApplicationIds = append(jobApplicationIds, jobApplication.ID)
 	}
 
-	jobApplications, err := h.queries.GetJobApplications(h.ctx, db.GetJobApplicationsParams{
-		UserID: uuid,
-
-		Limit:  int32(queryParams.Size),
-		Offset: int32(queryParams.Page * queryParams.Size),
-
-		CompanyNameAsc:  queryParams.Sort == models.CompanyNameAsc,
-		CompanyNameDesc: queryParams.Sort == models.CompanyNameDesc,
-		JobTitleAsc:     queryParams.Sort == models.JobTitleAsc,
-		JobTitleDesc:    queryParams.Sort == models.JobTitleDesc,
-		DateAppliedAsc:  queryParams.Sort == models.DateAppliedAsc,
-		DateAppliedDesc: queryParams.Sort == models.DateAppliedDesc,
-		StatusAsc:       queryParams.Sort == models.StatusAsc,
-		StatusDesc:      queryParams.Sort == models.StatusDesc,
-		SalaryAsc:       queryParams.Sort == models.SalaryAsc,
-		SalaryDesc:      queryParams.Sort == models.SalaryDesc,
-		IsRepliedAsc:    queryParams.Sort == models.IsRepliedAsc,
-		IsRepliedDesc:   queryParams.Sort == models.IsRepliedDesc,
-
-		CompanyNameOrJobTitle: queryParams.CompanyNameOrJobTitle,
-		DateApplied:           utils.NullifyTime(dateApplied),
-		Status:                queryParams.Status,
+	tags, err := h.queries.GetJobApplicationTags(h.ctx, db.GetJobApplicationTagsParams{
+		JobApplicationIds: jobApplicationIds,
+		UserID:            uuid,
 	})
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
@@ -100,7 +209,12 @@ func (h *Handler) JobApplications(c *gin.Context) {
 		return
 	}
 
-	resBody := models.NewJobApplicationsResBody(queryParams.Page, queryParams.Size, jobApplications)
+	if isCursor {
+		c.JSON(http.StatusOK, models.NewJobApplicationsCursorResBody(queryParams.Size, params, jobApplications, tags))
+		return
+	}
+
+	resBody := models.NewJobApplicationsResBody(queryParams.Page, queryParams.Size, jobApplications, tags)
 
 	c.JSON(http.StatusOK, resBody)
 }
@@ -108,7 +222,8 @@ func (h *Handler) JobApplications(c *gin.Context) {
 // JobApplication godoc
 //
 //	@Summary		Retrieve job application details
-//	@Description	Fetches the details of a specific job application by its id
+//	@Description	Fetches the details of a specific job application by its id, including its tags and linked contacts
+//	@Description	The ETag header carries its version, which If-Match on PUT, PATCH and DELETE guards against overwriting changes made in the meantime. Passing it back as If-None-Match returns 304 until the job application changes.
 //
 //	@Security		BearerAuth
 //
@@ -116,10 +231,13 @@ func (h *Handler) JobApplications(c *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			If-None-Match		header		string	false	"ETag of the cached job application"
 //	@Failure		400					{object}	models.Error
 //	@Failure		404					{object}	models.Error
 //	@Failure		500					{object}	models.Error
 //	@Success		200					{object}	models.JobApplicationResBody
+//	@Header			200					{string}	ETag	"Version of the job application"
+//	@Success		304
 //	@Router			/job-applications/{jobApplicationId} [get]
 func (h *Handler) JobApplication(c *gin.Context) {
 	userId := c.MustGet("userId").(string)
@@ -151,7 +269,37 @@ func (h *Handler) JobApplication(c *gin.Context) {
 		return
 	}
 
-	resBody := models.NewJobApplicationResBody(jobApplication)
+	etag := models.NewJobApplicationETag(jobApplication.Version)
+	c.Header("ETag", etag)
+
+	if utils.MatchETag(c.GetHeader("If-None-Match"), etag, true) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	tags, err := h.queries.GetJobApplicationTags(h.ctx, db.GetJobApplicationTagsParams{
+		JobApplicationIds: []pgtype.UUID{jobApplicationId},
+		UserID:            uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	contacts, err := h.queries.GetJobApplicationContacts(h.ctx, db.GetJobApplicationContactsParams{
+		JobApplicationID: jobApplicationId,
+		UserID:           uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewJobApplicationResBody(jobApplication, tags, contacts)
 
 	c.JSON(http.StatusOK, resBody)
 }
@@ -159,17 +307,23 @@ func (h *Handler) JobApplication(c *gin.Context) {
 // CreateJobApplication godoc
 //
 //	@Summary		Submit a new job application
-//	@Description	Processes and creates a new job application with the provided data
+//	@Description	Processes and creates a new job application with the provided data. The company is given either by id or by name, in which case it is matched against existing companies by normalised name or created on the fly.
+//	@Description	Retrying with the same Idempotency-Key header within a day returns the original response instead of creating a duplicate.
+//	@Description	The date applied is given either as a YYYY-MM-DD date or as an RFC 3339 timestamp, which is taken as the day it falls on in the time zone of the user, and is always returned as a YYYY-MM-DD date.
 //
 //	@Security		BearerAuth
 //
 //	@Tags			Job application
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body		models.CreateJobApplicationReqBody	true	"Job application details"
-//	@Failure		400		{object}	models.Error
-//	@Failure		500		{object}	models.Error
-//	@Success		201		{object}	models.CreateJobApplicationResBody
+//	@Param			body			body		models.CreateJobApplicationReqBody	true	"Job application details"
+//	@Param			Idempotency-Key	header		string								false	"Unique key of the request, at most 255 characters"
+//	@Failure		400				{object}	models.Error
+//	@Failure		409				{object}	models.Error
+//	@Failure		422				{object}	models.Error
+//	@Failure		500				{object}	models.Error
+//	@Success		201				{object}	models.CreateJobApplicationResBody
+//	@Header			201				{string}	Idempotent-Replayed	"Set to true when the response is replayed"
 //	@Router			/job-applications [post]
 func (h *Handler) CreateJobApplication(c *gin.Context) {
 	userId := c.MustGet("userId").(string)
@@ -191,21 +345,15 @@ func (h *Handler) CreateJobApplication(c *gin.Context) {
 		return
 	}
 
-	jobApplication, err := h.queries.CreateJobApplication(h.ctx, db.CreateJobApplicationParams{
-		UserID:        uuid,
-		CompanyName:   body.CompanyName,
-		JobTitle:      body.JobTitle,
-		DateApplied:   pgtype.Timestamptz{Time: body.DateApplied, Valid: true},
-		Status:        db.Status(body.Status),
-		MinSalary:     pgtype.Float8{Float64: body.MinSalary, Valid: true},
-		MaxSalary:     pgtype.Float8{Float64: body.MaxSalary, Valid: true},
-		JobPostingUrl: pgtype.Text{String: body.JobPostingURL, Valid: true},
-		Notes:         pgtype.Text{String: body.Notes, Valid: true},
-	})
+	preferences, ok := h.userPreferences(c, uuid)
+	if !ok {
+		return
+	}
+
+	// NOTE: The company is matched by its normalised name, or created on the fly, unless companyId is given
+	jobApplication, err := h.queries.CreateJobApplication(h.ctx, models.NewCreateJobApplicationParams(uuid, body, preferences))
 	if err != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
+		abortWithJobApplicationError(c, err)
 		return
 	}
 
@@ -216,8 +364,9 @@ func (h *Handler) CreateJobApplication(c *gin.Context) {
 
 // UpdateJobApplication godoc
 //
-//	@Summary		Update a job application
-//	@Description	Updates an existing job application with the provided details
+//	@Summary		Replace a job application
+//	@Description	Replaces an existing job application with the provided details. Optional fields left out, i.e. salaries, job posting url and notes, are cleared. Use PATCH to change only some of the fields.
+//	@Description	The date applied is given either as a YYYY-MM-DD date or as an RFC 3339 timestamp, which is taken as the day it falls on in the time zone of the user, and is always returned as a YYYY-MM-DD date.
 //
 //	@Security		BearerAuth
 //
@@ -226,9 +375,13 @@ func (h *Handler) CreateJobApplication(c *gin.Context) {
 //	@Produce		json
 //	@Param			jobApplicationId	path		string								true	"Job application uuid"
 //	@Param			body				body		models.UpdateJobApplicationReqBody	true	"Job application details"
+//	@Param			If-Match			header		string								false	"ETag the job application must still have"
 //	@Failure		400					{object}	models.Error
+//	@Failure		404					{object}	models.Error
+//	@Failure		412					{object}	models.Error
 //	@Failure		500					{object}	models.Error
 //	@Success		200					{object}	models.UpdateJobApplicationResBody
+//	@Header			200					{string}	ETag	"New version of the job application"
 //	@Router			/job-applications/{jobApplicationId} [put]
 func (h *Handler) UpdateJobApplication(c *gin.Context) {
 	userId := c.MustGet("userId").(string)
@@ -258,25 +411,142 @@ func (h *Handler) UpdateJobApplication(c *gin.Context) {
 		return
 	}
 
-	params := models.NewUpdateJobApplicationParams(jobApplicationId, uuid, body)
+	preferences, ok := h.userPreferences(c, uuid)
+	if !ok {
+		return
+	}
 
-	jobApplication, err := h.queries.UpdateJobApplication(h.ctx, params)
+	tx, err := h.conn.Begin(h.ctx)
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
+	defer tx.Rollback(h.ctx)
+
+	queries := h.queries.WithTx(tx)
+
+	if !h.lockJobApplication(c, queries, jobApplicationId, uuid) {
+		return
+	}
+
+	params := models.NewUpdateJobApplicationParams(jobApplicationId, uuid, body, preferences)
+
+	jobApplication, err := queries.UpdateJobApplication(h.ctx, params)
+	if err != nil {
+		abortWithJobApplicationError(c, err)
+		return
+	}
+
+	if err := tx.Commit(h.ctx); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
 
 	resBody := models.NewUpdateJobApplicationResBody(jobApplication)
 
+	c.Header("ETag", models.NewJobApplicationETag(jobApplication.Version))
+	c.JSON(http.StatusOK, resBody)
+}
+
+// PatchJobApplication godoc
+//
+//	@Summary		Patch a job application
+//	@Description	Changes some fields of an existing job application, following JSON Merge Patch (RFC 7396). Fields left out are kept as they are, while salaries, job posting url and notes set to null or, for the latter two, an empty string are cleared.
+//	@Description	The date applied is given either as a YYYY-MM-DD date or as an RFC 3339 timestamp, which is taken as the day it falls on in the time zone of the user, and is always returned as a YYYY-MM-DD date.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Job application
+//	@Accept			json
+//	@Accept			application/merge-patch+json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string								true	"Job application uuid"
+//	@Param			body				body		models.PatchJobApplicationReqBody	true	"Job application fields to change"
+//	@Param			If-Match			header		string								false	"ETag the job application must still have"
+//	@Failure		400					{object}	models.Error
+//	@Failure		404					{object}	models.Error
+//	@Failure		412					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.PatchJobApplicationResBody
+//	@Header			200					{string}	ETag	"New version of the job application"
+//	@Router			/job-applications/{jobApplicationId} [patch]
+func (h *Handler) PatchJobApplication(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.PatchJobApplicationReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	preferences, ok := h.userPreferences(c, uuid)
+	if !ok {
+		return
+	}
+
+	tx, err := h.conn.Begin(h.ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	queries := h.queries.WithTx(tx)
+
+	if !h.lockJobApplication(c, queries, jobApplicationId, uuid) {
+		return
+	}
+
+	params := models.NewPatchJobApplicationParams(jobApplicationId, uuid, body, preferences)
+
+	jobApplication, err := queries.UpdateJobApplication(h.ctx, params)
+	if err != nil {
+		abortWithJobApplicationError(c, err)
+		return
+	}
+
+	if err := tx.Commit(h.ctx); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewPatchJobApplicationResBody(jobApplication)
+
+	c.Header("ETag", models.NewJobApplicationETag(jobApplication.Version))
 	c.JSON(http.StatusOK, resBody)
 }
 
 // DeleteJobApplication godoc
 //
 //	@Summary		Delete a job application
-//	@Description	Deletes an existing job application
+//	@Description	Moves an existing job application to the trash, from where it can be restored for 30 days before it's permanently deleted along with its interviews, reminders and attachments
 //
 //	@Security		BearerAuth
 //
@@ -284,7 +554,10 @@ func (h *Handler) UpdateJobApplication(c *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			If-Match			header		string	false	"ETag the job application must still have"
 //	@Failure		400					{object}	models.Error
+//	@Failure		404					{object}	models.Error
+//	@Failure		412					{object}	models.Error
 //	@Failure		500					{object}	models.Error
 //	@Success		200					{object}	models.DeleteJobApplicationResBody
 //	@Router			/job-applications/{jobApplicationId} [delete]
@@ -307,7 +580,22 @@ func (h *Handler) DeleteJobApplication(c *gin.Context) {
 		return
 	}
 
-	jobApplication, err := h.queries.DeleteJobApplication(h.ctx, db.DeleteJobApplicationParams{
+	tx, err := h.conn.Begin(h.ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	queries := h.queries.WithTx(tx)
+
+	if !h.lockJobApplication(c, queries, jobApplicationId, uuid) {
+		return
+	}
+
+	jobApplication, err := queries.DeleteJobApplication(h.ctx, db.DeleteJobApplicationParams{
 		ID:     jobApplicationId,
 		UserID: uuid,
 	})
@@ -318,7 +606,75 @@ func (h *Handler) DeleteJobApplication(c *gin.Context) {
 		return
 	}
 
+	if err := tx.Commit(h.ctx); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
 	resBody := models.NewDeleteJobApplicationResBody(jobApplication)
 
 	c.JSON(http.StatusOK, resBody)
 }
+
+// JobApplicationTimeline godoc
+//
+//	@Summary		Retrieve job application timeline
+//	@Description	Returns every recorded change of a specific job application in chronological order, along with the time spent in each stage
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Job application
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Failure		400					{object}	models.Error
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.JobApplicationTimelineResBody
+//	@Router			/job-applications/{jobApplicationId}/timeline [get]
+func (h *Handler) JobApplicationTimeline(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.queries.GetJobApplication(h.ctx, db.GetJobApplicationParams{
+		ID:     jobApplicationId,
+		UserID: uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	events, err := h.queries.GetJobApplicationEvents(h.ctx, db.GetJobApplicationEventsParams{
+		JobApplicationID: jobApplicationId,
+		UserID:           uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewJobApplicationTimelineResBody(events, time.Now())
+
+	c.JSON(http.StatusOK, resBody)
+}
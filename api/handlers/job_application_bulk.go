@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+const maxBulkItems = 500
+
+// bulkUpdateJobApplication applies the changes of a bulk update to a single job application, returning the message to report for it, if any.
+// The tag ids are the ones to add followed by the ones to remove.
+func (h *Handler) bulkUpdateJobApplication(queries *db.Queries, jobApplicationId, userId pgtype.UUID, body models.BulkJobApplicationsReqBody, tagIds []pgtype.UUID) (string, error) {
+	if _, err := queries.GetJobApplication(h.ctx, db.GetJobApplicationParams{
+		ID:     jobApplicationId,
+		UserID: userId,
+	}); err == pgx.ErrNoRows {
+		return "job application not found", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	if body.StageID != "" || body.IsReplied != nil {
+		if _, err := queries.UpdateJobApplication(h.ctx, models.NewBulkUpdateJobApplicationParams(jobApplicationId, userId, body)); err != nil {
+			if message, ok := jobApplicationErrorMessage(err); ok {
+				return message, nil
+			}
+			return "", err
+		}
+	}
+
+	for _, tagId := range tagIds[:len(body.AddTagIDs)] {
+		if _, err := queries.TagJobApplication(h.ctx, db.TagJobApplicationParams{
+			JobApplicationID: jobApplicationId,
+			TagID:            tagId,
+			UserID:           userId,
+		}); err != nil {
+			return "", err
+		}
+	}
+
+	// NOTE: Removing a tag the job application doesn't have is not an error
+	for _, tagId := range tagIds[len(body.AddTagIDs):] {
+		if _, err := queries.UntagJobApplication(h.ctx, db.UntagJobApplicationParams{
+			JobApplicationID: jobApplicationId,
+			TagID:            tagId,
+			UserID:           userId,
+		}); err != nil && err != pgx.ErrNoRows {
+			return "", err
+		}
+	}
+
+	return "", nil
+}
+
+// BulkJobApplications godoc
+//
+//	@Summary		Bulk update or delete job applications
+//	@Description	Changes the stage, reply status or tags of many job applications at once, or moves them to the trash. The job applications are picked either by a list of ids or by a filter, in the same format as the query of a saved view, e.g. "outcome=NEUTRAL&applied_within_days=30".
+//	@Description	Every job application is changed in one transaction, so either all of them are changed or none is. The response lists the result of each one, with the reason it failed, if any.
+//	@Description	At most 500 job applications can be changed at once.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Job application
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.BulkJobApplicationsReqBody	true	"Job applications and changes"
+//	@Failure		400		{object}	models.BulkJobApplicationsResBody
+//	@Failure		404		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.BulkJobApplicationsResBody
+//	@Router			/job-applications/bulk [post]
+func (h *Handler) BulkJobApplications(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.BulkJobApplicationsReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := models.ValidateBulkJobApplicationsReqBody(body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if body.StageID != "" {
+		stageId, _ := utils.ToUUID(body.StageID) // NOTE: Already validated by the binding
+
+		if _, err := h.queries.GetStage(h.ctx, db.GetStageParams{
+			ID:     stageId,
+			UserID: uuid,
+		}); err != nil {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+	}
+
+	tagIds := []pgtype.UUID{}
+	for _, id := range slices.Concat(body.AddTagIDs, body.RemoveTagIDs) {
+		tagId, _ := utils.ToUUID(id) // NOTE: Already validated by the binding
+
+		if _, err := h.queries.GetTag(h.ctx, db.GetTagParams{
+			ID:     tagId,
+			UserID: uuid,
+		}); err != nil {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		tagIds = append(tagIds, tagId)
+	}
+
+	tx, err := h.conn.Begin(h.ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	var jobApplicationIds []pgtype.UUID
+
+	if body.Filter != nil {
+		preferences, ok := h.userPreferences(c, uuid)
+		if !ok {
+			return
+		}
+
+		params, err := models.NewBulkJobApplicationsFilterParams(uuid, *body.Filter, maxBulkItems+1, preferences)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		if !h.checkRequestedSalaryCurrency(c, h.queries, params.SalaryCurrency, preferences) {
+			return
+		}
+
+		// NOTE: Looked up in the same transaction, with the rows locked, so that the filter can't stop matching them before they're changed
+		params.ForUpdate = true
+
+		jobApplications, err := h.queries.WithTx(tx).GetJobApplications(h.ctx, params)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		for _, jobApplication := range jobApplications {
+			jobApplicationIds = append(jobApplicationIds, jobApplication.ID)
+		}
+	} else {
+		jobApplicationIds = models.NewBulkJobApplicationIds(body.IDs)
+	}
+
+	if len(jobApplicationIds) > maxBulkItems {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("more than %d job applications selected", maxBulkItems),
+		})
+		return
+	}
+
+	items := []models.BulkJobApplicationsItem{}
+	valid := true
+
+	// NOTE: Every job application is changed, even once another one failed, so that each failure is reported.
+	// Each change runs in its own savepoint, since a failed statement would otherwise abort the whole transaction.
+	for _, jobApplicationId := range jobApplicationIds {
+		savepoint, err := tx.Begin(h.ctx)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		var message string
+
+		switch body.Action {
+		case models.BulkUpdate:
+			message, err = h.bulkUpdateJobApplication(h.queries.WithTx(savepoint), jobApplicationId, uuid, body, tagIds)
+		case models.BulkDelete:
+			_, err = h.queries.WithTx(savepoint).DeleteJobApplication(h.ctx, db.DeleteJobApplicationParams{
+				ID:     jobApplicationId,
+				UserID: uuid,
+			})
+			if err == pgx.ErrNoRows {
+				message, err = "job application not found", nil
+			}
+		}
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		if message != "" {
+			if err := savepoint.Rollback(h.ctx); err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+
+			items = append(items, models.BulkJobApplicationsItem{
+				ID:    jobApplicationId.String(),
+				Error: message,
+			})
+			valid = false
+			continue
+		}
+
+		if err := savepoint.Commit(h.ctx); err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		items = append(items, models.BulkJobApplicationsItem{
+			ID: jobApplicationId.String(),
+		})
+	}
+
+	if !valid {
+		c.AbortWithStatusJSON(http.StatusBadRequest, models.NewBulkJobApplicationsResBody(body.Action, items))
+		return
+	}
+
+	if err := tx.Commit(h.ctx); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewBulkJobApplicationsResBody(body.Action, items)
+
+	c.JSON(http.StatusOK, resBody)
+}
@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+const exportBatchSize = 500
+
+type jobApplicationsWriter interface {
+	Write(entry models.ExportJobApplicationEntry) error
+	Flush() error
+	Close() error
+}
+
+type csvJobApplicationsWriter struct {
+	csv *csv.Writer
+}
+
+func newCSVJobApplicationsWriter(w io.Writer) (*csvJobApplicationsWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(models.ExportJobApplicationsColumns); err != nil {
+		return nil, err
+	}
+	return &csvJobApplicationsWriter{csv: writer}, nil
+}
+
+func (w *csvJobApplicationsWriter) Write(entry models.ExportJobApplicationEntry) error {
+	record := []string{}
+	for _, cell := range models.NewExportJobApplicationCells(entry) {
+		switch v := cell.(type) {
+		case nil:
+			record = append(record, "")
+		case float64:
+			record = append(record, strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			record = append(record, strconv.FormatBool(v))
+		case string:
+			record = append(record, v)
+		}
+	}
+	return w.csv.Write(record)
+}
+
+func (w *csvJobApplicationsWriter) Flush() error {
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *csvJobApplicationsWriter) Close() error {
+	return w.Flush()
+}
+
+type jsonJobApplicationsWriter struct {
+	w       io.Writer
+	written bool
+}
+
+func newJSONJobApplicationsWriter(w io.Writer) (*jsonJobApplicationsWriter, error) {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return nil, err
+	}
+	return &jsonJobApplicationsWriter{w: w}, nil
+}
+
+func (w *jsonJobApplicationsWriter) Write(entry models.ExportJobApplicationEntry) error {
+	if w.written {
+		if _, err := io.WriteString(w.w, ","); err != nil {
+			return err
+		}
+	}
+	w.written = true
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(raw)
+	return err
+}
+
+func (w *jsonJobApplicationsWriter) Flush() error {
+	return nil
+}
+
+func (w *jsonJobApplicationsWriter) Close() error {
+	_, err := io.WriteString(w.w, "]")
+	return err
+}
+
+type xlsxJobApplicationsWriter struct {
+	xlsx *utils.XLSXWriter
+}
+
+func newXLSXJobApplicationsWriter(w io.Writer) (*xlsxJobApplicationsWriter, error) {
+	writer, err := utils.NewXLSXWriter(w, "Job applications")
+	if err != nil {
+		return nil, err
+	}
+
+	header := []any{}
+	for _, column := range models.ExportJobApplicationsColumns {
+		header = append(header, column)
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	return &xlsxJobApplicationsWriter{xlsx: writer}, nil
+}
+
+func (w *xlsxJobApplicationsWriter) Write(entry models.ExportJobApplicationEntry) error {
+	return w.xlsx.Write(models.NewExportJobApplicationCells(entry))
+}
+
+func (w *xlsxJobApplicationsWriter) Flush() error {
+	return w.xlsx.Flush()
+}
+
+func (w *xlsxJobApplicationsWriter) Close() error {
+	return w.xlsx.Close()
+}
+
+// ExportJobApplications godoc
+//
+//	@Summary		Export job applications
+//	@Description	Streams every job application matching the given filters, including notes and tags, as a CSV, JSON or XLSX file. Accepts the same filter and sort params as the job applications list, saved views included, but no page limit applies. CSV exports can be imported back as is.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Job application
+//	@Produce		text/csv
+//	@Produce		json
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Param			format						query		string		false	"File format"	Enums(csv, json, xlsx)	default(csv)
+//	@Param			view						query		string		false	"Saved view uuid, or default for the default view, whose params apply unless given explicitly"
+//	@Param			sort						query		string		false	"Comma-separated sortable column names, each prefixed with - to sort descending, from company_name, job_title, date_applied, stage, salary and is_replied, or relevance. Ties are broken by the next column."	default(-date_applied)
+//	@Param			q							query		string		false	"Full-text search across company name, job title, notes, and job posting url, matching word prefixes. Sorts by relevance unless another sort is given."
+//	@Param			company_name_or_job_title	query		string		false	"Company name or job title"
+//	@Param			date_applied				query		string		false	"Date applied (YYYY-MM-DD)"
+//	@Param			date_applied_from			query		string		false	"Earliest date applied (YYYY-MM-DD)"
+//	@Param			date_applied_to				query		string		false	"Latest date applied (YYYY-MM-DD)"
+//	@Param			applied_within_days			query		int			false	"Applied within the given number of days, today in the time zone of the user included"	minimum(1)
+//	@Param			stage_id					query		[]string	false	"Stage uuids"																			collectionFormat(multi)
+//	@Param			outcome						query		string		false	"Stage outcome"																			Enums(NEUTRAL, POSITIVE, NEGATIVE)
+//	@Param			min_salary					query		number		false	"Lowest acceptable salary, matched against the upper end of the salary range"			minimum(0)
+//	@Param			max_salary					query		number		false	"Highest acceptable salary, matched against the lower end of the salary range"			minimum(0)
+//	@Param			salary_currency				query		string		false	"ISO 4217 code of the currency salaries are filtered and sorted in, converted with the configured exchange rates, the preferred currency of the user by default, in which salaries in other currencies are left out while it has no exchange rate"
+//	@Param			salary_period				query		string		false	"Period salaries are filtered and sorted in, converted assuming 168 working hours a month"						Enums(HOUR, MONTH, YEAR)	default(MONTH)
+//	@Param			salary_type					query		string		false	"Gross or net, since salaries of the two are compared as they are, without being converted into one another"	Enums(GROSS, NET)
+//	@Param			is_replied					query		boolean		false	"Whether a reply was received"
+//	@Param			tags						query		[]string	false	"Tag uuids"														collectionFormat(multi)
+//	@Param			tags_match					query		string		false	"Whether applications must have any or all of the given tags"	Enums(any, all)	default(any)
+//	@Failure		400							{object}	models.Error
+//	@Failure		500							{object}	models.Error
+//	@Success		200							{array}		models.ExportJobApplicationEntry
+//	@Router			/job-applications/export [get]
+func (h *Handler) ExportJobApplications(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var queryParams models.ExportJobApplicationsQueryParams
+
+	if _, ok := h.bindJobApplicationsQuery(c, uuid, &queryParams); !ok {
+		return
+	}
+
+	if queryParams.Format == "" {
+		queryParams.Format = models.ExportFormatCSV
+	}
+
+	preferences, ok := h.userPreferences(c, uuid)
+	if !ok {
+		return
+	}
+
+	params, err := models.NewGetJobApplicationsParams(uuid, queryParams.JobApplicationsQueryParams, preferences)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !h.checkRequestedSalaryCurrency(c, h.queries, params.SalaryCurrency, preferences) {
+		return
+	}
+
+	params.Limit = exportBatchSize
+	params.Offset = 0
+	params.WithTotal = false
+
+	// NOTE: Rows are read in batches, all from the same snapshot, so that concurrent changes can't shift them between pages
+	tx, err := h.conn.BeginTx(h.ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	queries := h.queries.WithTx(tx)
+
+	nextBatch := func() ([]models.ExportJobApplicationEntry, error) {
+		jobApplications, err := queries.GetJobApplications(h.ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		jobApplicationIds := []pgtype.UUID{}
+		for _, jobApplication := range jobApplications {
+			jobApplicationIds = append(jobApplicationIds, jobApplication.ID)
+		}
+
+		tags, err := queries.GetJobApplicationTags(h.ctx, db.GetJobApplicationTagsParams{
+			JobApplicationIds: jobApplicationIds,
+			UserID:            uuid,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// NOTE: Each batch starts right after the last row of the previous one, which keeps deep batches as fast as the first
+		if len(jobApplications) > 0 {
+			params = models.NewGetJobApplicationsParamsAfter(params, jobApplications[len(jobApplications)-1])
+		}
+
+		return models.NewExportJobApplicationEntries(jobApplications, tags), nil
+	}
+
+	// NOTE: The first batch is read upfront, so that database errors can still be reported before the response is committed
+	entries, err := nextBatch()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	filename := "job-applications-" + time.Now().UTC().Format(time.DateOnly) + "." + string(queryParams.Format)
+
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+
+	var writer jobApplicationsWriter
+	switch queryParams.Format {
+	case models.ExportFormatCSV:
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		writer, err = newCSVJobApplicationsWriter(c.Writer)
+	case models.ExportFormatJSON:
+		c.Header("Content-Type", "application/json; charset=utf-8")
+		writer, err = newJSONJobApplicationsWriter(c.Writer)
+	case models.ExportFormatXLSX:
+		c.Header("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		writer, err = newXLSXJobApplicationsWriter(c.Writer)
+	}
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// NOTE: Once streaming started the status can't change anymore, so a failure can only cut the file short
+	for {
+		for _, entry := range entries {
+			if err := writer.Write(entry); err != nil {
+				c.Error(err)
+				return
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			c.Error(err)
+			return
+		}
+		c.Writer.Flush()
+
+		if len(entries) < exportBatchSize {
+			break
+		}
+
+		entries, err = nextBatch()
+		if err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		c.Error(err)
+		return
+	}
+}
@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+const (
+	maxImportFileSize = 5 << 20
+	maxImportRows     = 5000
+)
+
+// ImportJobApplications godoc
+//
+//	@Summary		Import job applications
+//	@Description	Imports job applications from a CSV file. Each row is validated like the body of a single job application and the whole file is saved in one transaction, so either every row is imported or none is.
+//	@Description	The mapping is a JSON object pairing job application fields with CSV column names, e.g. {"companyName": "Company", "jobTitle": "Position", "dateApplied": "Applied on"}. Without it columns named exactly like the fields are used.
+//	@Description	Salaries are read in the number format of the locale the user prefers, e.g. "7 500,50" for pl-PL or "7,500.50" for en-US. Values in any other format are reported as invalid rather than guessed at.
+//	@Description	With dryRun set every row is checked against the database, but nothing is saved.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Job application
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			dryRun	query		bool	false	"Validate without saving"	default(false)
+//	@Param			file	formData	file	true	"CSV file with a header row"
+//	@Param			mapping	formData	string	false	"Field to column mapping"
+//	@Failure		400		{object}	models.ImportJobApplicationsResBody
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.ImportJobApplicationsResBody
+//	@Success		201		{object}	models.ImportJobApplicationsResBody
+//	@Router			/job-applications/import [post]
+func (h *Handler) ImportJobApplications(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var queryParams models.ImportJobApplicationsQueryParams
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if fileHeader.Size > maxImportFileSize {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("file exceeds the %d MB limit", maxImportFileSize>>20),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "file is empty",
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// NOTE: Spreadsheet apps like Excel prepend a byte order mark to UTF-8 exports
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+
+	mapping := models.NewImportJobApplicationsMapping(header)
+	if raw := c.PostForm("mapping"); raw != "" {
+		mapping = map[string]string{}
+		if err := json.Unmarshal([]byte(raw), &mapping); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "mapping must be a JSON object of field and column names",
+			})
+			return
+		}
+	}
+
+	columns := map[string]int{}
+	for field, column := range mapping {
+		if !slices.Contains(models.ImportJobApplicationsFields, field) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("unknown field %q in mapping", field),
+			})
+			return
+		}
+
+		i := slices.Index(header, column)
+		if i == -1 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("column %q not found in the header", column),
+			})
+			return
+		}
+
+		columns[field] = i
+	}
+
+	preferences, ok := h.userPreferences(c, uuid)
+	if !ok {
+		return
+	}
+
+	rows := []models.ImportJobApplicationsRow{}
+	bodies := []models.CreateJobApplicationReqBody{}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		// NOTE: Rows with a missing or extra cell are still read, and reported like any other invalid row
+		if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		if len(rows) == maxImportRows {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("file exceeds the %d rows limit", maxImportRows),
+			})
+			return
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		values := map[string]string{}
+		for field, i := range columns {
+			if i < len(record) {
+				values[field] = strings.TrimSpace(record[i])
+			}
+		}
+
+		body, errs := models.NewImportedJobApplicationReqBody(values, preferences)
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		if err := binding.Validator.ValidateStruct(body); err != nil {
+			errs = append(errs, strings.Split(err.Error(), "\n")...)
+		}
+
+		rows = append(rows, models.ImportJobApplicationsRow{
+			Row:    line,
+			Errors: errs,
+		})
+		bodies = append(bodies, body)
+	}
+
+	if len(rows) == 0 {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "file has no rows",
+		})
+		return
+	}
+
+	tx, err := h.conn.Begin(h.ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	ids := make([]string, len(rows))
+	valid := true
+
+	// NOTE: Every valid row is inserted, even on a dry run or once another row failed, so that database errors are reported too.
+	// Each insert runs in its own savepoint, since a failed statement would otherwise abort the whole transaction.
+	for i, body := range bodies {
+		if len(rows[i].Errors) > 0 {
+			valid = false
+			continue
+		}
+
+		savepoint, err := tx.Begin(h.ctx)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		jobApplication, err := h.queries.WithTx(savepoint).CreateJobApplication(h.ctx, models.NewCreateJobApplicationParams(uuid, body, preferences))
+		if err != nil {
+			message, ok := jobApplicationErrorMessage(err)
+			if !ok {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+
+			if err := savepoint.Rollback(h.ctx); err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+
+			rows[i].Errors = append(rows[i].Errors, message)
+			valid = false
+			continue
+		}
+
+		if err := savepoint.Commit(h.ctx); err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		ids[i] = jobApplication.ID.String()
+	}
+
+	if !valid {
+		c.AbortWithStatusJSON(http.StatusBadRequest, models.NewImportJobApplicationsResBody(queryParams.DryRun, rows))
+		return
+	}
+
+	if queryParams.DryRun {
+		c.JSON(http.StatusOK, models.NewImportJobApplicationsResBody(queryParams.DryRun, rows))
+		return
+	}
+
+	if err := tx.Commit(h.ctx); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for i := range rows {
+		rows[i].ID = ids[i]
+	}
+
+	resBody := models.NewImportJobApplicationsResBody(queryParams.DryRun, rows)
+
+	c.JSON(http.StatusCreated, resBody)
+}
@@ -0,0 +1,581 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+const (
+	totpIssuer              = "Career Compass"
+	mfaTokenAudience        = "mfa"
+	maxMFAChallengeAttempts = 5
+	maxMFAAttempts          = 10
+	mfaLockoutMinutes       = 15
+	recoveryCodesCount      = 10
+)
+
+var errTooManyMFAAttempts = errors.New("too many invalid codes, try again later")
+
+// signMFAToken starts a challenge, which the token identifies, so that it can be completed once and only with a few attempts
+func (h *Handler) signMFAToken(userId pgtype.UUID, email string) (string, error) {
+	challenge, err := h.queries.CreateMFAChallenge(h.ctx, userId)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"jti": challenge.ID.String(),
+		"uid": userId,
+		"sub": email,
+		"aud": mfaTokenAudience,
+		"exp": jwt.NewNumericDate(challenge.ExpiresAt.Time),
+	})
+
+	return token.SignedString([]byte(h.env.JWTSecret))
+}
+
+// verifyMFACode checks a TOTP code, or a single-use recovery code when allowed, after counting the attempt against the per-user limit.
+// A TOTP code is accepted once, so it can't be replayed while it's still valid.
+func (h *Handler) verifyMFACode(userId pgtype.UUID, secret, code string, allowRecoveryCode bool) (bool, error) {
+	if err := h.attemptMFA(userId); err != nil {
+		return false, err
+	}
+	return h.useMFACode(h.queries, userId, secret, code, allowRecoveryCode)
+}
+
+// attemptMFA counts an attempt against the per-user limit, outside of any transaction, so that failed attempts are kept
+func (h *Handler) attemptMFA(userId pgtype.UUID) error {
+	_, err := h.queries.AttemptMFA(h.ctx, db.AttemptMFAParams{
+		ID:             userId,
+		MaxAttempts:    maxMFAAttempts,
+		LockoutMinutes: mfaLockoutMinutes,
+	})
+	if err == pgx.ErrNoRows {
+		return errTooManyMFAAttempts
+	}
+	return err
+}
+
+// useMFACode accepts a code the way verifyMFACode does, without counting the attempt
+func (h *Handler) useMFACode(queries *db.Queries, userId pgtype.UUID, secret, code string, allowRecoveryCode bool) (bool, error) {
+	var err error
+	if step, ok := utils.ValidateTOTP(secret, code, time.Now()); ok {
+		_, err = queries.UseTOTPStep(h.ctx, db.UseTOTPStepParams{
+			ID:   userId,
+			Step: step,
+		})
+	} else if allowRecoveryCode {
+		_, err = queries.UseRecoveryCode(h.ctx, db.UseRecoveryCodeParams{
+			UserID: userId,
+			Code:   code,
+		})
+	} else {
+		err = pgx.ErrNoRows
+	}
+
+	if err == pgx.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, queries.ResetMFAAttempts(h.ctx, userId)
+}
+
+// abortMFACode responds to a code that wasn't accepted, with the given status unless too many codes were tried
+func abortMFACode(c *gin.Context, status int, err error) {
+	switch err {
+	case nil:
+		c.AbortWithStatusJSON(status, gin.H{
+			"error": "invalid code",
+		})
+	case errTooManyMFAAttempts:
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+			"error": err.Error(),
+		})
+	default:
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+	}
+}
+
+func (h *Handler) regenerateRecoveryCodes(userId pgtype.UUID) ([]string, error) {
+	codes, err := utils.GenerateRecoveryCodes(recoveryCodesCount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.queries.DeleteRecoveryCodes(h.ctx, userId); err != nil {
+		return nil, err
+	}
+
+	if err := h.queries.CreateRecoveryCodes(h.ctx, db.CreateRecoveryCodesParams{
+		UserID: userId,
+		Codes:  codes,
+	}); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// SignInMFA godoc
+//
+//	@Summary		Complete two-factor sign in
+//	@Description	Exchanges the MFA challenge token returned by sign in, together with a valid TOTP or recovery code, for an access token
+//	@Description	Each token signs in once and allows 5 attempts. After 10 codes tried without one being accepted, further ones are rejected for up to 15 minutes. A TOTP code is accepted only once.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.SignInMFAReqBody	true	"MFA challenge token and code"
+//	@Failure		400		{object}	models.Error
+//	@Failure		401		{object}	models.Error
+//	@Failure		429		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.SignInResBody
+//	@Router			/sign-in/mfa [post]
+func (h *Handler) SignInMFA(c *gin.Context) {
+	var body models.SignInMFAReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	claims := Claims{}
+	token, err := jwt.ParseWithClaims(body.MFAToken, &claims, func(t *jwt.Token) (interface{}, error) { return []byte(h.env.JWTSecret), nil }, jwt.WithAudience(mfaTokenAudience))
+	if err != nil || !token.Valid {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid or expired MFA token",
+		})
+		return
+	}
+
+	uuid, err := utils.ToUUID(claims.UserId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid or expired MFA token",
+		})
+		return
+	}
+
+	challengeId, err := utils.ToUUID(claims.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid or expired MFA token",
+		})
+		return
+	}
+
+	totp, err := h.queries.GetTOTP(h.ctx, uuid)
+	if err != nil || !totp.IsTotpEnabled {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid or expired MFA token",
+		})
+		return
+	}
+
+	if _, err := h.queries.AttemptMFAChallenge(h.ctx, db.AttemptMFAChallengeParams{
+		ID:          challengeId,
+		UserID:      uuid,
+		MaxAttempts: maxMFAChallengeAttempts,
+	}); err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid or expired MFA token",
+		})
+		return
+	} else if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := h.attemptMFA(uuid); err != nil {
+		abortMFACode(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	tx, err := h.conn.Begin(h.ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	queries := h.queries.WithTx(tx)
+
+	// NOTE: The challenge is claimed before the code is used up, so that a concurrent request with the same token waits for this one
+	// and, once it has signed in, fails without burning a recovery code. A rejected code rolls the claim back for the next attempt.
+	if _, err := queries.CompleteMFAChallenge(h.ctx, db.CompleteMFAChallengeParams{
+		ID:     challengeId,
+		UserID: uuid,
+	}); err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid or expired MFA token",
+		})
+		return
+	} else if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if ok, err := h.useMFACode(queries, uuid, totp.TotpSecret.String, body.Code, true); !ok {
+		abortMFACode(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	user, err := queries.GetUserById(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	session, err := queries.CreateSession(h.ctx, user.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(h.ctx); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	signed, err := h.signAccessToken(user.ID, user.Email, session.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody, err := models.NewSignInResBody(user, signed, session.RefreshToken)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// EnrollTOTP godoc
+//
+//	@Summary		Enroll in two-factor authentication
+//	@Description	Generates a new TOTP secret for the currently authenticated user. Two-factor authentication stays disabled until the enrollment is confirmed with a valid code.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			MFA
+//	@Accept			json
+//	@Produce		json
+//	@Failure		400	{object}	models.Error
+//	@Failure		404	{object}	models.Error
+//	@Failure		500	{object}	models.Error
+//	@Success		200	{object}	models.EnrollTOTPResBody
+//	@Router			/profile/mfa/totp [post]
+func (h *Handler) EnrollTOTP(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	user, err := h.queries.GetUserById(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	totp, err := h.queries.GetTOTP(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if totp.IsTotpEnabled {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "two-factor authentication is already enabled",
+		})
+		return
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := h.queries.UpdateTOTPSecret(h.ctx, db.UpdateTOTPSecretParams{
+		ID:         uuid,
+		TotpSecret: pgtype.Text{String: secret, Valid: true},
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewEnrollTOTPResBody(secret, utils.TOTPURI(secret, totpIssuer, user.Email))
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// ConfirmTOTP godoc
+//
+//	@Summary		Confirm two-factor authentication
+//	@Description	Enables two-factor authentication once the user proves their authenticator app generates valid codes. Returns a fresh set of one-time recovery codes.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			MFA
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.ConfirmTOTPReqBody	true	"TOTP code"
+//	@Failure		400		{object}	models.Error
+//	@Failure		429		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.RecoveryCodesResBody
+//	@Router			/profile/mfa/totp/confirm [post]
+func (h *Handler) ConfirmTOTP(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.ConfirmTOTPReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	totp, err := h.queries.GetTOTP(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if totp.IsTotpEnabled {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "two-factor authentication is already enabled",
+		})
+		return
+	}
+
+	if !totp.TotpSecret.Valid {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "two-factor authentication enrollment hasn't been started",
+		})
+		return
+	}
+
+	if ok, err := h.verifyMFACode(uuid, totp.TotpSecret.String, body.Code, false); !ok {
+		abortMFACode(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.queries.EnableTOTP(h.ctx, uuid); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	codes, err := h.regenerateRecoveryCodes(uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewRecoveryCodesResBody(codes)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// DisableTOTP godoc
+//
+//	@Summary		Disable two-factor authentication
+//	@Description	Disables two-factor authentication for the currently authenticated user. Requires a valid TOTP or recovery code.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			MFA
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.DisableTOTPReqBody	true	"TOTP or recovery code"
+//	@Failure		400		{object}	models.Error
+//	@Failure		429		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		204
+//	@Router			/profile/mfa/totp/disable [post]
+func (h *Handler) DisableTOTP(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.DisableTOTPReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	totp, err := h.queries.GetTOTP(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !totp.IsTotpEnabled {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "two-factor authentication isn't enabled",
+		})
+		return
+	}
+
+	if ok, err := h.verifyMFACode(uuid, totp.TotpSecret.String, body.Code, true); !ok {
+		abortMFACode(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.queries.DisableTOTP(h.ctx, uuid); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := h.queries.DeleteRecoveryCodes(h.ctx, uuid); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// RegenerateRecoveryCodes godoc
+//
+//	@Summary		Regenerate recovery codes
+//	@Description	Replaces all recovery codes of the currently authenticated user with a new set. Previously issued codes stop working. Requires a fresh TOTP code, recovery codes aren't accepted.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			MFA
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.RegenerateRecoveryCodesReqBody	true	"TOTP code"
+//	@Failure		400		{object}	models.Error
+//	@Failure		429		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		201		{object}	models.RecoveryCodesResBody
+//	@Router			/profile/mfa/recovery-codes [post]
+func (h *Handler) RegenerateRecoveryCodes(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.RegenerateRecoveryCodesReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	totp, err := h.queries.GetTOTP(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !totp.IsTotpEnabled {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "two-factor authentication isn't enabled",
+		})
+		return
+	}
+
+	if ok, err := h.verifyMFACode(uuid, totp.TotpSecret.String, body.Code, false); !ok {
+		abortMFACode(c, http.StatusBadRequest, err)
+		return
+	}
+
+	codes, err := h.regenerateRecoveryCodes(uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewRecoveryCodesResBody(codes)
+
+	c.JSON(http.StatusCreated, resBody)
+}
@@ -1,16 +1,29 @@
 package handlers
 
 import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
 )
 
 type Claims struct {
 	jwt.RegisteredClaims
-	UserId string `json:"uid"`
+	UserId    string `json:"uid"`
+	SessionId string `json:"sid"`
 }
 
 func (h *Handler) Auth() gin.HandlerFunc {
@@ -40,8 +53,230 @@ func (h *Handler) Auth() gin.HandlerFunc {
 			return
 		}
 
+		sessionId, err := utils.ToUUID(claims.SessionId)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "token is missing session id",
+			})
+			return
+		}
+
+		session, err := h.queries.GetActiveSession(h.ctx, sessionId)
+		if err != nil || session.UserID.String() != claims.UserId {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "session has been revoked or expired",
+			})
+			return
+		}
+
 		c.Set("userId", claims.UserId)
+		c.Set("sessionId", claims.SessionId)
+
+		c.Next()
+	}
+}
+
+const (
+	idempotencyKeyTTL       = 24 * time.Hour
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotencyWriter keeps a copy of the response body, so that it can be replayed
+type idempotencyWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *idempotencyWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *idempotencyWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// idempotencyPolicy adjusts how the keys of a route are scoped and what's kept of its responses
+type idempotencyPolicy struct {
+	// scope narrows down the keys of anonymous requests, which would otherwise share a single namespace.
+	// Anonymous requests without a scope aren't made idempotent.
+	scope func(body []byte) string
+	// redact strips what mustn't be kept in the database from a response before it's stored for replaying
+	redact func(status int, body []byte) []byte
+}
+
+// Idempotent makes retrying a request with the same Idempotency-Key header return the original response instead of handling it again.
+// Keys are scoped to the signed in user and expire after a day. Requests without the header are handled as usual.
+func (h *Handler) Idempotent() gin.HandlerFunc {
+	return h.idempotent(idempotencyPolicy{})
+}
+
+// IdempotentSignUp is Idempotent for the sign-up, whose keys are scoped to the email being registered.
+// The tokens are left out of the stored response, so a replayed sign-up has to be followed by a sign in.
+func (h *Handler) IdempotentSignUp() gin.HandlerFunc {
+	return h.idempotent(idempotencyPolicy{
+		scope: func(body []byte) string {
+			var reqBody models.SignUpReqBody
+			if err := json.Unmarshal(body, &reqBody); err != nil {
+				return ""
+			}
+			return strings.ToLower(strings.TrimSpace(reqBody.Email))
+		},
+		redact: func(status int, body []byte) []byte {
+			if status != http.StatusCreated {
+				return body
+			}
+
+			var resBody models.SignUpResBody
+			if err := json.Unmarshal(body, &resBody); err != nil {
+				return nil
+			}
+			resBody.Token = ""
+			resBody.RefreshToken = ""
+
+			redacted, err := json.Marshal(resBody)
+			if err != nil {
+				return nil
+			}
+			return redacted
+		},
+	})
+}
+
+func (h *Handler) idempotent(policy idempotencyPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader("Idempotency-Key")
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "Idempotency-Key header is too long",
+			})
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		var userId pgtype.UUID
+		if id := c.GetString("userId"); id != "" {
+			uuid, err := utils.ToUUID(id)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+			userId = uuid
+		} else {
+			scope := ""
+			if policy.scope != nil {
+				scope = policy.scope(body)
+			}
+			if scope == "" {
+				c.Next()
+				return
+			}
+
+			// NOTE: Hashed, so that the scope, e.g. an email, isn't kept next to the key
+			mac := hmac.New(sha256.New, []byte(h.env.JWTSecret))
+			mac.Write([]byte(scope + "\n" + key))
+			key = hex.EncodeToString(mac.Sum(nil))
+		}
+
+		// NOTE: Keyed, since the body of a sign-up contains the password
+		mac := hmac.New(sha256.New, []byte(h.env.JWTSecret))
+		mac.Write([]byte(c.Request.Method + " " + c.Request.URL.RequestURI() + "\n"))
+		mac.Write(body)
+		requestHash := hex.EncodeToString(mac.Sum(nil))
+
+		created, err := h.queries.CreateIdempotencyKey(h.ctx, db.CreateIdempotencyKeyParams{
+			UserID:      userId,
+			Key:         key,
+			RequestHash: requestHash,
+			ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(idempotencyKeyTTL), Valid: true},
+		})
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		if created == 0 {
+			stored, err := h.queries.GetIdempotencyKey(h.ctx, db.GetIdempotencyKeyParams{
+				UserID: userId,
+				Key:    key,
+			})
+			// NOTE: The key is gone if the original request has just failed, in which case it's safe to retry
+			if err == pgx.ErrNoRows || (err == nil && !stored.Status.Valid) {
+				c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+					"error": "a request with this Idempotency-Key is still being processed",
+				})
+				return
+			}
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+
+			if !hmac.Equal([]byte(stored.RequestHash), []byte(requestHash)) {
+				c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+					"error": "Idempotency-Key has already been used for a different request",
+				})
+				return
+			}
+
+			c.Header("Idempotent-Replayed", "true")
+			c.Data(int(stored.Status.Int32), gin.MIMEJSON+"; charset=utf-8", stored.Response)
+			c.Abort()
+			return
+		}
+
+		saved := false
+
+		// NOTE: Releases the key if the request failed on the server side, including a panic, so that it can be retried
+		defer func() {
+			if !saved {
+				h.queries.DeleteIdempotencyKey(h.ctx, db.DeleteIdempotencyKeyParams{
+					UserID: userId,
+					Key:    key,
+				})
+			}
+		}()
+
+		writer := &idempotencyWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
 
 		c.Next()
+
+		if writer.Status() < http.StatusInternalServerError {
+			response := writer.body.Bytes()
+			if policy.redact != nil {
+				response = policy.redact(writer.Status(), response)
+			}
+			if response == nil {
+				return
+			}
+
+			saved = h.queries.SaveIdempotencyKeyResponse(h.ctx, db.SaveIdempotencyKeyResponseParams{
+				Status:   int32(writer.Status()),
+				Response: response,
+				UserID:   userId,
+				Key:      key,
+			}) == nil
+		}
 	}
 }
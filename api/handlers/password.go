@@ -1,15 +1,12 @@
 package handlers
 
 import (
-	"bytes"
 	"fmt"
-	"html/template"
 	"net/http"
-	"net/smtp"
-	"path/filepath"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
 	"github.com/jakub-szewczyk/career-compass-gin/api/models"
 	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
 	"golang.org/x/crypto/bcrypt"
@@ -54,17 +51,7 @@ func (h *Handler) InitPasswordReset(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusNoContent, nil)
-
-	// TODO: Consider using goroutines
-	tmpl, err := template.ParseFiles(filepath.Join("templates", "reset-password.html"))
-	if err != nil {
-		fmt.Println("error loading template:", err)
-		return
-	}
-
-	var html bytes.Buffer
-	if err := tmpl.Execute(&html, struct {
+	if err := h.enqueueEmail(h.queries, user.Email, "Reset Your Password", "reset-password.html", struct {
 		FirstName string
 		Link      string
 		Year      int
@@ -73,25 +60,19 @@ func (h *Handler) InitPasswordReset(c *gin.Context) {
 		Link:      h.env.ResetPasswordURL + fmt.Sprintf("?token=%v", token),
 		Year:      time.Now().Year(),
 	}); err != nil {
-		fmt.Println("error rendering template:", err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
 		return
 	}
 
-	mime := "MIME-version: 1.0;\nContent-Type: text/html; charset=\"UTF-8\";\n\n"
-	subject := "Subject: Reset Your Password\n"
-
-	auth := smtp.PlainAuth(h.env.SMTPIdentity, h.env.SMTPUsername, h.env.SMTPPassword, h.env.SMTPHost)
-	err = smtp.SendMail(h.env.SMTPHost+":"+h.env.SMTPPORT, auth, h.env.SMTPUsername, []string{user.Email}, []byte(subject+mime+html.String()))
-	if err != nil {
-		fmt.Println("error sending email:", err)
-		return
-	}
+	c.JSON(http.StatusNoContent, nil)
 }
 
 // ResetPassword godoc
 //
 //	@Summary		Reset password
-//	@Description	Allows a user to set a new password using a valid reset token. This endpoint is typically used in the "forgot password" flow.
+//	@Description	Allows a user to set a new password using a valid reset token. This endpoint is typically used in the "forgot password" flow. All existing sessions of the user are revoked.
 //	@Tags			Password
 //	@Accept			json
 //	@Produce		json
@@ -110,13 +91,39 @@ func (h *Handler) ResetPassword(c *gin.Context) {
 		return
 	}
 
-	token, err := h.queries.GetPasswordResetToken(h.ctx, body.PasswordResetToken)
+	hash, err := bcrypt.GenerateFromPassword([]byte(body.Password), bcrypt.DefaultCost)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tx, err := h.conn.Begin(h.ctx)
 	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	queries := h.queries.WithTx(tx)
+
+	// NOTE: Consumed up front, so that concurrent requests with the same token can't both reset the password
+	token, err := queries.DeletePasswordResetToken(h.ctx, body.PasswordResetToken)
+	if err == pgx.ErrNoRows {
 		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
 			"error": "missing password reset token",
 		})
 		return
 	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
 
 	if token.ExpiresAt.Time.Before(time.Now()) {
 		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
@@ -125,25 +132,24 @@ func (h *Handler) ResetPassword(c *gin.Context) {
 		return
 	}
 
-	hash, err := bcrypt.GenerateFromPassword([]byte(body.Password), bcrypt.DefaultCost)
-	if err != nil {
+	if err := queries.UpdatePassword(h.ctx, db.UpdatePasswordParams{
+		ID:       token.UserID,
+		Password: string(hash),
+	}); err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
 
-	if err := h.queries.UpdatePassword(h.ctx, db.UpdatePasswordParams{
-		ID:       token.UserID,
-		Password: string(hash),
-	}); err != nil {
+	if err := queries.RevokeSessions(h.ctx, token.UserID); err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
 
-	if err := h.queries.DeletePasswordResetToken(h.ctx, token.Token); err != nil {
+	if err := tx.Commit(h.ctx); err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
@@ -1,16 +1,15 @@
 package handlers
 
 import (
-	"bytes"
 	"fmt"
-	"html/template"
 	"net/http"
-	"net/smtp"
-	"path/filepath"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
 	"github.com/jakub-szewczyk/career-compass-gin/utils"
 )
 
@@ -109,17 +108,10 @@ func (h *Handler) SendVerificationEmail(c *gin.Context) {
 		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
 			"error": err.Error(),
 		})
-	}
-
-	// TODO: Consider using goroutines
-	tmpl, err := template.ParseFiles(filepath.Join("templates", "sign-up.html"))
-	if err != nil {
-		fmt.Println("error loading template:", err)
 		return
 	}
 
-	var html bytes.Buffer
-	if err := tmpl.Execute(&html, struct {
+	if err := h.enqueueEmail(h.queries, user.Email, fmt.Sprintf("Welcome to Career Compass, %v!", user.FirstName), "sign-up.html", struct {
 		FirstName string
 		Link      string
 		Year      int
@@ -128,17 +120,9 @@ func (h *Handler) SendVerificationEmail(c *gin.Context) {
 		Link:      h.env.EmailVerificationURL + fmt.Sprintf("?token=%v", token.Token),
 		Year:      time.Now().Year(),
 	}); err != nil {
-		fmt.Println("error rendering template:", err)
-		return
-	}
-
-	mime := "MIME-version: 1.0;\nContent-Type: text/html; charset=\"UTF-8\";\n\n"
-	subject := fmt.Sprintf("Subject: Welcome to Career Compass, %v!\n", user.FirstName)
-
-	auth := smtp.PlainAuth(h.env.SMTPIdentity, h.env.SMTPUsername, h.env.SMTPPassword, h.env.SMTPHost)
-	err = smtp.SendMail(h.env.SMTPHost+":"+h.env.SMTPPORT, auth, h.env.SMTPUsername, []string{user.Email}, []byte(subject+mime+html.String()))
-	if err != nil {
-		fmt.Println("error sending email:", err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
 		return
 	}
 
@@ -220,3 +204,117 @@ func (h *Handler) VerifyEmail(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resBody)
 }
+
+// userPreferences loads the preferences every date, stat and salary of the user is relative to
+func (h *Handler) userPreferences(c *gin.Context, userId pgtype.UUID) (db.GetUserPreferencesRow, bool) {
+	preferences, err := h.queries.GetUserPreferences(h.ctx, userId)
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return preferences, false
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return preferences, false
+	}
+
+	return preferences, true
+}
+
+// Preferences godoc
+//
+//	@Summary		Get user preferences
+//	@Description	Retrieves the time zone, locale, week start and currency of the currently authenticated user.
+//	@Description	Dates applied are filtered and counted in the time zone, reminder emails are formatted for the locale, weekly stats start on the week start, and salaries default to the currency.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Profile
+//	@Accept			json
+//	@Produce		json
+//	@Failure		404	{object}	models.Error
+//	@Failure		500	{object}	models.Error
+//	@Success		200	{object}	models.PreferencesResBody
+//	@Router			/profile/preferences [get]
+func (h *Handler) Preferences(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	preferences, ok := h.userPreferences(c, uuid)
+	if !ok {
+		return
+	}
+
+	resBody := models.NewPreferencesResBody(preferences)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// UpdatePreferences godoc
+//
+//	@Summary		Update user preferences
+//	@Description	Replaces the time zone, locale, week start and currency of the currently authenticated user. The currency needs an exchange rate, so that salaries can be converted to it.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Profile
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.UpdatePreferencesReqBody	true	"User preferences"
+//	@Failure		400		{object}	models.Error
+//	@Failure		404		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.UpdatePreferencesResBody
+//	@Router			/profile/preferences [put]
+func (h *Handler) UpdatePreferences(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.UpdatePreferencesReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !h.checkSalaryCurrency(c, h.queries, body.Currency) {
+		return
+	}
+
+	preferences, err := h.queries.UpdateUserPreferences(h.ctx, models.NewUpdateUserPreferencesParams(uuid, body))
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewUpdatePreferencesResBody(preferences)
+
+	c.JSON(http.StatusOK, resBody)
+}
@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+// Reminders godoc
+//
+//	@Summary		Get reminders
+//	@Description	Lists reminders across all job applications, ordered by when they are due, including the follow-ups suggested for applications left without a reply
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Reminder
+//	@Accept			json
+//	@Produce		json
+//	@Param			status				query		string	false	"Reminder status"	Enums(PENDING, DELIVERED, DISMISSED)
+//	@Param			job_application_id	query		string	false	"Job application uuid"
+//	@Failure		400					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.RemindersResBody
+//	@Router			/reminders [get]
+func (h *Handler) Reminders(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var queryParams models.RemindersQueryParams
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	reminders, err := h.queries.GetReminders(h.ctx, models.NewGetRemindersParams(uuid, queryParams))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewRemindersResBody(reminders)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// CreateReminder godoc
+//
+//	@Summary		Create a reminder
+//	@Description	Schedules a reminder email for a job application, either at the given time or the given number of days from now.
+//	@Description	With unlessReplied set, e.g. to follow up if no reply comes within a week, the reminder is dismissed instead of sent once the application is marked as replied.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Reminder
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string							true	"Job application uuid"
+//	@Param			body				body		models.CreateReminderReqBody	true	"Reminder details"
+//	@Failure		400					{object}	models.Error
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		201					{object}	models.CreateReminderResBody
+//	@Router			/job-applications/{jobApplicationId}/reminders [post]
+func (h *Handler) CreateReminder(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.CreateReminderReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// NOTE: No rows are inserted when the job application doesn't belong to the user
+	preferences, ok := h.userPreferences(c, uuid)
+	if !ok {
+		return
+	}
+
+	reminder, err := h.queries.CreateReminder(h.ctx, models.NewCreateReminderParams(jobApplicationId, uuid, body, preferences))
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewCreateReminderResBody(reminder)
+
+	c.JSON(http.StatusCreated, resBody)
+}
+
+// SnoozeReminder godoc
+//
+//	@Summary		Snooze a reminder
+//	@Description	Postpones a reminder until the given time or by the given number of days from now. Snoozing a delivered or dismissed reminder schedules it again.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Reminder
+//	@Accept			json
+//	@Produce		json
+//	@Param			reminderId	path		string							true	"Reminder uuid"
+//	@Param			body		body		models.SnoozeReminderReqBody	true	"Snooze details"
+//	@Failure		400			{object}	models.Error
+//	@Failure		404			{object}	models.Error
+//	@Failure		500			{object}	models.Error
+//	@Success		200			{object}	models.SnoozeReminderResBody
+//	@Router			/reminders/{reminderId}/snooze [post]
+func (h *Handler) SnoozeReminder(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	reminderId, err := utils.ToUUID(c.Param("reminderId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.SnoozeReminderReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	preferences, ok := h.userPreferences(c, uuid)
+	if !ok {
+		return
+	}
+
+	reminder, err := h.queries.SnoozeReminder(h.ctx, models.NewSnoozeReminderParams(reminderId, uuid, body, preferences))
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewSnoozeReminderResBody(reminder)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// DismissReminder godoc
+//
+//	@Summary		Dismiss a reminder
+//	@Description	Dismisses a reminder, so that it is never sent, or marks an already sent one as done
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Reminder
+//	@Accept			json
+//	@Produce		json
+//	@Param			reminderId	path		string	true	"Reminder uuid"
+//	@Failure		404			{object}	models.Error
+//	@Failure		500			{object}	models.Error
+//	@Success		200			{object}	models.DismissReminderResBody
+//	@Router			/reminders/{reminderId}/dismiss [post]
+func (h *Handler) DismissReminder(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	reminderId, err := utils.ToUUID(c.Param("reminderId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	reminder, err := h.queries.DismissReminder(h.ctx, db.DismissReminderParams{
+		ID:     reminderId,
+		UserID: uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewDismissReminderResBody(reminder)
+
+	c.JSON(http.StatusOK, resBody)
+}
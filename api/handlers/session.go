@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+// NOTE: Access tokens are short-lived, refresh tokens (30 days) are stored in the sessions table
+const accessTokenTTL = time.Minute * 15
+
+func (h *Handler) signAccessToken(userId pgtype.UUID, email string, sessionId pgtype.UUID) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"uid": userId,
+		"sid": sessionId,
+		"sub": email,
+		"exp": jwt.NewNumericDate(time.Now().Add(accessTokenTTL)),
+	})
+
+	return token.SignedString([]byte(h.env.JWTSecret))
+}
+
+// RefreshToken godoc
+//
+//	@Summary		Refresh access token
+//	@Description	Exchanges a valid refresh token for a new access token. The refresh token is rotated, meaning the one provided can't be used again.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.RefreshTokenReqBody	true	"Refresh token"
+//	@Failure		400		{object}	models.Error
+//	@Failure		401		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.RefreshTokenResBody
+//	@Router			/token/refresh [post]
+func (h *Handler) RefreshToken(c *gin.Context) {
+	var body models.RefreshTokenReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	session, err := h.queries.RotateRefreshToken(h.ctx, body.RefreshToken)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid or expired refresh token",
+		})
+		return
+	}
+
+	signed, err := h.signAccessToken(session.UserID, session.Email, session.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewRefreshTokenResBody(signed, session.RefreshToken)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// SignOut godoc
+//
+//	@Summary		User sign out
+//	@Description	Revokes the session associated with the provided access token. Its refresh token can no longer be used.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Failure		401	{object}	models.Error
+//	@Failure		500	{object}	models.Error
+//	@Success		204
+//	@Router			/sign-out [post]
+func (h *Handler) SignOut(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+	sessionId := c.MustGet("sessionId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	sessionUUID, err := utils.ToUUID(sessionId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := h.queries.RevokeSession(h.ctx, db.RevokeSessionParams{
+		ID:     sessionUUID,
+		UserID: uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// SignOutAll godoc
+//
+//	@Summary		User sign out everywhere
+//	@Description	Revokes every session of the currently authenticated user, signing them out on all devices
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Failure		401	{object}	models.Error
+//	@Failure		500	{object}	models.Error
+//	@Success		204
+//	@Router			/sign-out/all [post]
+func (h *Handler) SignOutAll(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := h.queries.RevokeSessions(h.ctx, uuid); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+// abortWithStageNameError reports duplicate stage names as a client error
+func abortWithStageNameError(c *gin.Context, err error) {
+	if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == pgerrcode.UniqueViolation && pgErr.ConstraintName == "unique_stage_name" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "a stage with this name already exists",
+		})
+		return
+	}
+
+	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+		"error": err.Error(),
+	})
+}
+
+// Stages godoc
+//
+//	@Summary		Get pipeline stages
+//	@Description	Retrieves every pipeline stage defined by the currently authenticated user, ordered by position
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Stage
+//	@Accept			json
+//	@Produce		json
+//	@Failure		500	{object}	models.Error
+//	@Success		200	{object}	models.StagesResBody
+//	@Router			/stages [get]
+func (h *Handler) Stages(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stages, err := h.queries.GetStages(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewStagesResBody(stages)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// Stage godoc
+//
+//	@Summary		Retrieve pipeline stage details
+//	@Description	Fetches the details of a specific pipeline stage by its id
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Stage
+//	@Accept			json
+//	@Produce		json
+//	@Param			stageId	path		string	true	"Stage uuid"
+//	@Failure		404		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.StageResBody
+//	@Router			/stages/{stageId} [get]
+func (h *Handler) Stage(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stageId, err := utils.ToUUID(c.Param("stageId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stage, err := h.queries.GetStage(h.ctx, db.GetStageParams{
+		ID:     stageId,
+		UserID: uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewStageResBody(stage)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// CreateStage godoc
+//
+//	@Summary		Create a pipeline stage
+//	@Description	Defines a new pipeline stage. Terminal stages mark the end of the process, while the outcome tells whether it ended well or not.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Stage
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.CreateStageReqBody	true	"Stage details"
+//	@Failure		400		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		201		{object}	models.CreateStageResBody
+//	@Router			/stages [post]
+func (h *Handler) CreateStage(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.CreateStageReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stage, err := h.queries.CreateStage(h.ctx, models.NewCreateStageParams(uuid, body))
+	if err != nil {
+		abortWithStageNameError(c, err)
+		return
+	}
+
+	resBody := models.NewCreateStageResBody(stage)
+
+	c.JSON(http.StatusCreated, resBody)
+}
+
+// UpdateStage godoc
+//
+//	@Summary		Update a pipeline stage
+//	@Description	Updates an existing pipeline stage with the provided details
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Stage
+//	@Accept			json
+//	@Produce		json
+//	@Param			stageId	path		string						true	"Stage uuid"
+//	@Param			body	body		models.UpdateStageReqBody	true	"Stage details"
+//	@Failure		400		{object}	models.Error
+//	@Failure		404		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.UpdateStageResBody
+//	@Router			/stages/{stageId} [put]
+func (h *Handler) UpdateStage(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stageId, err := utils.ToUUID(c.Param("stageId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.UpdateStageReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stage, err := h.queries.UpdateStage(h.ctx, models.NewUpdateStageParams(stageId, uuid, body))
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		abortWithStageNameError(c, err)
+		return
+	}
+
+	resBody := models.NewUpdateStageResBody(stage)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// DeleteStage godoc
+//
+//	@Summary		Delete a pipeline stage
+//	@Description	Deletes an existing pipeline stage. Stages still assigned to job applications can't be deleted.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Stage
+//	@Accept			json
+//	@Produce		json
+//	@Param			stageId	path		string	true	"Stage uuid"
+//	@Failure		400		{object}	models.Error
+//	@Failure		404		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.DeleteStageResBody
+//	@Router			/stages/{stageId} [delete]
+func (h *Handler) DeleteStage(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stageId, err := utils.ToUUID(c.Param("stageId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stage, err := h.queries.DeleteStage(h.ctx, db.DeleteStageParams{
+		ID:     stageId,
+		UserID: uuid,
+	})
+
+	if pgErr, ok := err.(*pgconn.PgError); err != nil && ok {
+		if pgErr.Code == pgerrcode.ForeignKeyViolation && pgErr.ConstraintName == "job_applications_stage_id_fkey" {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "stage is still assigned to job applications",
+			})
+		} else {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewDeleteStageResBody(stage)
+
+	c.JSON(http.StatusOK, resBody)
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+// Stats godoc
+//
+//	@Summary		Get job application stats
+//	@Description	Aggregates job applications into dashboard stats: counts per stage, both current and ever reached, reply and acceptance rates, median days from applying to the first reply or decision, applications per week, and the salary distribution.
+//	@Description	Dates are inclusive. Days to a reply are counted from the start of the day applied in the time zone of the user, and weeks start on the day the user prefers. Grouping adds the same stats per company or per job title, on top of the overall ones.
+//	@Description	Salaries are converted to the requested currency and period first, using the configured exchange rates. Gross and net amounts are mixed as they are unless salary_type is given, and salaries in a currency without an exchange rate are left out.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Stats
+//	@Accept			json
+//	@Produce		json
+//	@Param			from			query		string	false	"Earliest date applied (YYYY-MM-DD)"
+//	@Param			to				query		string	false	"Latest date applied (YYYY-MM-DD)"
+//	@Param			group_by		query		string	false	"Break the stats down per company or job title"	Enums(company, job_title)
+//	@Param			salary_currency	query		string	false	"ISO 4217 code of the currency the salary distribution is given in, the preferred currency of the user by default, in which salaries in other currencies are left out while it has no exchange rate"
+//	@Param			salary_period	query		string	false	"Period the salary distribution is given in"						Enums(HOUR, MONTH, YEAR)	default(MONTH)
+//	@Param			salary_type		query		string	false	"Gross or net, to keep the salary distribution from mixing the two"	Enums(GROSS, NET)
+//	@Failure		400				{object}	models.Error
+//	@Failure		500				{object}	models.Error
+//	@Success		200				{object}	models.StatsResBody
+//	@Router			/stats [get]
+func (h *Handler) Stats(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var queryParams models.StatsQueryParams
+
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// NOTE: Every query reads from the same snapshot, so that the numbers add up
+	tx, err := h.conn.BeginTx(h.ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	queries := h.queries.WithTx(tx)
+
+	preferences, ok := h.userPreferences(c, uuid)
+	if !ok {
+		return
+	}
+
+	params := models.NewGetJobApplicationStatsParams(uuid, queryParams, preferences)
+
+	if !h.checkRequestedSalaryCurrency(c, queries, params.SalaryCurrency, preferences) {
+		return
+	}
+
+	stages, err := queries.GetStages(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stats, err := queries.GetJobApplicationStats(h.ctx, params)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	stageStats, err := queries.GetJobApplicationStageStats(h.ctx, models.NewGetJobApplicationStageStatsParams(params))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	weeklyStats, err := queries.GetJobApplicationWeeklyStats(h.ctx, models.NewGetJobApplicationWeeklyStatsParams(params, preferences))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewStatsResBody(queryParams, params, stages, stats, stageStats, weeklyStats)
+
+	c.JSON(http.StatusOK, resBody)
+}
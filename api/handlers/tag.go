@@ -0,0 +1,383 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+// abortWithTagNameError reports names clashing with an existing tag as a client error
+func abortWithTagNameError(c *gin.Context, err error) {
+	if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == pgerrcode.UniqueViolation && pgErr.ConstraintName == "unique_tag_name" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "a tag with this name already exists",
+		})
+		return
+	}
+
+	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+		"error": err.Error(),
+	})
+}
+
+// Tags godoc
+//
+//	@Summary		Get tags
+//	@Description	Retrieves every tag defined by the user, ordered by name
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Tag
+//	@Accept			json
+//	@Produce		json
+//	@Failure		500	{object}	models.Error
+//	@Success		200	{object}	models.TagsResBody
+//	@Router			/tags [get]
+func (h *Handler) Tags(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tags, err := h.queries.GetTags(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewTagsResBody(tags)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// CreateTag godoc
+//
+//	@Summary		Create a tag
+//	@Description	Creates a new tag. The colour defaults to slate grey when omitted.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.CreateTagReqBody	true	"Tag details"
+//	@Failure		400		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		201		{object}	models.CreateTagResBody
+//	@Router			/tags [post]
+func (h *Handler) CreateTag(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.CreateTagReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tag, err := h.queries.CreateTag(h.ctx, models.NewCreateTagParams(uuid, body))
+	if err != nil {
+		abortWithTagNameError(c, err)
+		return
+	}
+
+	resBody := models.NewCreateTagResBody(tag)
+
+	c.JSON(http.StatusCreated, resBody)
+}
+
+// UpdateTag godoc
+//
+//	@Summary		Update a tag
+//	@Description	Updates the name or colour of an existing tag
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			tagId	path		string					true	"Tag uuid"
+//	@Param			body	body		models.UpdateTagReqBody	true	"Tag details"
+//	@Failure		400		{object}	models.Error
+//	@Failure		404		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.UpdateTagResBody
+//	@Router			/tags/{tagId} [put]
+func (h *Handler) UpdateTag(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tagId, err := utils.ToUUID(c.Param("tagId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.UpdateTagReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tag, err := h.queries.UpdateTag(h.ctx, models.NewUpdateTagParams(tagId, uuid, body))
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		abortWithTagNameError(c, err)
+		return
+	}
+
+	resBody := models.NewUpdateTagResBody(tag)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// DeleteTag godoc
+//
+//	@Summary		Delete a tag
+//	@Description	Deletes an existing tag and removes it from every job application
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			tagId	path		string	true	"Tag uuid"
+//	@Failure		404		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.DeleteTagResBody
+//	@Router			/tags/{tagId} [delete]
+func (h *Handler) DeleteTag(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tagId, err := utils.ToUUID(c.Param("tagId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tag, err := h.queries.DeleteTag(h.ctx, db.DeleteTagParams{
+		ID:     tagId,
+		UserID: uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewDeleteTagResBody(tag)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// TagJobApplication godoc
+//
+//	@Summary		Tag a job application
+//	@Description	Attaches an existing tag to a job application. Attaching an already attached tag is a no-op.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			tagId				path		string	true	"Tag uuid"
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.JobApplicationTagsResBody
+//	@Router			/job-applications/{jobApplicationId}/tags/{tagId} [put]
+func (h *Handler) TagJobApplication(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tagId, err := utils.ToUUID(c.Param("tagId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.queries.GetJobApplication(h.ctx, db.GetJobApplicationParams{
+		ID:     jobApplicationId,
+		UserID: uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.queries.GetTag(h.ctx, db.GetTagParams{
+		ID:     tagId,
+		UserID: uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.queries.TagJobApplication(h.ctx, db.TagJobApplicationParams{
+		JobApplicationID: jobApplicationId,
+		TagID:            tagId,
+		UserID:           uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tags, err := h.queries.GetJobApplicationTags(h.ctx, db.GetJobApplicationTagsParams{
+		JobApplicationIds: []pgtype.UUID{jobApplicationId},
+		UserID:            uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewJobApplicationTagsResBody(tags)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// UntagJobApplication godoc
+//
+//	@Summary		Untag a job application
+//	@Description	Removes a tag from a job application without deleting the tag
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Param			tagId				path		string	true	"Tag uuid"
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.JobApplicationTagsResBody
+//	@Router			/job-applications/{jobApplicationId}/tags/{tagId} [delete]
+func (h *Handler) UntagJobApplication(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tagId, err := utils.ToUUID(c.Param("tagId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.queries.UntagJobApplication(h.ctx, db.UntagJobApplicationParams{
+		JobApplicationID: jobApplicationId,
+		TagID:            tagId,
+		UserID:           uuid,
+	}); err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tags, err := h.queries.GetJobApplicationTags(h.ctx, db.GetJobApplicationTagsParams{
+		JobApplicationIds: []pgtype.UUID{jobApplicationId},
+		UserID:            uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewJobApplicationTagsResBody(tags)
+
+	c.JSON(http.StatusOK, resBody)
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+// Trash godoc
+//
+//	@Summary		Get trash
+//	@Description	Lists deleted job applications, most recently deleted first, along with when each of them is going to be permanently deleted
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Trash
+//	@Accept			json
+//	@Produce		json
+//	@Failure		500	{object}	models.Error
+//	@Success		200	{object}	models.TrashResBody
+//	@Router			/trash [get]
+func (h *Handler) Trash(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplications, err := h.queries.GetTrashedJobApplications(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewTrashResBody(jobApplications)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// RestoreJobApplication godoc
+//
+//	@Summary		Restore a job application
+//	@Description	Brings a deleted job application back from the trash, along with its interviews, contacts, tags, reminders and attachments
+//
+//	@Security		BearerAuth
+//
+//	@Tags			Trash
+//	@Accept			json
+//	@Produce		json
+//	@Param			jobApplicationId	path		string	true	"Job application uuid"
+//	@Failure		404					{object}	models.Error
+//	@Failure		500					{object}	models.Error
+//	@Success		200					{object}	models.RestoreJobApplicationResBody
+//	@Router			/job-applications/{jobApplicationId}/restore [post]
+func (h *Handler) RestoreJobApplication(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	jobApplicationId, err := utils.ToUUID(c.Param("jobApplicationId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// NOTE: No rows are updated when the job application isn't in the trash
+	jobApplication, err := h.queries.RestoreJobApplication(h.ctx, db.RestoreJobApplicationParams{
+		ID:     jobApplicationId,
+		UserID: uuid,
+	})
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewRestoreJobApplicationResBody(jobApplication)
+
+	c.JSON(http.StatusOK, resBody)
+}
@@ -0,0 +1,414 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+// abortWithViewNameError reports names clashing with an existing view as a client error
+func abortWithViewNameError(c *gin.Context, err error) {
+	if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == pgerrcode.UniqueViolation && pgErr.ConstraintName == "unique_view_name" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": "a view with this name already exists",
+		})
+		return
+	}
+
+	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+		"error": err.Error(),
+	})
+}
+
+// bindJobApplicationsQuery binds the job applications list params, starting from the saved view picked by the view param, if any.
+// Params given explicitly take precedence over the ones saved in the view. The merged params are returned for the handlers to inspect.
+func (h *Handler) bindJobApplicationsQuery(c *gin.Context, userId pgtype.UUID, queryParams any) (url.Values, bool) {
+	values := c.Request.URL.Query()
+
+	if values.Has("view") {
+		var query string
+
+		if viewId := values.Get("view"); viewId == models.DefaultView {
+			// NOTE: Having no default view isn't an error, the list is simply left unfiltered
+			view, err := h.queries.GetDefaultView(h.ctx, userId)
+			if err != nil && err != pgx.ErrNoRows {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": err.Error(),
+				})
+				return nil, false
+			}
+			query = view.Query
+		} else {
+			viewUuid, err := utils.ToUUID(viewId)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"error": err.Error(),
+				})
+				return nil, false
+			}
+
+			view, err := h.queries.GetView(h.ctx, db.GetViewParams{
+				ID:     viewUuid,
+				UserID: userId,
+			})
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+					"error": err.Error(),
+				})
+				return nil, false
+			}
+			query = view.Query
+		}
+
+		// NOTE: Saved queries are validated on save, so a parse error here can only mean a corrupted row
+		saved, err := url.ParseQuery(query)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return nil, false
+		}
+
+		values.Del("view")
+		for key, value := range values {
+			saved[key] = value
+		}
+		values = saved
+	}
+
+	if err := models.BindJobApplicationsQueryParams(values, queryParams); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return nil, false
+	}
+
+	return values, true
+}
+
+// Views godoc
+//
+//	@Summary		Get views
+//	@Description	Retrieves every saved view of the job applications list, the default one first, then ordered by name
+//
+//	@Security		BearerAuth
+//
+//	@Tags			View
+//	@Accept			json
+//	@Produce		json
+//	@Failure		500	{object}	models.Error
+//	@Success		200	{object}	models.ViewsResBody
+//	@Router			/views [get]
+func (h *Handler) Views(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	views, err := h.queries.GetViews(h.ctx, uuid)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewViewsResBody(views)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// View godoc
+//
+//	@Summary		Get a view
+//	@Description	Retrieves a single saved view of the job applications list
+//
+//	@Security		BearerAuth
+//
+//	@Tags			View
+//	@Accept			json
+//	@Produce		json
+//	@Param			viewId	path		string	true	"View uuid"
+//	@Failure		404		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.ViewResBody
+//	@Router			/views/{viewId} [get]
+func (h *Handler) View(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	viewId, err := utils.ToUUID(c.Param("viewId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	view, err := h.queries.GetView(h.ctx, db.GetViewParams{
+		ID:     viewId,
+		UserID: uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewViewResBody(view)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// CreateView godoc
+//
+//	@Summary		Create a view
+//	@Description	Saves a combination of job applications list params, such as filters, sort and page size, under a name.
+//	@Description	The query accepts the same params as the job applications list, except for page and cursor. Marking the view as the default one takes that over from any other view.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			View
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		models.CreateViewReqBody	true	"View details"
+//	@Failure		400		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		201		{object}	models.CreateViewResBody
+//	@Router			/views [post]
+func (h *Handler) CreateView(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.CreateViewReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	body.Query, err = models.NewViewQuery(body.Query)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tx, err := h.conn.Begin(h.ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	queries := h.queries.WithTx(tx)
+
+	if body.IsDefault {
+		if err := queries.ClearDefaultView(h.ctx, uuid); err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+	}
+
+	view, err := queries.CreateView(h.ctx, models.NewCreateViewParams(uuid, body))
+	if err != nil {
+		abortWithViewNameError(c, err)
+		return
+	}
+
+	if err := tx.Commit(h.ctx); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewCreateViewResBody(view)
+
+	c.JSON(http.StatusCreated, resBody)
+}
+
+// UpdateView godoc
+//
+//	@Summary		Update a view
+//	@Description	Updates the name, query or default flag of an existing view. Omitted fields are left as they are, while an empty query clears every saved param.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			View
+//	@Accept			json
+//	@Produce		json
+//	@Param			viewId	path		string						true	"View uuid"
+//	@Param			body	body		models.UpdateViewReqBody	true	"View details"
+//	@Failure		400		{object}	models.Error
+//	@Failure		404		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.UpdateViewResBody
+//	@Router			/views/{viewId} [put]
+func (h *Handler) UpdateView(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	viewId, err := utils.ToUUID(c.Param("viewId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var body models.UpdateViewReqBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if body.Query != nil {
+		query, err := models.NewViewQuery(*body.Query)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		body.Query = &query
+	}
+
+	tx, err := h.conn.Begin(h.ctx)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(h.ctx)
+
+	queries := h.queries.WithTx(tx)
+
+	if body.IsDefault != nil && *body.IsDefault {
+		if err := queries.ClearDefaultView(h.ctx, uuid); err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+	}
+
+	view, err := queries.UpdateView(h.ctx, models.NewUpdateViewParams(viewId, uuid, body))
+	if err == pgx.ErrNoRows {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		abortWithViewNameError(c, err)
+		return
+	}
+
+	if err := tx.Commit(h.ctx); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewUpdateViewResBody(view)
+
+	c.JSON(http.StatusOK, resBody)
+}
+
+// DeleteView godoc
+//
+//	@Summary		Delete a view
+//	@Description	Deletes an existing view. Deleting the default view leaves the user without one.
+//
+//	@Security		BearerAuth
+//
+//	@Tags			View
+//	@Accept			json
+//	@Produce		json
+//	@Param			viewId	path		string	true	"View uuid"
+//	@Failure		404		{object}	models.Error
+//	@Failure		500		{object}	models.Error
+//	@Success		200		{object}	models.DeleteViewResBody
+//	@Router			/views/{viewId} [delete]
+func (h *Handler) DeleteView(c *gin.Context) {
+	userId := c.MustGet("userId").(string)
+
+	uuid, err := utils.ToUUID(userId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	viewId, err := utils.ToUUID(c.Param("viewId"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	view, err := h.queries.DeleteView(h.ctx, db.DeleteViewParams{
+		ID:     viewId,
+		UserID: uuid,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resBody := models.NewDeleteViewResBody(view)
+
+	c.JSON(http.StatusOK, resBody)
+}
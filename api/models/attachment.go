@@ -0,0 +1,186 @@
+package models
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+var ErrUnsupportedContentType = errors.New("unsupported file type, expected a PDF, Word or OpenDocument document, plain text or an image")
+
+// NOTE: Word 97-2003 documents are OLE compound files, which http.DetectContentType doesn't recognize
+var oleSignature = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+// NewAttachmentContentType sniffs the content type from the first bytes of a file rather than trusting the one sent by the client.
+// Office documents are ZIP or OLE containers, so for those the extension tells which kind of document it is.
+func NewAttachmentContentType(head []byte, filename string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(filename))
+
+	if bytes.HasPrefix(head, oleSignature) {
+		if ext == ".doc" {
+			return "application/msword", nil
+		}
+		return "", ErrUnsupportedContentType
+	}
+
+	contentType := http.DetectContentType(head)
+
+	switch contentType {
+	case "application/pdf", "image/png", "image/jpeg":
+		return contentType, nil
+	case "text/plain; charset=utf-8":
+		return "text/plain", nil
+	case "application/zip":
+		switch ext {
+		case ".docx":
+			return "application/vnd.openxmlformats-officedocument.wordprocessingml.document", nil
+		case ".odt":
+			return "application/vnd.oasis.opendocument.text", nil
+		}
+	}
+
+	return "", ErrUnsupportedContentType
+}
+
+type AttachmentsQueryParams struct {
+	JobApplicationID string            `form:"job_application_id" binding:"omitempty,uuid"`
+	Kind             db.AttachmentKind `form:"kind" binding:"omitempty,oneof=CV COVER_LETTER OTHER"`
+	Checksum         string            `form:"checksum" binding:"omitempty,sha256"`
+}
+
+func NewGetAttachmentsParams(userId pgtype.UUID, queryParams AttachmentsQueryParams) db.GetAttachmentsParams {
+	var jobApplicationId pgtype.UUID
+	if queryParams.JobApplicationID != "" {
+		jobApplicationId, _ = utils.ToUUID(queryParams.JobApplicationID) // NOTE: Already validated by the binding
+	}
+
+	return db.GetAttachmentsParams{
+		UserID:           userId,
+		JobApplicationID: jobApplicationId,
+		Kind:             db.NullAttachmentKind{AttachmentKind: queryParams.Kind, Valid: queryParams.Kind != ""},
+		Checksum:         pgtype.Text{String: strings.ToLower(queryParams.Checksum), Valid: queryParams.Checksum != ""},
+	}
+}
+
+type attachmentEntry struct {
+	ID               string            `json:"id" example:"3c2d1e0f-9a8b-4c7d-8e6f-5a4b3c2d1e0f"`
+	JobApplicationID string            `json:"jobApplicationId" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyName      string            `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle         string            `json:"jobTitle" example:"Software Engineer"`
+	Kind             db.AttachmentKind `json:"kind" example:"CV"`
+	Filename         string            `json:"filename" example:"cv-2025-03.pdf"`
+	ContentType      string            `json:"contentType" example:"application/pdf"`
+	Size             int64             `json:"size" example:"183204"`
+	Checksum         string            `json:"checksum" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"` // NOTE: SHA-256 of the contents, identical files share it
+	CreatedAt        time.Time         `json:"createdAt" example:"2025-03-14T12:34:56Z"`
+}
+
+type AttachmentsResBody struct {
+	Data []attachmentEntry `json:"data"`
+}
+
+func NewAttachmentsResBody(attachments []db.GetAttachmentsRow) AttachmentsResBody {
+	data := []attachmentEntry{}
+
+	for _, attachment := range attachments {
+		data = append(data, attachmentEntry{
+			ID:               attachment.ID.String(),
+			JobApplicationID: attachment.JobApplicationID.String(),
+			CompanyName:      attachment.CompanyName,
+			JobTitle:         attachment.JobTitle,
+			Kind:             attachment.Kind,
+			Filename:         attachment.Filename,
+			ContentType:      attachment.ContentType,
+			Size:             attachment.Size,
+			Checksum:         attachment.Checksum,
+			CreatedAt:        attachment.CreatedAt.Time,
+		})
+	}
+
+	return AttachmentsResBody{
+		Data: data,
+	}
+}
+
+type CreateAttachmentReqBody struct {
+	Kind db.AttachmentKind `form:"kind" binding:"omitempty,oneof=CV COVER_LETTER OTHER"`
+}
+
+func NewCreateAttachmentReqBody(kind db.AttachmentKind) CreateAttachmentReqBody {
+	return CreateAttachmentReqBody{
+		Kind: kind,
+	}
+}
+
+type CreateAttachmentResBody struct {
+	ID               string            `json:"id" example:"3c2d1e0f-9a8b-4c7d-8e6f-5a4b3c2d1e0f"`
+	JobApplicationID string            `json:"jobApplicationId" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	Kind             db.AttachmentKind `json:"kind" example:"CV"`
+	Filename         string            `json:"filename" example:"cv-2025-03.pdf"`
+	ContentType      string            `json:"contentType" example:"application/pdf"`
+	Size             int64             `json:"size" example:"183204"`
+	Checksum         string            `json:"checksum" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	CreatedAt        time.Time         `json:"createdAt" example:"2025-03-14T12:34:56Z"`
+}
+
+func NewCreateAttachmentResBody(attachment db.CreateAttachmentRow) CreateAttachmentResBody {
+	return CreateAttachmentResBody{
+		ID:               attachment.ID.String(),
+		JobApplicationID: attachment.JobApplicationID.String(),
+		Kind:             attachment.Kind,
+		Filename:         attachment.Filename,
+		ContentType:      attachment.ContentType,
+		Size:             attachment.Size,
+		Checksum:         attachment.Checksum,
+		CreatedAt:        attachment.CreatedAt.Time,
+	}
+}
+
+func NewCreateAttachmentParams(jobApplicationId, userId pgtype.UUID, body CreateAttachmentReqBody, filename, contentType string, size int64, checksum, storageKey string) db.CreateAttachmentParams {
+	kind := body.Kind
+	if kind == "" {
+		kind = db.AttachmentKindOTHER
+	}
+
+	return db.CreateAttachmentParams{
+		Kind:             kind,
+		Filename:         filename,
+		ContentType:      contentType,
+		Size:             size,
+		Checksum:         checksum,
+		StorageKey:       storageKey,
+		JobApplicationID: jobApplicationId,
+		UserID:           userId,
+	}
+}
+
+type DeleteAttachmentResBody struct {
+	ID               string            `json:"id" example:"3c2d1e0f-9a8b-4c7d-8e6f-5a4b3c2d1e0f"`
+	JobApplicationID string            `json:"jobApplicationId" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	Kind             db.AttachmentKind `json:"kind" example:"CV"`
+	Filename         string            `json:"filename" example:"cv-2025-03.pdf"`
+	ContentType      string            `json:"contentType" example:"application/pdf"`
+	Size             int64             `json:"size" example:"183204"`
+	Checksum         string            `json:"checksum" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	CreatedAt        time.Time         `json:"createdAt" example:"2025-03-14T12:34:56Z"`
+}
+
+func NewDeleteAttachmentResBody(attachment db.Attachment) DeleteAttachmentResBody {
+	return DeleteAttachmentResBody{
+		ID:               attachment.ID.String(),
+		JobApplicationID: attachment.JobApplicationID.String(),
+		Kind:             attachment.Kind,
+		Filename:         attachment.Filename,
+		ContentType:      attachment.ContentType,
+		Size:             attachment.Size,
+		Checksum:         attachment.Checksum,
+		CreatedAt:        attachment.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,266 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+type CompaniesQueryParams struct {
+	Page   int    `form:"page" binding:"min=0"`
+	Size   int    `form:"size" binding:"min=0"`
+	Search string `form:"search" binding:"omitempty"`
+}
+
+type companyEntry struct {
+	ID                  string `json:"id" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	Name                string `json:"name" example:"Evil Corp Inc."`
+	Website             string `json:"website,omitempty" example:"https://evilcorp.com"`
+	Industry            string `json:"industry,omitempty" example:"Conglomerate"`
+	Size                string `json:"size,omitempty" example:"10000+"`
+	Location            string `json:"location,omitempty" example:"New York, NY"`
+	JobApplicationCount int    `json:"jobApplicationCount" example:"3"`
+}
+
+type CompaniesResBody struct {
+	Page  int            `json:"page" example:"0"`
+	Size  int            `json:"size" example:"10"`
+	Total int            `json:"total" example:"100"`
+	Data  []companyEntry `json:"data"`
+}
+
+func NewCompaniesResBody(page, size int, companies []db.GetCompaniesRow) CompaniesResBody {
+	data := []companyEntry{}
+
+	for _, company := range companies {
+		data = append(data, companyEntry{
+			ID:                  company.ID.String(),
+			Name:                company.Name,
+			Website:             company.Website.String,
+			Industry:            company.Industry.String,
+			Size:                company.Size.String,
+			Location:            company.Location.String,
+			JobApplicationCount: int(company.JobApplicationCount),
+		})
+	}
+
+	total := 0
+
+	if len(companies) > 0 {
+		total = int(companies[0].Total)
+	}
+
+	return CompaniesResBody{
+		Page:  page,
+		Size:  size,
+		Total: total,
+		Data:  data,
+	}
+}
+
+type companyJobApplication struct {
+	ID          string              `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	JobTitle    string              `json:"jobTitle" example:"Software Engineer"`
+	DateApplied string              `json:"dateApplied" example:"2025-03-14"`
+	Stage       jobApplicationStage `json:"stage"`
+}
+
+type companyOutcomes struct {
+	Total    int `json:"total" example:"3"`
+	Neutral  int `json:"neutral" example:"1"`
+	Positive int `json:"positive" example:"1"`
+	Negative int `json:"negative" example:"1"`
+}
+
+type CompanyResBody struct {
+	ID              string                  `json:"id" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	Name            string                  `json:"name" example:"Evil Corp Inc."`
+	Website         string                  `json:"website,omitempty" example:"https://evilcorp.com"`
+	Industry        string                  `json:"industry,omitempty" example:"Conglomerate"`
+	Size            string                  `json:"size,omitempty" example:"10000+"`
+	Location        string                  `json:"location,omitempty" example:"New York, NY"`
+	Notes           string                  `json:"notes,omitempty" example:"Great benefits, questionable ethics"`
+	Outcomes        companyOutcomes         `json:"outcomes"`
+	JobApplications []companyJobApplication `json:"jobApplications"`
+}
+
+func NewCompanyResBody(company db.GetCompanyRow, jobApplications []db.GetCompanyJobApplicationsRow) CompanyResBody {
+	resBody := CompanyResBody{
+		ID:              company.ID.String(),
+		Name:            company.Name,
+		Website:         company.Website.String,
+		Industry:        company.Industry.String,
+		Size:            company.Size.String,
+		Location:        company.Location.String,
+		Notes:           company.Notes.String,
+		JobApplications: []companyJobApplication{},
+	}
+
+	for _, jobApplication := range jobApplications {
+		resBody.JobApplications = append(resBody.JobApplications, companyJobApplication{
+			ID:          jobApplication.ID.String(),
+			JobTitle:    jobApplication.JobTitle,
+			DateApplied: fromDate(jobApplication.DateApplied),
+			Stage: jobApplicationStage{
+				ID:         jobApplication.StageID.String(),
+				Name:       jobApplication.StageName,
+				Color:      jobApplication.StageColor,
+				IsTerminal: jobApplication.StageIsTerminal,
+				Outcome:    jobApplication.StageOutcome,
+			},
+		})
+
+		resBody.Outcomes.Total++
+
+		switch jobApplication.StageOutcome {
+		case db.StageOutcomeNEUTRAL:
+			resBody.Outcomes.Neutral++
+		case db.StageOutcomePOSITIVE:
+			resBody.Outcomes.Positive++
+		case db.StageOutcomeNEGATIVE:
+			resBody.Outcomes.Negative++
+		}
+	}
+
+	return resBody
+}
+
+type CreateCompanyReqBody struct {
+	Name     string `json:"name" binding:"required" example:"Evil Corp Inc."`
+	Website  string `json:"website,omitempty" binding:"omitempty,url" example:"https://evilcorp.com"`
+	Industry string `json:"industry,omitempty" example:"Conglomerate"`
+	Size     string `json:"size,omitempty" example:"10000+"`
+	Location string `json:"location,omitempty" example:"New York, NY"`
+	Notes    string `json:"notes,omitempty" example:"Great benefits, questionable ethics"`
+}
+
+func NewCreateCompanyReqBody(name, website, industry, size, location, notes string) CreateCompanyReqBody {
+	return CreateCompanyReqBody{
+		Name:     name,
+		Website:  website,
+		Industry: industry,
+		Size:     size,
+		Location: location,
+		Notes:    notes,
+	}
+}
+
+type CreateCompanyResBody struct {
+	ID       string `json:"id" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	Name     string `json:"name" example:"Evil Corp Inc."`
+	Website  string `json:"website,omitempty" example:"https://evilcorp.com"`
+	Industry string `json:"industry,omitempty" example:"Conglomerate"`
+	Size     string `json:"size,omitempty" example:"10000+"`
+	Location string `json:"location,omitempty" example:"New York, NY"`
+	Notes    string `json:"notes,omitempty" example:"Great benefits, questionable ethics"`
+}
+
+func NewCreateCompanyResBody(company db.CreateCompanyRow) CreateCompanyResBody {
+	return CreateCompanyResBody{
+		ID:       company.ID.String(),
+		Name:     company.Name,
+		Website:  company.Website.String,
+		Industry: company.Industry.String,
+		Size:     company.Size.String,
+		Location: company.Location.String,
+		Notes:    company.Notes.String,
+	}
+}
+
+func NewCreateCompanyParams(userId pgtype.UUID, body CreateCompanyReqBody) db.CreateCompanyParams {
+	return db.CreateCompanyParams{
+		UserID:   userId,
+		Name:     body.Name,
+		Website:  pgtype.Text{String: body.Website, Valid: body.Website != ""},
+		Industry: pgtype.Text{String: body.Industry, Valid: body.Industry != ""},
+		Size:     pgtype.Text{String: body.Size, Valid: body.Size != ""},
+		Location: pgtype.Text{String: body.Location, Valid: body.Location != ""},
+		Notes:    pgtype.Text{String: body.Notes, Valid: body.Notes != ""},
+	}
+}
+
+type UpdateCompanyReqBody struct {
+	Name     string `json:"name,omitempty" example:"Evil Corp Inc."`
+	Website  string `json:"website,omitempty" binding:"omitempty,url" example:"https://evilcorp.com"`
+	Industry string `json:"industry,omitempty" example:"Conglomerate"`
+	Size     string `json:"size,omitempty" example:"10000+"`
+	Location string `json:"location,omitempty" example:"New York, NY"`
+	Notes    string `json:"notes,omitempty" example:"Great benefits, questionable ethics"`
+}
+
+func NewUpdateCompanyReqBody(name, website, industry, size, location, notes string) UpdateCompanyReqBody {
+	return UpdateCompanyReqBody{
+		Name:     name,
+		Website:  website,
+		Industry: industry,
+		Size:     size,
+		Location: location,
+		Notes:    notes,
+	}
+}
+
+type UpdateCompanyResBody struct {
+	ID       string `json:"id" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	Name     string `json:"name" example:"Evil Corp Inc."`
+	Website  string `json:"website,omitempty" example:"https://evilcorp.com"`
+	Industry string `json:"industry,omitempty" example:"Conglomerate"`
+	Size     string `json:"size,omitempty" example:"10000+"`
+	Location string `json:"location,omitempty" example:"New York, NY"`
+	Notes    string `json:"notes,omitempty" example:"Great benefits, questionable ethics"`
+}
+
+func NewUpdateCompanyResBody(company db.UpdateCompanyRow) UpdateCompanyResBody {
+	return UpdateCompanyResBody{
+		ID:       company.ID.String(),
+		Name:     company.Name,
+		Website:  company.Website.String,
+		Industry: company.Industry.String,
+		Size:     company.Size.String,
+		Location: company.Location.String,
+		Notes:    company.Notes.String,
+	}
+}
+
+func NewUpdateCompanyParams(companyId, userId pgtype.UUID, body UpdateCompanyReqBody) db.UpdateCompanyParams {
+	return db.UpdateCompanyParams{
+		ID:       companyId,
+		UserID:   userId,
+		Name:     pgtype.Text{String: body.Name, Valid: true},
+		Website:  pgtype.Text{String: body.Website, Valid: true},
+		Industry: pgtype.Text{String: body.Industry, Valid: true},
+		Size:     pgtype.Text{String: body.Size, Valid: true},
+		Location: pgtype.Text{String: body.Location, Valid: true},
+		Notes:    pgtype.Text{String: body.Notes, Valid: true},
+	}
+}
+
+type DeleteCompanyResBody struct {
+	ID       string `json:"id" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	Name     string `json:"name" example:"Evil Corp Inc."`
+	Website  string `json:"website,omitempty" example:"https://evilcorp.com"`
+	Industry string `json:"industry,omitempty" example:"Conglomerate"`
+	Size     string `json:"size,omitempty" example:"10000+"`
+	Location string `json:"location,omitempty" example:"New York, NY"`
+	Notes    string `json:"notes,omitempty" example:"Great benefits, questionable ethics"`
+}
+
+func NewDeleteCompanyResBody(company db.DeleteCompanyRow) DeleteCompanyResBody {
+	return DeleteCompanyResBody{
+		ID:       company.ID.String(),
+		Name:     company.Name,
+		Website:  company.Website.String,
+		Industry: company.Industry.String,
+		Size:     company.Size.String,
+		Location: company.Location.String,
+		Notes:    company.Notes.String,
+	}
+}
+
+type MergeCompaniesReqBody struct {
+	CompanyIDs []string `json:"companyIds" binding:"required,min=1,dive,uuid" example:"5d2f7c1e-9b4a-4e6d-8c3f-1a2b3c4d5e6f"` // NOTE: Companies merged into the one in the path and deleted afterwards
+}
+
+func NewMergeCompaniesReqBody(companyIds []string) MergeCompaniesReqBody {
+	return MergeCompaniesReqBody{
+		CompanyIDs: companyIds,
+	}
+}
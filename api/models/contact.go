@@ -0,0 +1,255 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+type ContactsQueryParams struct {
+	Page   int    `form:"page" binding:"min=0"`
+	Size   int    `form:"size" binding:"min=0"`
+	Search string `form:"search" binding:"omitempty"`
+}
+
+type contactEntry struct {
+	ID          string `json:"id" example:"6b1f0c2a-4d3e-4f5a-9b8c-7d6e5f4a3b2c"`
+	Name        string `json:"name" example:"Jane Doe"`
+	Email       string `json:"email,omitempty" example:"jane.doe@evilcorp.com"`
+	Phone       string `json:"phone,omitempty" example:"+48 123 456 789"`
+	LinkedInURL string `json:"linkedInURL,omitempty" example:"https://www.linkedin.com/in/jane-doe"`
+	Company     string `json:"company,omitempty" example:"Evil Corp Inc."`
+	Role        string `json:"role,omitempty" example:"Technical Recruiter"`
+}
+
+type ContactsResBody struct {
+	Page  int            `json:"page" example:"0"`
+	Size  int            `json:"size" example:"10"`
+	Total int            `json:"total" example:"100"`
+	Data  []contactEntry `json:"data"`
+}
+
+func NewContactsResBody(page, size int, contacts []db.GetContactsRow) ContactsResBody {
+	data := []contactEntry{}
+
+	for _, contact := range contacts {
+		data = append(data, contactEntry{
+			ID:          contact.ID.String(),
+			Name:        contact.Name,
+			Email:       contact.Email.String,
+			Phone:       contact.Phone.String,
+			LinkedInURL: contact.LinkedinUrl.String,
+			Company:     contact.Company.String,
+			Role:        contact.Role.String,
+		})
+	}
+
+	total := 0
+
+	if len(contacts) > 0 {
+		total = int(contacts[0].Total)
+	}
+
+	return ContactsResBody{
+		Page:  page,
+		Size:  size,
+		Total: total,
+		Data:  data,
+	}
+}
+
+type contactJobApplication struct {
+	ID          string `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyName string `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle    string `json:"jobTitle" example:"Software Engineer"`
+	DateApplied string `json:"dateApplied" example:"2025-03-14"`
+}
+
+type ContactResBody struct {
+	ID              string                  `json:"id" example:"6b1f0c2a-4d3e-4f5a-9b8c-7d6e5f4a3b2c"`
+	Name            string                  `json:"name" example:"Jane Doe"`
+	Email           string                  `json:"email,omitempty" example:"jane.doe@evilcorp.com"`
+	Phone           string                  `json:"phone,omitempty" example:"+48 123 456 789"`
+	LinkedInURL     string                  `json:"linkedInURL,omitempty" example:"https://www.linkedin.com/in/jane-doe"`
+	Company         string                  `json:"company,omitempty" example:"Evil Corp Inc."`
+	Role            string                  `json:"role,omitempty" example:"Technical Recruiter"`
+	Notes           string                  `json:"notes,omitempty" example:"Prefers to be contacted via LinkedIn"`
+	JobApplications []contactJobApplication `json:"jobApplications"`
+}
+
+func NewContactResBody(contact db.GetContactRow, jobApplications []db.GetContactJobApplicationsRow) ContactResBody {
+	resBody := ContactResBody{
+		ID:              contact.ID.String(),
+		Name:            contact.Name,
+		Email:           contact.Email.String,
+		Phone:           contact.Phone.String,
+		LinkedInURL:     contact.LinkedinUrl.String,
+		Company:         contact.Company.String,
+		Role:            contact.Role.String,
+		Notes:           contact.Notes.String,
+		JobApplications: []contactJobApplication{},
+	}
+
+	for _, jobApplication := range jobApplications {
+		resBody.JobApplications = append(resBody.JobApplications, contactJobApplication{
+			ID:          jobApplication.ID.String(),
+			CompanyName: jobApplication.CompanyName,
+			JobTitle:    jobApplication.JobTitle,
+			DateApplied: fromDate(jobApplication.DateApplied),
+		})
+	}
+
+	return resBody
+}
+
+type CreateContactReqBody struct {
+	Name        string `json:"name" binding:"required" example:"Jane Doe"`
+	Email       string `json:"email,omitempty" binding:"omitempty,email" example:"jane.doe@evilcorp.com"`
+	Phone       string `json:"phone,omitempty" example:"+48 123 456 789"`
+	LinkedInURL string `json:"linkedInURL,omitempty" binding:"omitempty,url" example:"https://www.linkedin.com/in/jane-doe"`
+	Company     string `json:"company,omitempty" example:"Evil Corp Inc."`
+	Role        string `json:"role,omitempty" example:"Technical Recruiter"`
+	Notes       string `json:"notes,omitempty" example:"Prefers to be contacted via LinkedIn"`
+}
+
+func NewCreateContactReqBody(name, email, phone, linkedInURL, company, role, notes string) CreateContactReqBody {
+	return CreateContactReqBody{
+		Name:        name,
+		Email:       email,
+		Phone:       phone,
+		LinkedInURL: linkedInURL,
+		Company:     company,
+		Role:        role,
+		Notes:       notes,
+	}
+}
+
+type CreateContactResBody struct {
+	ID          string `json:"id" example:"6b1f0c2a-4d3e-4f5a-9b8c-7d6e5f4a3b2c"`
+	Name        string `json:"name" example:"Jane Doe"`
+	Email       string `json:"email,omitempty" example:"jane.doe@evilcorp.com"`
+	Phone       string `json:"phone,omitempty" example:"+48 123 456 789"`
+	LinkedInURL string `json:"linkedInURL,omitempty" example:"https://www.linkedin.com/in/jane-doe"`
+	Company     string `json:"company,omitempty" example:"Evil Corp Inc."`
+	Role        string `json:"role,omitempty" example:"Technical Recruiter"`
+	Notes       string `json:"notes,omitempty" example:"Prefers to be contacted via LinkedIn"`
+}
+
+func NewCreateContactResBody(contact db.CreateContactRow) CreateContactResBody {
+	return CreateContactResBody{
+		ID:          contact.ID.String(),
+		Name:        contact.Name,
+		Email:       contact.Email.String,
+		Phone:       contact.Phone.String,
+		LinkedInURL: contact.LinkedinUrl.String,
+		Company:     contact.Company.String,
+		Role:        contact.Role.String,
+		Notes:       contact.Notes.String,
+	}
+}
+
+func NewCreateContactParams(userId pgtype.UUID, body CreateContactReqBody) db.CreateContactParams {
+	return db.CreateContactParams{
+		UserID:      userId,
+		Name:        body.Name,
+		Email:       pgtype.Text{String: body.Email, Valid: body.Email != ""},
+		Phone:       pgtype.Text{String: body.Phone, Valid: body.Phone != ""},
+		LinkedinUrl: pgtype.Text{String: body.LinkedInURL, Valid: body.LinkedInURL != ""},
+		Company:     pgtype.Text{String: body.Company, Valid: body.Company != ""},
+		Role:        pgtype.Text{String: body.Role, Valid: body.Role != ""},
+		Notes:       pgtype.Text{String: body.Notes, Valid: body.Notes != ""},
+	}
+}
+
+type UpdateContactReqBody struct {
+	Name        string `json:"name,omitempty" example:"Jane Doe"`
+	Email       string `json:"email,omitempty" binding:"omitempty,email" example:"jane.doe@evilcorp.com"`
+	Phone       string `json:"phone,omitempty" example:"+48 123 456 789"`
+	LinkedInURL string `json:"linkedInURL,omitempty" binding:"omitempty,url" example:"https://www.linkedin.com/in/jane-doe"`
+	Company     string `json:"company,omitempty" example:"Evil Corp Inc."`
+	Role        string `json:"role,omitempty" example:"Technical Recruiter"`
+	Notes       string `json:"notes,omitempty" example:"Prefers to be contacted via LinkedIn"`
+}
+
+func NewUpdateContactReqBody(name, email, phone, linkedInURL, company, role, notes string) UpdateContactReqBody {
+	return UpdateContactReqBody{
+		Name:        name,
+		Email:       email,
+		Phone:       phone,
+		LinkedInURL: linkedInURL,
+		Company:     company,
+		Role:        role,
+		Notes:       notes,
+	}
+}
+
+type UpdateContactResBody struct {
+	ID          string `json:"id" example:"6b1f0c2a-4d3e-4f5a-9b8c-7d6e5f4a3b2c"`
+	Name        string `json:"name" example:"Jane Doe"`
+	Email       string `json:"email,omitempty" example:"jane.doe@evilcorp.com"`
+	Phone       string `json:"phone,omitempty" example:"+48 123 456 789"`
+	LinkedInURL string `json:"linkedInURL,omitempty" example:"https://www.linkedin.com/in/jane-doe"`
+	Company     string `json:"company,omitempty" example:"Evil Corp Inc."`
+	Role        string `json:"role,omitempty" example:"Technical Recruiter"`
+	Notes       string `json:"notes,omitempty" example:"Prefers to be contacted via LinkedIn"`
+}
+
+func NewUpdateContactResBody(contact db.UpdateContactRow) UpdateContactResBody {
+	return UpdateContactResBody{
+		ID:          contact.ID.String(),
+		Name:        contact.Name,
+		Email:       contact.Email.String,
+		Phone:       contact.Phone.String,
+		LinkedInURL: contact.LinkedinUrl.String,
+		Company:     contact.Company.String,
+		Role:        contact.Role.String,
+		Notes:       contact.Notes.String,
+	}
+}
+
+func NewUpdateContactParams(contactId, userId pgtype.UUID, body UpdateContactReqBody) db.UpdateContactParams {
+	return db.UpdateContactParams{
+		ID:          contactId,
+		UserID:      userId,
+		Name:        pgtype.Text{String: body.Name, Valid: true},
+		Email:       pgtype.Text{String: body.Email, Valid: true},
+		Phone:       pgtype.Text{String: body.Phone, Valid: true},
+		LinkedinUrl: pgtype.Text{String: body.LinkedInURL, Valid: true},
+		Company:     pgtype.Text{String: body.Company, Valid: true},
+		Role:        pgtype.Text{String: body.Role, Valid: true},
+		Notes:       pgtype.Text{String: body.Notes, Valid: true},
+	}
+}
+
+type DeleteContactResBody struct {
+	ID          string `json:"id" example:"6b1f0c2a-4d3e-4f5a-9b8c-7d6e5f4a3b2c"`
+	Name        string `json:"name" example:"Jane Doe"`
+	Email       string `json:"email,omitempty" example:"jane.doe@evilcorp.com"`
+	Phone       string `json:"phone,omitempty" example:"+48 123 456 789"`
+	LinkedInURL string `json:"linkedInURL,omitempty" example:"https://www.linkedin.com/in/jane-doe"`
+	Company     string `json:"company,omitempty" example:"Evil Corp Inc."`
+	Role        string `json:"role,omitempty" example:"Technical Recruiter"`
+	Notes       string `json:"notes,omitempty" example:"Prefers to be contacted via LinkedIn"`
+}
+
+func NewDeleteContactResBody(contact db.DeleteContactRow) DeleteContactResBody {
+	return DeleteContactResBody{
+		ID:          contact.ID.String(),
+		Name:        contact.Name,
+		Email:       contact.Email.String,
+		Phone:       contact.Phone.String,
+		LinkedInURL: contact.LinkedinUrl.String,
+		Company:     contact.Company.String,
+		Role:        contact.Role.String,
+		Notes:       contact.Notes.String,
+	}
+}
+
+type JobApplicationContactsResBody struct {
+	Data []jobApplicationContact `json:"data"`
+}
+
+func NewJobApplicationContactsResBody(contacts []db.GetJobApplicationContactsRow) JobApplicationContactsResBody {
+	return JobApplicationContactsResBody{
+		Data: newJobApplicationContacts(contacts),
+	}
+}
@@ -0,0 +1,328 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+type UpcomingInterviewsQueryParams struct {
+	From string `form:"from" binding:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To   string `form:"to" binding:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// inTimezone renders the scheduled time in the timezone the interview takes place in
+func inTimezone(scheduledAt pgtype.Timestamptz, timezone string) time.Time {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return scheduledAt.Time.UTC()
+	}
+	return scheduledAt.Time.In(location)
+}
+
+type interviewEntry struct {
+	ID               string              `json:"id" example:"3c9d8e7f-6a5b-4c3d-2e1f-0a9b8c7d6e5f"`
+	ScheduledAt      time.Time           `json:"scheduledAt" example:"2025-04-01T10:00:00+02:00"`
+	Timezone         string              `json:"timezone" example:"Europe/Warsaw"`
+	Duration         int32               `json:"duration,omitempty" example:"60"` // NOTE: Minutes
+	Type             db.InterviewType    `json:"type" example:"TECHNICAL"`
+	Location         string              `json:"location,omitempty" example:"Evil Corp HQ, 3rd floor"`
+	MeetingURL       string              `json:"meetingURL,omitempty" example:"https://meet.example.com/abc-defg-hij"`
+	Interviewers     []string            `json:"interviewers" example:"Jane Doe,John Smith"`
+	PreparationNotes string              `json:"preparationNotes,omitempty" example:"Revise system design basics"`
+	Outcome          db.InterviewOutcome `json:"outcome" example:"PENDING"`
+}
+
+type InterviewsResBody struct {
+	Data []interviewEntry `json:"data"`
+}
+
+func NewInterviewsResBody(interviews []db.GetInterviewsRow) InterviewsResBody {
+	data := []interviewEntry{}
+
+	for _, interview := range interviews {
+		data = append(data, interviewEntry{
+			ID:               interview.ID.String(),
+			ScheduledAt:      inTimezone(interview.ScheduledAt, interview.Timezone),
+			Timezone:         interview.Timezone,
+			Duration:         interview.Duration.Int32,
+			Type:             interview.Type,
+			Location:         interview.Location.String,
+			MeetingURL:       interview.MeetingUrl.String,
+			Interviewers:     interview.Interviewers,
+			PreparationNotes: interview.PreparationNotes.String,
+			Outcome:          interview.Outcome,
+		})
+	}
+
+	return InterviewsResBody{
+		Data: data,
+	}
+}
+
+type InterviewResBody struct {
+	ID               string              `json:"id" example:"3c9d8e7f-6a5b-4c3d-2e1f-0a9b8c7d6e5f"`
+	ScheduledAt      time.Time           `json:"scheduledAt" example:"2025-04-01T10:00:00+02:00"`
+	Timezone         string              `json:"timezone" example:"Europe/Warsaw"`
+	Duration         int32               `json:"duration,omitempty" example:"60"`
+	Type             db.InterviewType    `json:"type" example:"TECHNICAL"`
+	Location         string              `json:"location,omitempty" example:"Evil Corp HQ, 3rd floor"`
+	MeetingURL       string              `json:"meetingURL,omitempty" example:"https://meet.example.com/abc-defg-hij"`
+	Interviewers     []string            `json:"interviewers" example:"Jane Doe,John Smith"`
+	PreparationNotes string              `json:"preparationNotes,omitempty" example:"Revise system design basics"`
+	Outcome          db.InterviewOutcome `json:"outcome" example:"PENDING"`
+}
+
+func NewInterviewResBody(interview db.GetInterviewRow) InterviewResBody {
+	return InterviewResBody{
+		ID:               interview.ID.String(),
+		ScheduledAt:      inTimezone(interview.ScheduledAt, interview.Timezone),
+		Timezone:         interview.Timezone,
+		Duration:         interview.Duration.Int32,
+		Type:             interview.Type,
+		Location:         interview.Location.String,
+		MeetingURL:       interview.MeetingUrl.String,
+		Interviewers:     interview.Interviewers,
+		PreparationNotes: interview.PreparationNotes.String,
+		Outcome:          interview.Outcome,
+	}
+}
+
+type CreateInterviewReqBody struct {
+	ScheduledAt      time.Time           `json:"scheduledAt" binding:"required" example:"2025-04-01T10:00:00+02:00"`
+	Timezone         string              `json:"timezone,omitempty" binding:"omitempty,timezone" example:"Europe/Warsaw"` // NOTE: Defaults to UTC
+	Duration         int32               `json:"duration,omitempty" binding:"omitempty,gt=0" example:"60"`
+	Type             db.InterviewType    `json:"type" binding:"required,oneof=PHONE ONSITE TECHNICAL HR" example:"TECHNICAL"`
+	Location         string              `json:"location,omitempty" example:"Evil Corp HQ, 3rd floor"`
+	MeetingURL       string              `json:"meetingURL,omitempty" binding:"omitempty,url" example:"https://meet.example.com/abc-defg-hij"`
+	Interviewers     []string            `json:"interviewers,omitempty" binding:"omitempty,dive,required" example:"Jane Doe,John Smith"`
+	PreparationNotes string              `json:"preparationNotes,omitempty" example:"Revise system design basics"`
+	Outcome          db.InterviewOutcome `json:"outcome,omitempty" binding:"omitempty,oneof=PENDING PASSED FAILED CANCELLED" example:"PENDING"`
+}
+
+func NewCreateInterviewReqBody(scheduledAt time.Time, timezone string, duration int32, interviewType db.InterviewType, location, meetingURL string, interviewers []string, preparationNotes string, outcome db.InterviewOutcome) CreateInterviewReqBody {
+	return CreateInterviewReqBody{
+		ScheduledAt:      scheduledAt,
+		Timezone:         timezone,
+		Duration:         duration,
+		Type:             interviewType,
+		Location:         location,
+		MeetingURL:       meetingURL,
+		Interviewers:     interviewers,
+		PreparationNotes: preparationNotes,
+		Outcome:          outcome,
+	}
+}
+
+type CreateInterviewResBody struct {
+	ID               string              `json:"id" example:"3c9d8e7f-6a5b-4c3d-2e1f-0a9b8c7d6e5f"`
+	ScheduledAt      time.Time           `json:"scheduledAt" example:"2025-04-01T10:00:00+02:00"`
+	Timezone         string              `json:"timezone" example:"Europe/Warsaw"`
+	Duration         int32               `json:"duration,omitempty" example:"60"`
+	Type             db.InterviewType    `json:"type" example:"TECHNICAL"`
+	Location         string              `json:"location,omitempty" example:"Evil Corp HQ, 3rd floor"`
+	MeetingURL       string              `json:"meetingURL,omitempty" example:"https://meet.example.com/abc-defg-hij"`
+	Interviewers     []string            `json:"interviewers" example:"Jane Doe,John Smith"`
+	PreparationNotes string              `json:"preparationNotes,omitempty" example:"Revise system design basics"`
+	Outcome          db.InterviewOutcome `json:"outcome" example:"PENDING"`
+}
+
+func NewCreateInterviewResBody(interview db.CreateInterviewRow) CreateInterviewResBody {
+	return CreateInterviewResBody{
+		ID:               interview.ID.String(),
+		ScheduledAt:      inTimezone(interview.ScheduledAt, interview.Timezone),
+		Timezone:         interview.Timezone,
+		Duration:         interview.Duration.Int32,
+		Type:             interview.Type,
+		Location:         interview.Location.String,
+		MeetingURL:       interview.MeetingUrl.String,
+		Interviewers:     interview.Interviewers,
+		PreparationNotes: interview.PreparationNotes.String,
+		Outcome:          interview.Outcome,
+	}
+}
+
+func NewCreateInterviewParams(jobApplicationId, userId pgtype.UUID, body CreateInterviewReqBody) db.CreateInterviewParams {
+	params := db.CreateInterviewParams{
+		JobApplicationID: jobApplicationId,
+		UserID:           userId,
+		ScheduledAt:      pgtype.Timestamptz{Time: body.ScheduledAt, Valid: true},
+		Timezone:         body.Timezone,
+		Duration:         pgtype.Int4{Int32: body.Duration, Valid: body.Duration > 0},
+		Type:             body.Type,
+		Location:         pgtype.Text{String: body.Location, Valid: body.Location != ""},
+		MeetingUrl:       pgtype.Text{String: body.MeetingURL, Valid: body.MeetingURL != ""},
+		Interviewers:     body.Interviewers,
+		PreparationNotes: pgtype.Text{String: body.PreparationNotes, Valid: body.PreparationNotes != ""},
+		Outcome:          body.Outcome,
+	}
+
+	if params.Timezone == "" {
+		params.Timezone = "UTC"
+	}
+	if params.Interviewers == nil {
+		params.Interviewers = []string{}
+	}
+	if params.Outcome == "" {
+		params.Outcome = db.InterviewOutcomePENDING
+	}
+
+	return params
+}
+
+type UpdateInterviewReqBody struct {
+	ScheduledAt      *time.Time           `json:"scheduledAt,omitempty" example:"2025-04-01T10:00:00+02:00"`
+	Timezone         string               `json:"timezone,omitempty" binding:"omitempty,timezone" example:"Europe/Warsaw"`
+	Duration         *int32               `json:"duration,omitempty" binding:"omitempty,gt=0" example:"60"`
+	Type             *db.InterviewType    `json:"type,omitempty" binding:"omitempty,oneof=PHONE ONSITE TECHNICAL HR" example:"TECHNICAL"`
+	Location         string               `json:"location,omitempty" example:"Evil Corp HQ, 3rd floor"`
+	MeetingURL       string               `json:"meetingURL,omitempty" binding:"omitempty,url" example:"https://meet.example.com/abc-defg-hij"`
+	Interviewers     []string             `json:"interviewers,omitempty" binding:"omitempty,dive,required" example:"Jane Doe,John Smith"`
+	PreparationNotes string               `json:"preparationNotes,omitempty" example:"Revise system design basics"`
+	Outcome          *db.InterviewOutcome `json:"outcome,omitempty" binding:"omitempty,oneof=PENDING PASSED FAILED CANCELLED" example:"PASSED"`
+}
+
+func NewUpdateInterviewReqBody(scheduledAt *time.Time, timezone string, duration *int32, interviewType *db.InterviewType, location, meetingURL string, interviewers []string, preparationNotes string, outcome *db.InterviewOutcome) UpdateInterviewReqBody {
+	return UpdateInterviewReqBody{
+		ScheduledAt:      scheduledAt,
+		Timezone:         timezone,
+		Duration:         duration,
+		Type:             interviewType,
+		Location:         location,
+		MeetingURL:       meetingURL,
+		Interviewers:     interviewers,
+		PreparationNotes: preparationNotes,
+		Outcome:          outcome,
+	}
+}
+
+type UpdateInterviewResBody struct {
+	ID               string              `json:"id" example:"3c9d8e7f-6a5b-4c3d-2e1f-0a9b8c7d6e5f"`
+	ScheduledAt      time.Time           `json:"scheduledAt" example:"2025-04-01T10:00:00+02:00"`
+	Timezone         string              `json:"timezone" example:"Europe/Warsaw"`
+	Duration         int32               `json:"duration,omitempty" example:"60"`
+	Type             db.InterviewType    `json:"type" example:"TECHNICAL"`
+	Location         string              `json:"location,omitempty" example:"Evil Corp HQ, 3rd floor"`
+	MeetingURL       string              `json:"meetingURL,omitempty" example:"https://meet.example.com/abc-defg-hij"`
+	Interviewers     []string            `json:"interviewers" example:"Jane Doe,John Smith"`
+	PreparationNotes string              `json:"preparationNotes,omitempty" example:"Revise system design basics"`
+	Outcome          db.InterviewOutcome `json:"outcome" example:"PASSED"`
+}
+
+func NewUpdateInterviewResBody(interview db.UpdateInterviewRow) UpdateInterviewResBody {
+	return UpdateInterviewResBody{
+		ID:               interview.ID.String(),
+		ScheduledAt:      inTimezone(interview.ScheduledAt, interview.Timezone),
+		Timezone:         interview.Timezone,
+		Duration:         interview.Duration.Int32,
+		Type:             interview.Type,
+		Location:         interview.Location.String,
+		MeetingURL:       interview.MeetingUrl.String,
+		Interviewers:     interview.Interviewers,
+		PreparationNotes: interview.PreparationNotes.String,
+		Outcome:          interview.Outcome,
+	}
+}
+
+func NewUpdateInterviewParams(interviewId, jobApplicationId, userId pgtype.UUID, body UpdateInterviewReqBody) db.UpdateInterviewParams {
+	params := db.UpdateInterviewParams{
+		ID:               interviewId,
+		JobApplicationID: jobApplicationId,
+		UserID:           userId,
+		Timezone:         pgtype.Text{String: body.Timezone, Valid: true},
+		Location:         pgtype.Text{String: body.Location, Valid: true},
+		MeetingUrl:       pgtype.Text{String: body.MeetingURL, Valid: true},
+		Interviewers:     body.Interviewers,
+		PreparationNotes: pgtype.Text{String: body.PreparationNotes, Valid: true},
+	}
+
+	if body.ScheduledAt != nil {
+		params.ScheduledAt = pgtype.Timestamptz{Time: *body.ScheduledAt, Valid: true}
+	}
+	if body.Duration != nil {
+		params.Duration = pgtype.Int4{Int32: *body.Duration, Valid: true}
+	}
+	if body.Type != nil {
+		params.Type = db.NullInterviewType{InterviewType: *body.Type, Valid: true}
+	}
+	if body.Outcome != nil {
+		params.Outcome = db.NullInterviewOutcome{InterviewOutcome: *body.Outcome, Valid: true}
+	}
+
+	return params
+}
+
+type DeleteInterviewResBody struct {
+	ID               string              `json:"id" example:"3c9d8e7f-6a5b-4c3d-2e1f-0a9b8c7d6e5f"`
+	ScheduledAt      time.Time           `json:"scheduledAt" example:"2025-04-01T10:00:00+02:00"`
+	Timezone         string              `json:"timezone" example:"Europe/Warsaw"`
+	Duration         int32               `json:"duration,omitempty" example:"60"`
+	Type             db.InterviewType    `json:"type" example:"TECHNICAL"`
+	Location         string              `json:"location,omitempty" example:"Evil Corp HQ, 3rd floor"`
+	MeetingURL       string              `json:"meetingURL,omitempty" example:"https://meet.example.com/abc-defg-hij"`
+	Interviewers     []string            `json:"interviewers" example:"Jane Doe,John Smith"`
+	PreparationNotes string              `json:"preparationNotes,omitempty" example:"Revise system design basics"`
+	Outcome          db.InterviewOutcome `json:"outcome" example:"PENDING"`
+}
+
+func NewDeleteInterviewResBody(interview db.DeleteInterviewRow) DeleteInterviewResBody {
+	return DeleteInterviewResBody{
+		ID:               interview.ID.String(),
+		ScheduledAt:      inTimezone(interview.ScheduledAt, interview.Timezone),
+		Timezone:         interview.Timezone,
+		Duration:         interview.Duration.Int32,
+		Type:             interview.Type,
+		Location:         interview.Location.String,
+		MeetingURL:       interview.MeetingUrl.String,
+		Interviewers:     interview.Interviewers,
+		PreparationNotes: interview.PreparationNotes.String,
+		Outcome:          interview.Outcome,
+	}
+}
+
+type upcomingInterviewEntry struct {
+	ID               string              `json:"id" example:"3c9d8e7f-6a5b-4c3d-2e1f-0a9b8c7d6e5f"`
+	JobApplicationID string              `json:"jobApplicationId" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyName      string              `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle         string              `json:"jobTitle" example:"Software Engineer"`
+	ScheduledAt      time.Time           `json:"scheduledAt" example:"2025-04-01T10:00:00+02:00"`
+	Timezone         string              `json:"timezone" example:"Europe/Warsaw"`
+	Duration         int32               `json:"duration,omitempty" example:"60"`
+	Type             db.InterviewType    `json:"type" example:"TECHNICAL"`
+	Location         string              `json:"location,omitempty" example:"Evil Corp HQ, 3rd floor"`
+	MeetingURL       string              `json:"meetingURL,omitempty" example:"https://meet.example.com/abc-defg-hij"`
+	Interviewers     []string            `json:"interviewers" example:"Jane Doe,John Smith"`
+	PreparationNotes string              `json:"preparationNotes,omitempty" example:"Revise system design basics"`
+	Outcome          db.InterviewOutcome `json:"outcome" example:"PENDING"`
+}
+
+type UpcomingInterviewsResBody struct {
+	Data []upcomingInterviewEntry `json:"data"`
+}
+
+func NewUpcomingInterviewsResBody(interviews []db.GetUpcomingInterviewsRow) UpcomingInterviewsResBody {
+	data := []upcomingInterviewEntry{}
+
+	for _, interview := range interviews {
+		data = append(data, upcomingInterviewEntry{
+			ID:               interview.ID.String(),
+			JobApplicationID: interview.JobApplicationID.String(),
+			CompanyName:      interview.CompanyName,
+			JobTitle:         interview.JobTitle,
+			ScheduledAt:      inTimezone(interview.ScheduledAt, interview.Timezone),
+			Timezone:         interview.Timezone,
+			Duration:         interview.Duration.Int32,
+			Type:             interview.Type,
+			Location:         interview.Location.String,
+			MeetingURL:       interview.MeetingUrl.String,
+			Interviewers:     interview.Interviewers,
+			PreparationNotes: interview.PreparationNotes.String,
+			Outcome:          interview.Outcome,
+		})
+	}
+
+	return UpcomingInterviewsResBody{
+		Data: data,
+	}
+}
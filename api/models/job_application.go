@@ -1,10 +1,17 @@
 package models
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
 )
 
 type Sort string
@@ -16,33 +23,420 @@ const (
 	JobTitleDesc    Sort = "-job_title"
 	DateAppliedAsc  Sort = "date_applied"
 	DateAppliedDesc Sort = "-date_applied"
-	StatusAsc       Sort = "status"
-	StatusDesc      Sort = "-status"
+	StageAsc        Sort = "stage"
+	StageDesc       Sort = "-stage"
 	SalaryAsc       Sort = "salary"
 	SalaryDesc      Sort = "-salary"
 	IsRepliedAsc    Sort = "is_replied"
 	IsRepliedDesc   Sort = "-is_replied"
+	Relevance       Sort = "relevance" // NOTE: Best full-text search matches first
+)
+
+var sortKeys = map[Sort]db.JobApplicationsSortKey{
+	CompanyNameAsc:  {Column: db.JobApplicationsSortCompanyName},
+	CompanyNameDesc: {Column: db.JobApplicationsSortCompanyName, Desc: true},
+	JobTitleAsc:     {Column: db.JobApplicationsSortJobTitle},
+	JobTitleDesc:    {Column: db.JobApplicationsSortJobTitle, Desc: true},
+	DateAppliedAsc:  {Column: db.JobApplicationsSortDateApplied},
+	DateAppliedDesc: {Column: db.JobApplicationsSortDateApplied, Desc: true},
+	StageAsc:        {Column: db.JobApplicationsSortStage},
+	StageDesc:       {Column: db.JobApplicationsSortStage, Desc: true},
+	SalaryAsc:       {Column: db.JobApplicationsSortSalary},
+	SalaryDesc:      {Column: db.JobApplicationsSortSalary, Desc: true},
+	IsRepliedAsc:    {Column: db.JobApplicationsSortIsReplied},
+	IsRepliedDesc:   {Column: db.JobApplicationsSortIsReplied, Desc: true},
+	Relevance:       {Column: db.JobApplicationsSortRelevance, Desc: true},
+}
+
+// NOTE: Salaries entered without a unit are taken as gross monthly amounts in the preferred currency of the user, and salaries are compared in that unit unless asked otherwise
+const (
+	DefaultSalaryPeriod = db.SalaryPeriodMONTH
+	DefaultSalaryType   = db.SalaryTypeGROSS
+)
+
+type TagsMatch string
+
+const (
+	TagsMatchAny TagsMatch = "any"
+	TagsMatchAll TagsMatch = "all"
 )
 
 type JobApplicationsQueryParams struct {
-	Page                  int       `form:"page" binding:"min=0"`
-	Size                  int       `form:"size" binding:"min=0"`
-	Sort                  Sort      `form:"sort" binding:"omitempty,oneof=company_name -company_name job_title -job_title date_applied -date_applied status -status salary -salary is_replied -is_replied"`
-	CompanyNameOrJobTitle string    `form:"company_name_or_job_title" binding:"omitempty"`
-	DateApplied           string    `form:"date_applied" binding:"omitempty,datetime=2006-01-02"`
-	Status                db.Status `form:"status" binding:"omitempty,oneof=IN_PROGRESS REJECTED ACCEPTED"`
+	Page                  int             `form:"page" binding:"min=0"`
+	Size                  int             `form:"size" binding:"min=0"`
+	Sort                  string          `form:"sort" binding:"omitempty"` // NOTE: Comma-separated list of Sort values, validated by NewGetJobApplicationsParams
+	Q                     string          `form:"q" binding:"omitempty"`    // NOTE: Full-text search across company name, job title, notes and job posting url
+	CompanyNameOrJobTitle string          `form:"company_name_or_job_title" binding:"omitempty"`
+	DateApplied           string          `form:"date_applied" binding:"omitempty,datetime=2006-01-02"`
+	DateAppliedFrom       string          `form:"date_applied_from" binding:"omitempty,datetime=2006-01-02"`
+	DateAppliedTo         string          `form:"date_applied_to" binding:"omitempty,datetime=2006-01-02"`
+	AppliedWithinDays     int             `form:"applied_within_days" binding:"omitempty,min=1"` // NOTE: Relative to today, so that it stays useful in a saved view
+	StageID               []string        `form:"stage_id" binding:"omitempty,dive,uuid"`
+	Outcome               db.StageOutcome `form:"outcome" binding:"omitempty,oneof=NEUTRAL POSITIVE NEGATIVE"`
+	MinSalary             *float64        `form:"min_salary" binding:"omitempty,gte=0"`
+	MaxSalary             *float64        `form:"max_salary" binding:"omitempty,gte=0"`
+	SalaryCurrency        string          `form:"salary_currency" binding:"omitempty,iso4217"` // NOTE: Currency and period salaries are converted to before being filtered and sorted by
+	SalaryPeriod          db.SalaryPeriod `form:"salary_period" binding:"omitempty,oneof=HOUR MONTH YEAR"`
+	SalaryType            db.SalaryType   `form:"salary_type" binding:"omitempty,oneof=GROSS NET"`
+	IsReplied             *bool           `form:"is_replied" binding:"omitempty"`
+	Tags                  []string        `form:"tags" binding:"omitempty,dive,uuid"`
+	TagsMatch             TagsMatch       `form:"tags_match" binding:"omitempty,oneof=any all"`
+	Cursor                string          `form:"cursor" binding:"omitempty"` // NOTE: Switches to cursor-based pagination whenever present, even if empty
+}
+
+// newSorts splits the sort param, falling back to relevance when searching and to the latest applications otherwise
+func newSorts(queryParams JobApplicationsQueryParams) ([]Sort, error) {
+	if queryParams.Sort == "" && utils.ToPrefixTSQuery(queryParams.Q) != "" {
+		return []Sort{Relevance}, nil
+	}
+	if queryParams.Sort == "" {
+		return []Sort{DateAppliedDesc}, nil
+	}
+
+	sorts := []Sort{}
+	seenColumns := map[db.JobApplicationsSortColumn]bool{}
+	for _, value := range strings.Split(queryParams.Sort, ",") {
+		sort := Sort(strings.TrimSpace(value))
+
+		key, ok := sortKeys[sort]
+		if !ok {
+			return nil, fmt.Errorf("unknown sort %q", sort)
+		}
+		if seenColumns[key.Column] {
+			return nil, fmt.Errorf("%s is sorted by more than once", key.Column)
+		}
+		seenColumns[key.Column] = true
+
+		sorts = append(sorts, sort)
+	}
+
+	return sorts, nil
+}
+
+func toUUIDs(values []string) []pgtype.UUID {
+	uuids := []pgtype.UUID{}
+	seen := map[pgtype.UUID]bool{}
+	for _, value := range values {
+		uuid, _ := utils.ToUUID(value) // NOTE: Already validated by the binding
+		// NOTE: Duplicates would make an all-match filter impossible to satisfy
+		if !seen[uuid] {
+			seen[uuid] = true
+			uuids = append(uuids, uuid)
+		}
+	}
+	return uuids
+}
+
+func toDate(value string) pgtype.Date {
+	if value == "" {
+		return pgtype.Date{}
+	}
+	date, _ := time.Parse(time.DateOnly, value) // NOTE: Already validated by the binding
+	return pgtype.Date{Time: date, Valid: true}
+}
+
+// toLocalDate takes an RFC 3339 timestamp as the YYYY-MM-DD day it falls on in the time zone of the user, leaving anything else as it is
+func toLocalDate(value string, preferences db.GetUserPreferencesRow) string {
+	if timestamp, err := time.Parse(time.RFC3339, value); err == nil {
+		return timestamp.In(newLocation(preferences.Timezone)).Format(time.DateOnly)
+	}
+	return value
+}
+
+func fromDate(date pgtype.Date) string {
+	return date.Time.Format(time.DateOnly)
+}
+
+// newSalaryUnit falls back to the preferred currency of the user and the default period for whichever isn't given
+func newSalaryUnit(currency string, period db.SalaryPeriod, preferences db.GetUserPreferencesRow) (string, db.SalaryPeriod) {
+	if currency == "" {
+		currency = preferences.Currency
+	}
+	if period == "" {
+		period = DefaultSalaryPeriod
+	}
+	return currency, period
+}
+
+func NewGetJobApplicationsParams(userId pgtype.UUID, queryParams JobApplicationsQueryParams, preferences db.GetUserPreferencesRow) (db.GetJobApplicationsParams, error) {
+	query := utils.ToPrefixTSQuery(queryParams.Q)
+
+	sorts, err := newSorts(queryParams)
+	if err != nil {
+		return db.GetJobApplicationsParams{}, err
+	}
+
+	sort := []db.JobApplicationsSortKey{}
+	for _, s := range sorts {
+		sort = append(sort, sortKeys[s])
+	}
+
+	var minSalary, maxSalary pgtype.Float8
+	if queryParams.MinSalary != nil {
+		minSalary = pgtype.Float8{Float64: *queryParams.MinSalary, Valid: true}
+	}
+	if queryParams.MaxSalary != nil {
+		maxSalary = pgtype.Float8{Float64: *queryParams.MaxSalary, Valid: true}
+	}
+
+	var isReplied pgtype.Bool
+	if queryParams.IsReplied != nil {
+		isReplied = pgtype.Bool{Bool: *queryParams.IsReplied, Valid: true}
+	}
+
+	salaryCurrency, salaryPeriod := newSalaryUnit(queryParams.SalaryCurrency, queryParams.SalaryPeriod, preferences)
+
+	return db.GetJobApplicationsParams{
+		UserID: userId,
+
+		Limit:  int32(queryParams.Size),
+		Offset: int32(queryParams.Page * queryParams.Size),
+
+		Sort:      sort,
+		WithTotal: true,
+
+		Query:                 pgtype.Text{String: query, Valid: query != ""},
+		CompanyNameOrJobTitle: queryParams.CompanyNameOrJobTitle,
+		DateApplied:           toDate(queryParams.DateApplied),
+		DateAppliedFrom:       toDate(queryParams.DateAppliedFrom),
+		DateAppliedTo:         toDate(queryParams.DateAppliedTo),
+		AppliedWithinDays:     int32(queryParams.AppliedWithinDays),
+		Timezone:              preferences.Timezone,
+		StageIDs:              toUUIDs(queryParams.StageID),
+		StageOutcome:          db.NullStageOutcome{StageOutcome: queryParams.Outcome, Valid: queryParams.Outcome != ""},
+		MinSalary:             minSalary,
+		MaxSalary:             maxSalary,
+		SalaryCurrency:        salaryCurrency,
+		SalaryPeriod:          salaryPeriod,
+		SalaryType:            db.NullSalaryType{SalaryType: queryParams.SalaryType, Valid: queryParams.SalaryType != ""},
+		IsReplied:             isReplied,
+		TagIds:                toUUIDs(queryParams.Tags),
+		TagsMatchAll:          queryParams.TagsMatch == TagsMatchAll,
+	}, nil
+}
+
+// newSortValue mirrors the sort key expressions in the query, so that a row can be continued after
+func newSortValue(column db.JobApplicationsSortColumn, jobApplication db.GetJobApplicationsRow) any {
+	switch column {
+	case db.JobApplicationsSortCompanyName:
+		return jobApplication.CompanyName
+	case db.JobApplicationsSortJobTitle:
+		return jobApplication.JobTitle
+	case db.JobApplicationsSortDateApplied:
+		return jobApplication.DateApplied.Time
+	case db.JobApplicationsSortStage:
+		return jobApplication.StagePosition
+	case db.JobApplicationsSortSalary:
+		if !jobApplication.Salary.Valid {
+			return nil
+		}
+		return jobApplication.Salary.Float64
+	case db.JobApplicationsSortIsReplied:
+		return jobApplication.IsReplied
+	case db.JobApplicationsSortRelevance:
+		return jobApplication.Rank
+	}
+	return nil
+}
+
+func decodeSortValue[T any](raw json.RawMessage) (any, error) {
+	var value *T
+	if err := json.Unmarshal(raw, &value); err != nil || value == nil {
+		return nil, err
+	}
+	return *value, nil
+}
+
+// NOTE: JSON loses the Go types, so they are restored per column before being compared against in SQL
+func parseSortValue(column db.JobApplicationsSortColumn, raw json.RawMessage) (any, error) {
+	switch column {
+	case db.JobApplicationsSortCompanyName, db.JobApplicationsSortJobTitle:
+		return decodeSortValue[string](raw)
+	case db.JobApplicationsSortDateApplied:
+		return decodeSortValue[time.Time](raw)
+	case db.JobApplicationsSortStage:
+		return decodeSortValue[int32](raw)
+	case db.JobApplicationsSortSalary:
+		return decodeSortValue[float64](raw)
+	case db.JobApplicationsSortIsReplied:
+		return decodeSortValue[bool](raw)
+	case db.JobApplicationsSortRelevance:
+		return decodeSortValue[float32](raw)
+	}
+	return nil, errors.New("unknown sort column")
+}
+
+// NOTE: Opaque to clients, it holds the sort key values and id of the last row on a page
+type jobApplicationsCursor struct {
+	Sort   string            `json:"s"`
+	ID     pgtype.UUID       `json:"i"`
+	Values []json.RawMessage `json:"v"`
+}
+
+func newJobApplicationsCursor(params db.GetJobApplicationsParams, jobApplication db.GetJobApplicationsRow) string {
+	cursor := jobApplicationsCursor{
+		Sort: newSortString(params.Sort),
+		ID:   jobApplication.ID,
+	}
+
+	for _, key := range params.Sort {
+		raw, _ := json.Marshal(newSortValue(key.Column, jobApplication))
+		cursor.Values = append(cursor.Values, raw)
+	}
+
+	raw, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func newSortString(sort []db.JobApplicationsSortKey) string {
+	keys := []string{}
+	for _, key := range sort {
+		if key.Desc {
+			keys = append(keys, "-"+string(key.Column))
+		} else {
+			keys = append(keys, string(key.Column))
+		}
+	}
+	return strings.Join(keys, ",")
+}
+
+// NewGetJobApplicationsCursorParams asks for one row more than the page size, so that it's known whether a next page exists.
+// An empty cursor starts from the first row.
+func NewGetJobApplicationsCursorParams(userId pgtype.UUID, queryParams JobApplicationsQueryParams, preferences db.GetUserPreferencesRow) (db.GetJobApplicationsParams, error) {
+	params, err := NewGetJobApplicationsParams(userId, queryParams, preferences)
+	if err != nil {
+		return params, err
+	}
+
+	params.Limit = int32(queryParams.Size) + 1
+	params.Offset = 0
+	params.WithTotal = false
+
+	if queryParams.Size == 0 {
+		return params, errors.New("size must be greater than 0 when paginating by cursor")
+	}
+
+	if queryParams.Cursor == "" {
+		return params, nil
+	}
+
+	var cursor jobApplicationsCursor
+
+	raw, err := base64.RawURLEncoding.DecodeString(queryParams.Cursor)
+	if err != nil || json.Unmarshal(raw, &cursor) != nil || !cursor.ID.Valid {
+		return params, errors.New("invalid cursor")
+	}
+
+	// NOTE: A cursor only makes sense within the order it was issued for
+	if cursor.Sort != newSortString(params.Sort) || len(cursor.Values) != len(params.Sort) {
+		return params, errors.New("cursor was issued for a different sort")
+	}
+
+	for i, key := range params.Sort {
+		value, err := parseSortValue(key.Column, cursor.Values[i])
+		if err != nil {
+			return params, errors.New("invalid cursor")
+		}
+		params.After = append(params.After, value)
+	}
+	params.AfterID = cursor.ID
+
+	return params, nil
+}
+
+// NewGetJobApplicationsParamsAfter continues from the row right after the given one, in the same order
+func NewGetJobApplicationsParamsAfter(params db.GetJobApplicationsParams, jobApplication db.GetJobApplicationsRow) db.GetJobApplicationsParams {
+	params.Offset = 0
+	params.After = []any{}
+	for _, key := range params.Sort {
+		params.After = append(params.After, newSortValue(key.Column, jobApplication))
+	}
+	params.AfterID = jobApplication.ID
+
+	return params
+}
+
+type jobApplicationStage struct {
+	ID         string          `json:"id" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	Name       string          `json:"name" example:"Tech interview"`
+	Color      string          `json:"color" example:"#0284c7"`
+	IsTerminal bool            `json:"isTerminal" example:"false"`
+	Outcome    db.StageOutcome `json:"outcome" example:"NEUTRAL"`
+}
+
+type jobApplicationContact struct {
+	ID          string `json:"id" example:"6b1f0c2a-4d3e-4f5a-9b8c-7d6e5f4a3b2c"`
+	Name        string `json:"name" example:"Jane Doe"`
+	Email       string `json:"email,omitempty" example:"jane.doe@evilcorp.com"`
+	Phone       string `json:"phone,omitempty" example:"+48 123 456 789"`
+	LinkedInURL string `json:"linkedInURL,omitempty" example:"https://www.linkedin.com/in/jane-doe"`
+	Company     string `json:"company,omitempty" example:"Evil Corp Inc."`
+	Role        string `json:"role,omitempty" example:"Technical Recruiter"`
+}
+
+func newJobApplicationContacts(contacts []db.GetJobApplicationContactsRow) []jobApplicationContact {
+	data := []jobApplicationContact{}
+
+	for _, contact := range contacts {
+		data = append(data, jobApplicationContact{
+			ID:          contact.ID.String(),
+			Name:        contact.Name,
+			Email:       contact.Email.String,
+			Phone:       contact.Phone.String,
+			LinkedInURL: contact.LinkedinUrl.String,
+			Company:     contact.Company.String,
+			Role:        contact.Role.String,
+		})
+	}
+
+	return data
+}
+
+type jobApplicationTag struct {
+	ID    string `json:"id" example:"9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b"`
+	Name  string `json:"name" example:"remote"`
+	Color string `json:"color" example:"#7c3aed"`
+}
+
+func newJobApplicationTags(tags []db.GetJobApplicationTagsRow) []jobApplicationTag {
+	data := []jobApplicationTag{}
+
+	for _, tag := range tags {
+		data = append(data, jobApplicationTag{
+			ID:    tag.ID.String(),
+			Name:  tag.Name,
+			Color: tag.Color,
+		})
+	}
+
+	return data
 }
 
 type jobApplicationEntry struct {
-	ID            string    `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
-	CompanyName   string    `json:"companyName" example:"Evil Corp Inc."`
-	JobTitle      string    `json:"jobTitle" example:"Software Engineer"`
-	DateApplied   time.Time `json:"dateApplied" example:"2025-03-14T12:34:56Z"`
-	Status        db.Status `json:"status" example:"IN_PROGRESS"`
-	IsReplied     bool      `json:"isReplied" example:"false"`
-	MinSalary     float64   `json:"minSalary,omitempty" example:"50000.00"`
-	MaxSalary     float64   `json:"maxSalary,omitempty" example:"70000.00"`
-	JobPostingURL string    `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	ID             string              `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyID      string              `json:"companyId" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string              `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle       string              `json:"jobTitle" example:"Software Engineer"`
+	DateApplied    string              `json:"dateApplied" example:"2025-03-14"`
+	Stage          jobApplicationStage `json:"stage"`
+	IsReplied      bool                `json:"isReplied" example:"false"`
+	MinSalary      float64             `json:"minSalary,omitempty" example:"50000.00"`
+	MaxSalary      float64             `json:"maxSalary,omitempty" example:"70000.00"`
+	SalaryCurrency string              `json:"salaryCurrency" example:"PLN"`
+	SalaryPeriod   db.SalaryPeriod     `json:"salaryPeriod" example:"MONTH"`
+	SalaryType     db.SalaryType       `json:"salaryType" example:"GROSS"`
+	ContractType   db.ContractType     `json:"contractType,omitempty" example:"B2B"`
+	JobPostingURL  string              `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Tags           []jobApplicationTag `json:"tags"`
+	Snippet        string              `json:"snippet,omitempty" example:"Evil Corp Inc. | Software Engineer | <mark>Follow</mark> up in two weeks"` // NOTE: Only set when searching, with matches wrapped in <mark> tags
+}
+
+// newSnippet escapes the matched text, so that the <mark> tags are the only markup a client can render
+func newSnippet(snippet string) string {
+	snippet = html.EscapeString(snippet)
+	snippet = strings.ReplaceAll(snippet, "&lt;mark&gt;", "<mark>")
+	return strings.ReplaceAll(snippet, "&lt;/mark&gt;", "</mark>")
 }
 
 type JobApplicationsResBody struct {
@@ -52,23 +446,45 @@ type JobApplicationsResBody struct {
 	Data  []jobApplicationEntry `json:"data"`
 }
 
-func NewJobApplicationsResBody(page, size int, jobApplications []db.GetJobApplicationsRow) JobApplicationsResBody {
+func newJobApplicationEntries(jobApplications []db.GetJobApplicationsRow, tags []db.GetJobApplicationTagsRow) []jobApplicationEntry {
 	data := []jobApplicationEntry{}
 
+	tagsByJobApplication := map[pgtype.UUID][]db.GetJobApplicationTagsRow{}
+	for _, tag := range tags {
+		tagsByJobApplication[tag.JobApplicationID] = append(tagsByJobApplication[tag.JobApplicationID], tag)
+	}
+
 	for _, jobApplication := range jobApplications {
 		data = append(data, jobApplicationEntry{
-			ID:            jobApplication.ID.String(),
-			CompanyName:   jobApplication.CompanyName,
-			JobTitle:      jobApplication.JobTitle,
-			DateApplied:   jobApplication.DateApplied.Time.UTC(),
-			Status:        jobApplication.Status,
-			IsReplied:     jobApplication.IsReplied,
-			MinSalary:     jobApplication.MinSalary.Float64,
-			MaxSalary:     jobApplication.MaxSalary.Float64,
-			JobPostingURL: jobApplication.JobPostingUrl.String,
+			ID:          jobApplication.ID.String(),
+			CompanyID:   jobApplication.CompanyID.String(),
+			CompanyName: jobApplication.CompanyName,
+			JobTitle:    jobApplication.JobTitle,
+			DateApplied: fromDate(jobApplication.DateApplied),
+			Stage: jobApplicationStage{
+				ID:         jobApplication.StageID.String(),
+				Name:       jobApplication.StageName,
+				Color:      jobApplication.StageColor,
+				IsTerminal: jobApplication.StageIsTerminal,
+				Outcome:    jobApplication.StageOutcome,
+			},
+			IsReplied:      jobApplication.IsReplied,
+			MinSalary:      jobApplication.MinSalary.Float64,
+			MaxSalary:      jobApplication.MaxSalary.Float64,
+			SalaryCurrency: jobApplication.SalaryCurrency,
+			SalaryPeriod:   jobApplication.SalaryPeriod,
+			SalaryType:     jobApplication.SalaryType,
+			ContractType:   jobApplication.ContractType.ContractType,
+			JobPostingURL:  jobApplication.JobPostingUrl.String,
+			Tags:           newJobApplicationTags(tagsByJobApplication[jobApplication.ID]),
+			Snippet:        newSnippet(jobApplication.Snippet),
 		})
 	}
 
+	return data
+}
+
+func NewJobApplicationsResBody(page, size int, jobApplications []db.GetJobApplicationsRow, tags []db.GetJobApplicationTagsRow) JobApplicationsResBody {
 	total := 0
 
 	if len(jobApplications) > 0 {
@@ -79,159 +495,396 @@ func NewJobApplicationsResBody(page, size int, jobApplications []db.GetJobApplic
 		Page:  page,
 		Size:  size,
 		Total: total,
-		Data:  data,
+		Data:  newJobApplicationEntries(jobApplications, tags),
 	}
 }
 
+type JobApplicationsCursorResBody struct {
+	Size       int                   `json:"size" example:"10"`
+	NextCursor *string               `json:"nextCursor" example:"eyJzIjoiLWRhdGVfYXBwbGllZCJ9"` // NOTE: Null on the last page
+	Data       []jobApplicationEntry `json:"data"`
+}
+
+// NOTE: Expects the extra row asked for by NewGetJobApplicationsCursorParams, if there's one
+func NewJobApplicationsCursorResBody(size int, params db.GetJobApplicationsParams, jobApplications []db.GetJobApplicationsRow, tags []db.GetJobApplicationTagsRow) JobApplicationsCursorResBody {
+	var nextCursor *string
+
+	if len(jobApplications) > size {
+		jobApplications = jobApplications[:size]
+		cursor := newJobApplicationsCursor(params, jobApplications[len(jobApplications)-1])
+		nextCursor = &cursor
+	}
+
+	return JobApplicationsCursorResBody{
+		Size:       size,
+		NextCursor: nextCursor,
+		Data:       newJobApplicationEntries(jobApplications, tags),
+	}
+}
+
+// NewJobApplicationETag derives the ETag of a job application from its version, which every update increments.
+// It covers the fields of the job application itself, not its tags and contacts, which are changed through endpoints of their own.
+func NewJobApplicationETag(version int32) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
 type JobApplicationResBody struct {
-	ID            string    `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
-	CompanyName   string    `json:"companyName" example:"Evil Corp Inc."`
-	JobTitle      string    `json:"jobTitle" example:"Software Engineer"`
-	DateApplied   time.Time `json:"dateApplied" example:"2025-03-14T12:34:56Z"`
-	Status        db.Status `json:"status" example:"IN_PROGRESS"`
-	IsReplied     bool      `json:"isReplied" example:"false"`
-	MinSalary     float64   `json:"minSalary,omitempty" example:"50000.00"`
-	MaxSalary     float64   `json:"maxSalary,omitempty" example:"70000.00"`
-	JobPostingURL string    `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
-	Notes         string    `json:"notes,omitempty" example:"Follow up in two weeks"`
-}
-
-func NewJobApplicationResBody(jobApplication db.GetJobApplicationRow) JobApplicationResBody {
+	ID             string                  `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyID      string                  `json:"companyId" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string                  `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle       string                  `json:"jobTitle" example:"Software Engineer"`
+	DateApplied    string                  `json:"dateApplied" example:"2025-03-14"`
+	Stage          jobApplicationStage     `json:"stage"`
+	IsReplied      bool                    `json:"isReplied" example:"false"`
+	MinSalary      float64                 `json:"minSalary,omitempty" example:"50000.00"`
+	MaxSalary      float64                 `json:"maxSalary,omitempty" example:"70000.00"`
+	SalaryCurrency string                  `json:"salaryCurrency" example:"PLN"`
+	SalaryPeriod   db.SalaryPeriod         `json:"salaryPeriod" example:"MONTH"`
+	SalaryType     db.SalaryType           `json:"salaryType" example:"GROSS"`
+	ContractType   db.ContractType         `json:"contractType,omitempty" example:"B2B"`
+	JobPostingURL  string                  `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          string                  `json:"notes,omitempty" example:"Follow up in two weeks"`
+	Tags           []jobApplicationTag     `json:"tags"`
+	Contacts       []jobApplicationContact `json:"contacts"`
+}
+
+func NewJobApplicationResBody(jobApplication db.GetJobApplicationRow, tags []db.GetJobApplicationTagsRow, contacts []db.GetJobApplicationContactsRow) JobApplicationResBody {
 	return JobApplicationResBody{
-		ID:            jobApplication.ID.String(),
-		CompanyName:   jobApplication.CompanyName,
-		JobTitle:      jobApplication.JobTitle,
-		DateApplied:   jobApplication.DateApplied.Time.UTC(),
-		Status:        jobApplication.Status,
-		IsReplied:     jobApplication.IsReplied,
-		MinSalary:     jobApplication.MinSalary.Float64,
-		MaxSalary:     jobApplication.MaxSalary.Float64,
-		JobPostingURL: jobApplication.JobPostingUrl.String,
-		Notes:         jobApplication.Notes.String,
+		ID:          jobApplication.ID.String(),
+		CompanyID:   jobApplication.CompanyID.String(),
+		CompanyName: jobApplication.CompanyName,
+		JobTitle:    jobApplication.JobTitle,
+		DateApplied: fromDate(jobApplication.DateApplied),
+		Stage: jobApplicationStage{
+			ID:         jobApplication.StageID.String(),
+			Name:       jobApplication.StageName,
+			Color:      jobApplication.StageColor,
+			IsTerminal: jobApplication.StageIsTerminal,
+			Outcome:    jobApplication.StageOutcome,
+		},
+		IsReplied:      jobApplication.IsReplied,
+		MinSalary:      jobApplication.MinSalary.Float64,
+		MaxSalary:      jobApplication.MaxSalary.Float64,
+		SalaryCurrency: jobApplication.SalaryCurrency,
+		SalaryPeriod:   jobApplication.SalaryPeriod,
+		SalaryType:     jobApplication.SalaryType,
+		ContractType:   jobApplication.ContractType.ContractType,
+		JobPostingURL:  jobApplication.JobPostingUrl.String,
+		Notes:          jobApplication.Notes.String,
+		Tags:           newJobApplicationTags(tags),
+		Contacts:       newJobApplicationContacts(contacts),
 	}
 }
 
 type CreateJobApplicationReqBody struct {
-	CompanyName   string    `json:"companyName" binding:"required" example:"Evil Corp Inc."`
-	JobTitle      string    `json:"jobTitle" binding:"required" example:"Software Engineer"`
-	DateApplied   time.Time `json:"dateApplied" binding:"required" example:"2025-03-14T12:34:56Z"`
-	Status        db.Status `json:"status" binding:"required,oneof=IN_PROGRESS REJECTED ACCEPTED" example:"IN_PROGRESS"`
-	MinSalary     float64   `json:"minSalary,omitempty" binding:"omitempty,gte=0" example:"50000.00"`
-	MaxSalary     float64   `json:"maxSalary,omitempty" binding:"omitempty,gte=0" example:"70000.00"`
-	JobPostingURL string    `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
-	Notes         string    `json:"notes,omitempty" example:"Follow up in two weeks"`
+	CompanyID      string          `json:"companyId,omitempty" binding:"omitempty,uuid" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string          `json:"companyName,omitempty" binding:"required_without=CompanyID" example:"Evil Corp Inc."` // NOTE: Shortcut for companyId, matched against existing companies by normalised name
+	JobTitle       string          `json:"jobTitle" binding:"required" example:"Software Engineer"`
+	DateApplied    string          `json:"dateApplied" binding:"required,datetime=2006-01-02|datetime=2006-01-02T15:04:05Z07:00" example:"2025-03-14"` // NOTE: Or an RFC 3339 timestamp, taken as the day it falls on in the time zone of the user
+	StageID        string          `json:"stageId,omitempty" binding:"omitempty,uuid" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`                  // NOTE: Defaults to the first stage
+	MinSalary      *float64        `json:"minSalary,omitempty" binding:"omitempty,gte=0" example:"50000.00"`
+	MaxSalary      *float64        `json:"maxSalary,omitempty" binding:"omitempty,gte=0" example:"70000.00"`
+	SalaryCurrency string          `json:"salaryCurrency,omitempty" binding:"omitempty,iso4217" example:"PLN"`               // NOTE: Defaults to the preferred currency
+	SalaryPeriod   db.SalaryPeriod `json:"salaryPeriod,omitempty" binding:"omitempty,oneof=HOUR MONTH YEAR" example:"MONTH"` // NOTE: Defaults to MONTH
+	SalaryType     db.SalaryType   `json:"salaryType,omitempty" binding:"omitempty,oneof=GROSS NET" example:"GROSS"`         // NOTE: Defaults to GROSS
+	ContractType   db.ContractType `json:"contractType,omitempty" binding:"omitempty,oneof=UOP B2B UZ UOD OTHER" example:"B2B"`
+	JobPostingURL  string          `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          string          `json:"notes,omitempty" example:"Follow up in two weeks"`
 }
 
-func NewCreateJobApplicationReqBody(companyName, jobTitle string, dateApplied time.Time, status db.Status, minSalary, maxSalary float64, jobPostingURL, notes string) CreateJobApplicationReqBody {
+func NewCreateJobApplicationReqBody(companyId, companyName, jobTitle, dateApplied, stageId string, minSalary, maxSalary *float64, salaryCurrency string, salaryPeriod db.SalaryPeriod, salaryType db.SalaryType, contractType db.ContractType, jobPostingURL, notes string) CreateJobApplicationReqBody {
 	return CreateJobApplicationReqBody{
-		CompanyName:   companyName,
-		JobTitle:      jobTitle,
-		DateApplied:   dateApplied,
-		Status:        status,
-		MinSalary:     minSalary,
-		MaxSalary:     maxSalary,
-		JobPostingURL: jobPostingURL,
-		Notes:         notes,
+		CompanyID:      companyId,
+		CompanyName:    companyName,
+		JobTitle:       jobTitle,
+		DateApplied:    dateApplied,
+		StageID:        stageId,
+		MinSalary:      minSalary,
+		MaxSalary:      maxSalary,
+		SalaryCurrency: salaryCurrency,
+		SalaryPeriod:   salaryPeriod,
+		SalaryType:     salaryType,
+		ContractType:   contractType,
+		JobPostingURL:  jobPostingURL,
+		Notes:          notes,
 	}
 }
 
 type CreateJobApplicationResBody struct {
-	ID            string    `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
-	CompanyName   string    `json:"companyName" example:"Evil Corp Inc."`
-	JobTitle      string    `json:"jobTitle" example:"Software Engineer"`
-	DateApplied   time.Time `json:"dateApplied" example:"2025-03-14T12:34:56Z"`
-	Status        db.Status `json:"status" example:"IN_PROGRESS"`
-	IsReplied     bool      `json:"isReplied" example:"false"`
-	MinSalary     float64   `json:"minSalary,omitempty" example:"50000.00"`
-	MaxSalary     float64   `json:"maxSalary,omitempty" example:"70000.00"`
-	JobPostingURL string    `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
-	Notes         string    `json:"notes,omitempty" example:"Follow up in two weeks"`
+	ID             string              `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyID      string              `json:"companyId" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string              `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle       string              `json:"jobTitle" example:"Software Engineer"`
+	DateApplied    string              `json:"dateApplied" example:"2025-03-14"`
+	Stage          jobApplicationStage `json:"stage"`
+	IsReplied      bool                `json:"isReplied" example:"false"`
+	MinSalary      float64             `json:"minSalary,omitempty" example:"50000.00"`
+	MaxSalary      float64             `json:"maxSalary,omitempty" example:"70000.00"`
+	SalaryCurrency string              `json:"salaryCurrency" example:"PLN"`
+	SalaryPeriod   db.SalaryPeriod     `json:"salaryPeriod" example:"MONTH"`
+	SalaryType     db.SalaryType       `json:"salaryType" example:"GROSS"`
+	ContractType   db.ContractType     `json:"contractType,omitempty" example:"B2B"`
+	JobPostingURL  string              `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          string              `json:"notes,omitempty" example:"Follow up in two weeks"`
 }
 
 func NewCreateJobApplicationResBody(jobApplication db.CreateJobApplicationRow) CreateJobApplicationResBody {
 	return CreateJobApplicationResBody{
-		ID:            jobApplication.ID.String(),
-		CompanyName:   jobApplication.CompanyName,
-		JobTitle:      jobApplication.JobTitle,
-		DateApplied:   jobApplication.DateApplied.Time.UTC(),
-		Status:        jobApplication.Status,
-		IsReplied:     jobApplication.IsReplied,
-		MinSalary:     jobApplication.MinSalary.Float64,
-		MaxSalary:     jobApplication.MaxSalary.Float64,
-		JobPostingURL: jobApplication.JobPostingUrl.String,
-		Notes:         jobApplication.Notes.String,
+		ID:          jobApplication.ID.String(),
+		CompanyID:   jobApplication.CompanyID.String(),
+		CompanyName: jobApplication.CompanyName,
+		JobTitle:    jobApplication.JobTitle,
+		DateApplied: fromDate(jobApplication.DateApplied),
+		Stage: jobApplicationStage{
+			ID:         jobApplication.StageID.String(),
+			Name:       jobApplication.StageName,
+			Color:      jobApplication.StageColor,
+			IsTerminal: jobApplication.StageIsTerminal,
+			Outcome:    jobApplication.StageOutcome,
+		},
+		IsReplied:      jobApplication.IsReplied,
+		MinSalary:      jobApplication.MinSalary.Float64,
+		MaxSalary:      jobApplication.MaxSalary.Float64,
+		SalaryCurrency: jobApplication.SalaryCurrency,
+		SalaryPeriod:   jobApplication.SalaryPeriod,
+		SalaryType:     jobApplication.SalaryType,
+		ContractType:   jobApplication.ContractType.ContractType,
+		JobPostingURL:  jobApplication.JobPostingUrl.String,
+		Notes:          jobApplication.Notes.String,
 	}
 }
 
+func NewCreateJobApplicationParams(userId pgtype.UUID, body CreateJobApplicationReqBody, preferences db.GetUserPreferencesRow) db.CreateJobApplicationParams {
+	salaryCurrency, _ := newSalaryUnit(body.SalaryCurrency, body.SalaryPeriod, preferences)
+
+	params := db.CreateJobApplicationParams{
+		UserID:         userId,
+		CompanyName:    body.CompanyName,
+		JobTitle:       body.JobTitle,
+		DateApplied:    toDate(toLocalDate(body.DateApplied, preferences)),
+		SalaryCurrency: pgtype.Text{String: salaryCurrency, Valid: salaryCurrency != ""},
+		SalaryPeriod:   db.NullSalaryPeriod{SalaryPeriod: body.SalaryPeriod, Valid: body.SalaryPeriod != ""},
+		SalaryType:     db.NullSalaryType{SalaryType: body.SalaryType, Valid: body.SalaryType != ""},
+		ContractType:   db.NullContractType{ContractType: body.ContractType, Valid: body.ContractType != ""},
+		JobPostingUrl:  pgtype.Text{String: body.JobPostingURL, Valid: true},
+		Notes:          pgtype.Text{String: body.Notes, Valid: true},
+	}
+
+	if body.CompanyID != "" {
+		params.CompanyID, _ = utils.ToUUID(body.CompanyID) // NOTE: Already validated by the binding
+	}
+	if body.StageID != "" {
+		params.StageID, _ = utils.ToUUID(body.StageID) // NOTE: Already validated by the binding
+	}
+	if body.MinSalary != nil {
+		params.MinSalary = pgtype.Float8{Float64: *body.MinSalary, Valid: true}
+	}
+	if body.MaxSalary != nil {
+		params.MaxSalary = pgtype.Float8{Float64: *body.MaxSalary, Valid: true}
+	}
+
+	return params
+}
+
+// NOTE: Replaces the job application as a whole, so optional fields left out are cleared
 type UpdateJobApplicationReqBody struct {
-	CompanyName   string     `json:"companyName,omitempty" example:"Evil Corp Inc."`
-	JobTitle      string     `json:"jobTitle,omitempty" example:"Software Engineer"`
-	DateApplied   *time.Time `json:"dateApplied,omitempty" example:"2025-03-14T12:34:56Z"`
-	Status        *db.Status `json:"status,omitempty" binding:"omitempty,oneof=IN_PROGRESS REJECTED ACCEPTED" example:"IN_PROGRESS"`
-	IsReplied     *bool      `json:"isReplied,omitempty" example:"false"`
-	MinSalary     *float64   `json:"minSalary,omitempty" binding:"omitempty,gte=0" example:"50000.00"`
-	MaxSalary     *float64   `json:"maxSalary,omitempty" binding:"omitempty,gte=0" example:"70000.00"`
-	JobPostingURL string     `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
-	Notes         string     `json:"notes,omitempty" example:"Follow up in two weeks"`
-}
-
-func NewUpdateJobApplicationReqBody(companyName, jobTitle string, dateApplied *time.Time, status *db.Status, isReplied *bool, minSalary, maxSalary *float64, jobPostingURL, notes string) UpdateJobApplicationReqBody {
+	CompanyID      string          `json:"companyId,omitempty" binding:"omitempty,uuid" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string          `json:"companyName,omitempty" binding:"required_without=CompanyID" example:"Evil Corp Inc."` // NOTE: Shortcut for companyId, matched against existing companies by normalised name
+	JobTitle       string          `json:"jobTitle" binding:"required" example:"Software Engineer"`
+	DateApplied    string          `json:"dateApplied" binding:"required,datetime=2006-01-02|datetime=2006-01-02T15:04:05Z07:00" example:"2025-03-14"` // NOTE: Or an RFC 3339 timestamp, taken as the day it falls on in the time zone of the user
+	StageID        string          `json:"stageId" binding:"required,uuid" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	IsReplied      *bool           `json:"isReplied" binding:"required" example:"false"`
+	MinSalary      *float64        `json:"minSalary,omitempty" binding:"omitempty,gte=0" example:"50000.00"`
+	MaxSalary      *float64        `json:"maxSalary,omitempty" binding:"omitempty,gte=0" example:"70000.00"`
+	SalaryCurrency string          `json:"salaryCurrency,omitempty" binding:"omitempty,iso4217" example:"PLN"`               // NOTE: Reset to the preferred currency when left out
+	SalaryPeriod   db.SalaryPeriod `json:"salaryPeriod,omitempty" binding:"omitempty,oneof=HOUR MONTH YEAR" example:"MONTH"` // NOTE: Reset to MONTH when left out
+	SalaryType     db.SalaryType   `json:"salaryType,omitempty" binding:"omitempty,oneof=GROSS NET" example:"GROSS"`         // NOTE: Reset to GROSS when left out
+	ContractType   db.ContractType `json:"contractType,omitempty" binding:"omitempty,oneof=UOP B2B UZ UOD OTHER" example:"B2B"`
+	JobPostingURL  string          `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          string          `json:"notes,omitempty" example:"Follow up in two weeks"`
+}
+
+func NewUpdateJobApplicationReqBody(companyId, companyName, jobTitle, dateApplied, stageId string, isReplied bool, minSalary, maxSalary *float64, salaryCurrency string, salaryPeriod db.SalaryPeriod, salaryType db.SalaryType, contractType db.ContractType, jobPostingURL, notes string) UpdateJobApplicationReqBody {
 	return UpdateJobApplicationReqBody{
-		CompanyName:   companyName,
-		JobTitle:      jobTitle,
-		DateApplied:   dateApplied,
-		Status:        status,
-		IsReplied:     isReplied,
-		MinSalary:     minSalary,
-		MaxSalary:     maxSalary,
-		JobPostingURL: jobPostingURL,
-		Notes:         notes,
+		CompanyID:      companyId,
+		CompanyName:    companyName,
+		JobTitle:       jobTitle,
+		DateApplied:    dateApplied,
+		StageID:        stageId,
+		IsReplied:      &isReplied,
+		MinSalary:      minSalary,
+		MaxSalary:      maxSalary,
+		SalaryCurrency: salaryCurrency,
+		SalaryPeriod:   salaryPeriod,
+		SalaryType:     salaryType,
+		ContractType:   contractType,
+		JobPostingURL:  jobPostingURL,
+		Notes:          notes,
 	}
 }
 
 type UpdateJobApplicationResBody struct {
-	ID            string    `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
-	CompanyName   string    `json:"companyName" example:"Evil Corp Inc."`
-	JobTitle      string    `json:"jobTitle" example:"Software Engineer"`
-	DateApplied   time.Time `json:"dateApplied" example:"2025-03-14T12:34:56Z"`
-	Status        db.Status `json:"status" example:"IN_PROGRESS"`
-	IsReplied     bool      `json:"isReplied" example:"false"`
-	MinSalary     float64   `json:"minSalary,omitempty" example:"50000.00"`
-	MaxSalary     float64   `json:"maxSalary,omitempty" example:"70000.00"`
-	JobPostingURL string    `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
-	Notes         string    `json:"notes,omitempty" example:"Follow up in two weeks"`
+	ID             string              `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyID      string              `json:"companyId" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string              `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle       string              `json:"jobTitle" example:"Software Engineer"`
+	DateApplied    string              `json:"dateApplied" example:"2025-03-14"`
+	Stage          jobApplicationStage `json:"stage"`
+	IsReplied      bool                `json:"isReplied" example:"false"`
+	MinSalary      float64             `json:"minSalary,omitempty" example:"50000.00"`
+	MaxSalary      float64             `json:"maxSalary,omitempty" example:"70000.00"`
+	SalaryCurrency string              `json:"salaryCurrency" example:"PLN"`
+	SalaryPeriod   db.SalaryPeriod     `json:"salaryPeriod" example:"MONTH"`
+	SalaryType     db.SalaryType       `json:"salaryType" example:"GROSS"`
+	ContractType   db.ContractType     `json:"contractType,omitempty" example:"B2B"`
+	JobPostingURL  string              `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          string              `json:"notes,omitempty" example:"Follow up in two weeks"`
 }
 
 func NewUpdateJobApplicationResBody(jobApplication db.UpdateJobApplicationRow) UpdateJobApplicationResBody {
 	return UpdateJobApplicationResBody{
-		ID:            jobApplication.ID.String(),
-		CompanyName:   jobApplication.CompanyName,
-		JobTitle:      jobApplication.JobTitle,
-		DateApplied:   jobApplication.DateApplied.Time.UTC(),
-		Status:        jobApplication.Status,
-		IsReplied:     jobApplication.IsReplied,
-		MinSalary:     jobApplication.MinSalary.Float64,
-		MaxSalary:     jobApplication.MaxSalary.Float64,
-		JobPostingURL: jobApplication.JobPostingUrl.String,
-		Notes:         jobApplication.Notes.String,
+		ID:          jobApplication.ID.String(),
+		CompanyID:   jobApplication.CompanyID.String(),
+		CompanyName: jobApplication.CompanyName,
+		JobTitle:    jobApplication.JobTitle,
+		DateApplied: fromDate(jobApplication.DateApplied),
+		Stage: jobApplicationStage{
+			ID:         jobApplication.StageID.String(),
+			Name:       jobApplication.StageName,
+			Color:      jobApplication.StageColor,
+			IsTerminal: jobApplication.StageIsTerminal,
+			Outcome:    jobApplication.StageOutcome,
+		},
+		IsReplied:      jobApplication.IsReplied,
+		MinSalary:      jobApplication.MinSalary.Float64,
+		MaxSalary:      jobApplication.MaxSalary.Float64,
+		SalaryCurrency: jobApplication.SalaryCurrency,
+		SalaryPeriod:   jobApplication.SalaryPeriod,
+		SalaryType:     jobApplication.SalaryType,
+		ContractType:   jobApplication.ContractType.ContractType,
+		JobPostingURL:  jobApplication.JobPostingUrl.String,
+		Notes:          jobApplication.Notes.String,
 	}
 }
 
-func NewUpdateJobApplicationParams(jobApplicationId, userId pgtype.UUID, body UpdateJobApplicationReqBody) db.UpdateJobApplicationParams {
+func NewUpdateJobApplicationParams(jobApplicationId, userId pgtype.UUID, body UpdateJobApplicationReqBody, preferences db.GetUserPreferencesRow) db.UpdateJobApplicationParams {
 	params := db.UpdateJobApplicationParams{
-		ID:            jobApplicationId,
-		UserID:        userId,
-		CompanyName:   pgtype.Text{String: body.CompanyName, Valid: true},
-		JobTitle:      pgtype.Text{String: body.JobTitle, Valid: true},
-		JobPostingUrl: pgtype.Text{String: body.JobPostingURL, Valid: true},
-		Notes:         pgtype.Text{String: body.Notes, Valid: true},
+		ID:                 jobApplicationId,
+		UserID:             userId,
+		CompanyName:        pgtype.Text{String: body.CompanyName, Valid: true},
+		JobTitle:           pgtype.Text{String: body.JobTitle, Valid: true},
+		DateApplied:        toDate(toLocalDate(body.DateApplied, preferences)),
+		IsReplied:          pgtype.Bool{Bool: *body.IsReplied, Valid: true},
+		ClearMinSalary:     body.MinSalary == nil,
+		ClearMaxSalary:     body.MaxSalary == nil,
+		ClearContractType:  body.ContractType == "",
+		ContractType:       db.NullContractType{ContractType: body.ContractType, Valid: body.ContractType != ""},
+		JobPostingUrl:      pgtype.Text{String: body.JobPostingURL, Valid: true},
+		ClearJobPostingUrl: body.JobPostingURL == "",
+		Notes:              pgtype.Text{String: body.Notes, Valid: true},
+		ClearNotes:         body.Notes == "",
+	}
+
+	params.StageID, _ = utils.ToUUID(body.StageID) // NOTE: Already validated by the binding
+
+	salaryCurrency, salaryPeriod := newSalaryUnit(body.SalaryCurrency, body.SalaryPeriod, preferences)
+	params.SalaryCurrency = pgtype.Text{String: salaryCurrency, Valid: true}
+	params.SalaryPeriod = db.NullSalaryPeriod{SalaryPeriod: salaryPeriod, Valid: true}
+	params.SalaryType = db.NullSalaryType{SalaryType: DefaultSalaryType, Valid: true}
+	if body.SalaryType != "" {
+		params.SalaryType.SalaryType = body.SalaryType
+	}
+
+	if body.CompanyID != "" {
+		params.CompanyID, _ = utils.ToUUID(body.CompanyID) // NOTE: Already validated by the binding
 	}
+	if body.MinSalary != nil {
+		params.MinSalary = pgtype.Float8{Float64: *body.MinSalary, Valid: true}
+	}
+	if body.MaxSalary != nil {
+		params.MaxSalary = pgtype.Float8{Float64: *body.MaxSalary, Valid: true}
+	}
+
+	return params
+}
+
+// NOTE: A JSON Merge Patch (RFC 7396), so fields left out are kept as they are and optional fields set to null are cleared
+type PatchJobApplicationReqBody struct {
+	CompanyID      *string          `json:"companyId,omitempty" binding:"omitnil,uuid" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    *string          `json:"companyName,omitempty" binding:"omitnil,min=1" example:"Evil Corp Inc."` // NOTE: Shortcut for companyId, matched against existing companies by normalised name
+	JobTitle       *string          `json:"jobTitle,omitempty" binding:"omitnil,min=1" example:"Software Engineer"`
+	DateApplied    *string          `json:"dateApplied,omitempty" binding:"omitnil,datetime=2006-01-02|datetime=2006-01-02T15:04:05Z07:00" example:"2025-03-14"` // NOTE: Or an RFC 3339 timestamp, taken as the day it falls on in the time zone of the user
+	StageID        *string          `json:"stageId,omitempty" binding:"omitnil,uuid" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	IsReplied      *bool            `json:"isReplied,omitempty" example:"false"`
+	MinSalary      *float64         `json:"minSalary,omitempty" binding:"omitnil,gte=0" example:"50000.00"`
+	MaxSalary      *float64         `json:"maxSalary,omitempty" binding:"omitnil,gte=0" example:"70000.00"`
+	SalaryCurrency *string          `json:"salaryCurrency,omitempty" binding:"omitnil,iso4217" example:"PLN"`               // NOTE: Reset to the preferred currency when null
+	SalaryPeriod   *db.SalaryPeriod `json:"salaryPeriod,omitempty" binding:"omitnil,oneof=HOUR MONTH YEAR" example:"MONTH"` // NOTE: Reset to MONTH when null
+	SalaryType     *db.SalaryType   `json:"salaryType,omitempty" binding:"omitnil,oneof=GROSS NET" example:"GROSS"`         // NOTE: Reset to GROSS when null
+	ContractType   *db.ContractType `json:"contractType,omitempty" binding:"omitnil,oneof=UOP B2B UZ UOD OTHER" example:"B2B"`
+	JobPostingURL  *string          `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          *string          `json:"notes,omitempty" example:"Follow up in two weeks"`
 
+	nulls map[string]bool
+}
+
+// NOTE: Fields every job application has, which therefore can't be cleared
+var requiredJobApplicationFields = []string{"companyId", "companyName", "jobTitle", "dateApplied", "stageId", "isReplied"}
+
+// UnmarshalJSON tells fields set to null apart from the ones left out, which both end up as nil pointers
+func (body *PatchJobApplicationReqBody) UnmarshalJSON(data []byte) error {
+	type patch PatchJobApplicationReqBody
+
+	if err := json.Unmarshal(data, (*patch)(body)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	body.nulls = map[string]bool{}
+	for field, value := range fields {
+		if string(value) == "null" {
+			body.nulls[field] = true
+		}
+	}
+
+	for _, field := range requiredJobApplicationFields {
+		if body.nulls[field] {
+			return fmt.Errorf("%s can't be null", field)
+		}
+	}
+
+	return nil
+}
+
+func NewPatchJobApplicationParams(jobApplicationId, userId pgtype.UUID, body PatchJobApplicationReqBody, preferences db.GetUserPreferencesRow) db.UpdateJobApplicationParams {
+	params := db.UpdateJobApplicationParams{
+		ID:                 jobApplicationId,
+		UserID:             userId,
+		ClearMinSalary:     body.nulls["minSalary"],
+		ClearMaxSalary:     body.nulls["maxSalary"],
+		ClearContractType:  body.nulls["contractType"],
+		ClearJobPostingUrl: body.nulls["jobPostingURL"] || (body.JobPostingURL != nil && *body.JobPostingURL == ""),
+		ClearNotes:         body.nulls["notes"] || (body.Notes != nil && *body.Notes == ""),
+	}
+
+	if body.CompanyID != nil {
+		params.CompanyID, _ = utils.ToUUID(*body.CompanyID) // NOTE: Already validated by the binding
+	}
+	if body.CompanyName != nil {
+		params.CompanyName = pgtype.Text{String: *body.CompanyName, Valid: true}
+	}
+	if body.JobTitle != nil {
+		params.JobTitle = pgtype.Text{String: *body.JobTitle, Valid: true}
+	}
 	if body.DateApplied != nil {
-		params.DateApplied = pgtype.Timestamptz{Time: *body.DateApplied, Valid: true}
+		params.DateApplied = toDate(toLocalDate(*body.DateApplied, preferences))
 	}
-	if body.Status != nil {
-		params.Status = db.NullStatus{Status: *body.Status, Valid: true}
+	if body.StageID != nil {
+		params.StageID, _ = utils.ToUUID(*body.StageID) // NOTE: Already validated by the binding
 	}
 	if body.IsReplied != nil {
 		params.IsReplied = pgtype.Bool{Bool: *body.IsReplied, Valid: true}
@@ -242,34 +895,183 @@ func NewUpdateJobApplicationParams(jobApplicationId, userId pgtype.UUID, body Up
 	if body.MaxSalary != nil {
 		params.MaxSalary = pgtype.Float8{Float64: *body.MaxSalary, Valid: true}
 	}
+	if body.SalaryCurrency != nil || body.nulls["salaryCurrency"] {
+		params.SalaryCurrency = pgtype.Text{String: preferences.Currency, Valid: true}
+	}
+	if body.SalaryCurrency != nil {
+		params.SalaryCurrency.String = *body.SalaryCurrency
+	}
+	if body.SalaryPeriod != nil || body.nulls["salaryPeriod"] {
+		params.SalaryPeriod = db.NullSalaryPeriod{SalaryPeriod: DefaultSalaryPeriod, Valid: true}
+	}
+	if body.SalaryPeriod != nil {
+		params.SalaryPeriod.SalaryPeriod = *body.SalaryPeriod
+	}
+	if body.SalaryType != nil || body.nulls["salaryType"] {
+		params.SalaryType = db.NullSalaryType{SalaryType: DefaultSalaryType, Valid: true}
+	}
+	if body.SalaryType != nil {
+		params.SalaryType.SalaryType = *body.SalaryType
+	}
+	if body.ContractType != nil {
+		params.ContractType = db.NullContractType{ContractType: *body.ContractType, Valid: true}
+	}
+	if body.JobPostingURL != nil {
+		params.JobPostingUrl = pgtype.Text{String: *body.JobPostingURL, Valid: true}
+	}
+	if body.Notes != nil {
+		params.Notes = pgtype.Text{String: *body.Notes, Valid: true}
+	}
 
 	return params
 }
 
+type PatchJobApplicationResBody struct {
+	ID             string              `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyID      string              `json:"companyId" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string              `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle       string              `json:"jobTitle" example:"Software Engineer"`
+	DateApplied    string              `json:"dateApplied" example:"2025-03-14"`
+	Stage          jobApplicationStage `json:"stage"`
+	IsReplied      bool                `json:"isReplied" example:"false"`
+	MinSalary      float64             `json:"minSalary,omitempty" example:"50000.00"`
+	MaxSalary      float64             `json:"maxSalary,omitempty" example:"70000.00"`
+	SalaryCurrency string              `json:"salaryCurrency" example:"PLN"`
+	SalaryPeriod   db.SalaryPeriod     `json:"salaryPeriod" example:"MONTH"`
+	SalaryType     db.SalaryType       `json:"salaryType" example:"GROSS"`
+	ContractType   db.ContractType     `json:"contractType,omitempty" example:"B2B"`
+	JobPostingURL  string              `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          string              `json:"notes,omitempty" example:"Follow up in two weeks"`
+}
+
+func NewPatchJobApplicationResBody(jobApplication db.UpdateJobApplicationRow) PatchJobApplicationResBody {
+	return PatchJobApplicationResBody{
+		ID:          jobApplication.ID.String(),
+		CompanyID:   jobApplication.CompanyID.String(),
+		CompanyName: jobApplication.CompanyName,
+		JobTitle:    jobApplication.JobTitle,
+		DateApplied: fromDate(jobApplication.DateApplied),
+		Stage: jobApplicationStage{
+			ID:         jobApplication.StageID.String(),
+			Name:       jobApplication.StageName,
+			Color:      jobApplication.StageColor,
+			IsTerminal: jobApplication.StageIsTerminal,
+			Outcome:    jobApplication.StageOutcome,
+		},
+		IsReplied:      jobApplication.IsReplied,
+		MinSalary:      jobApplication.MinSalary.Float64,
+		MaxSalary:      jobApplication.MaxSalary.Float64,
+		SalaryCurrency: jobApplication.SalaryCurrency,
+		SalaryPeriod:   jobApplication.SalaryPeriod,
+		SalaryType:     jobApplication.SalaryType,
+		ContractType:   jobApplication.ContractType.ContractType,
+		JobPostingURL:  jobApplication.JobPostingUrl.String,
+		Notes:          jobApplication.Notes.String,
+	}
+}
+
 type DeleteJobApplicationResBody struct {
-	ID            string    `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
-	CompanyName   string    `json:"companyName" example:"Evil Corp Inc."`
-	JobTitle      string    `json:"jobTitle" example:"Software Engineer"`
-	DateApplied   time.Time `json:"dateApplied" example:"2025-03-14T12:34:56Z"`
-	Status        db.Status `json:"status" example:"IN_PROGRESS"`
-	IsReplied     bool      `json:"isReplied" example:"false"`
-	MinSalary     float64   `json:"minSalary,omitempty" example:"50000.00"`
-	MaxSalary     float64   `json:"maxSalary,omitempty" example:"70000.00"`
-	JobPostingURL string    `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
-	Notes         string    `json:"notes,omitempty" example:"Follow up in two weeks"`
+	ID             string              `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyID      string              `json:"companyId" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string              `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle       string              `json:"jobTitle" example:"Software Engineer"`
+	DateApplied    string              `json:"dateApplied" example:"2025-03-14"`
+	Stage          jobApplicationStage `json:"stage"`
+	IsReplied      bool                `json:"isReplied" example:"false"`
+	MinSalary      float64             `json:"minSalary,omitempty" example:"50000.00"`
+	MaxSalary      float64             `json:"maxSalary,omitempty" example:"70000.00"`
+	SalaryCurrency string              `json:"salaryCurrency" example:"PLN"`
+	SalaryPeriod   db.SalaryPeriod     `json:"salaryPeriod" example:"MONTH"`
+	SalaryType     db.SalaryType       `json:"salaryType" example:"GROSS"`
+	ContractType   db.ContractType     `json:"contractType,omitempty" example:"B2B"`
+	JobPostingURL  string              `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          string              `json:"notes,omitempty" example:"Follow up in two weeks"`
+	DeletedAt      time.Time           `json:"deletedAt" example:"2025-03-21T08:00:00Z"`
+	PurgeAt        time.Time           `json:"purgeAt" example:"2025-04-20T08:00:00Z"` // NOTE: When it's permanently deleted, unless restored before
 }
 
 func NewDeleteJobApplicationResBody(jobApplication db.DeleteJobApplicationRow) DeleteJobApplicationResBody {
 	return DeleteJobApplicationResBody{
-		ID:            jobApplication.ID.String(),
-		CompanyName:   jobApplication.CompanyName,
-		JobTitle:      jobApplication.JobTitle,
-		DateApplied:   jobApplication.DateApplied.Time.UTC(),
-		Status:        jobApplication.Status,
-		IsReplied:     jobApplication.IsReplied,
-		MinSalary:     jobApplication.MinSalary.Float64,
-		MaxSalary:     jobApplication.MaxSalary.Float64,
-		JobPostingURL: jobApplication.JobPostingUrl.String,
-		Notes:         jobApplication.Notes.String,
+		ID:          jobApplication.ID.String(),
+		CompanyID:   jobApplication.CompanyID.String(),
+		CompanyName: jobApplication.CompanyName,
+		JobTitle:    jobApplication.JobTitle,
+		DateApplied: fromDate(jobApplication.DateApplied),
+		Stage: jobApplicationStage{
+			ID:         jobApplication.StageID.String(),
+			Name:       jobApplication.StageName,
+			Color:      jobApplication.StageColor,
+			IsTerminal: jobApplication.StageIsTerminal,
+			Outcome:    jobApplication.StageOutcome,
+		},
+		IsReplied:      jobApplication.IsReplied,
+		MinSalary:      jobApplication.MinSalary.Float64,
+		MaxSalary:      jobApplication.MaxSalary.Float64,
+		SalaryCurrency: jobApplication.SalaryCurrency,
+		SalaryPeriod:   jobApplication.SalaryPeriod,
+		SalaryType:     jobApplication.SalaryType,
+		ContractType:   jobApplication.ContractType.ContractType,
+		JobPostingURL:  jobApplication.JobPostingUrl.String,
+		Notes:          jobApplication.Notes.String,
+		DeletedAt:      jobApplication.DeletedAt.Time.UTC(),
+		PurgeAt:        newPurgeAt(jobApplication.DeletedAt.Time),
+	}
+}
+
+type jobApplicationEvent struct {
+	ID        string    `json:"id" example:"0b6c8f3e-2f4a-4b7e-9d3c-5a1e7f9b2c4d"`
+	Field     string    `json:"field" example:"stage"`
+	OldValue  string    `json:"oldValue,omitempty" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	NewValue  string    `json:"newValue,omitempty" example:"5d2f7c1e-9b4a-4e6d-8c3f-1a2b3c4d5e6f"`
+	CreatedAt time.Time `json:"createdAt" example:"2025-03-21T08:00:00Z"`
+}
+
+type jobApplicationTimelineStage struct {
+	StageID   string     `json:"stageId" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	Name      string     `json:"name,omitempty" example:"Tech interview"` // NOTE: Empty once the stage is deleted
+	EnteredAt time.Time  `json:"enteredAt" example:"2025-03-14T12:34:56Z"`
+	LeftAt    *time.Time `json:"leftAt,omitempty" example:"2025-03-21T08:00:00Z"`
+	Duration  int64      `json:"duration" example:"588544"` // NOTE: Seconds spent in the stage so far
+}
+
+type JobApplicationTimelineResBody struct {
+	Events []jobApplicationEvent         `json:"events"`
+	Stages []jobApplicationTimelineStage `json:"stages"`
+}
+
+func NewJobApplicationTimelineResBody(events []db.GetJobApplicationEventsRow, now time.Time) JobApplicationTimelineResBody {
+	resBody := JobApplicationTimelineResBody{
+		Events: []jobApplicationEvent{},
+		Stages: []jobApplicationTimelineStage{},
 	}
+
+	for _, event := range events {
+		createdAt := event.CreatedAt.Time.UTC()
+
+		resBody.Events = append(resBody.Events, jobApplicationEvent{
+			ID:        event.ID.String(),
+			Field:     event.Field,
+			OldValue:  event.OldValue.String,
+			NewValue:  event.NewValue.String,
+			CreatedAt: createdAt,
+		})
+
+		if event.Field != "stage" {
+			continue
+		}
+
+		if n := len(resBody.Stages); n > 0 {
+			resBody.Stages[n-1].LeftAt = &createdAt
+			resBody.Stages[n-1].Duration = int64(createdAt.Sub(resBody.Stages[n-1].EnteredAt).Seconds())
+		}
+
+		resBody.Stages = append(resBody.Stages, jobApplicationTimelineStage{
+			StageID:   event.NewValue.String,
+			Name:      event.StageName.String,
+			EnteredAt: createdAt,
+			Duration:  int64(now.Sub(createdAt).Seconds()),
+		})
+	}
+
+	return resBody
 }
@@ -0,0 +1,142 @@
+package models
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+type BulkAction string
+
+const (
+	BulkUpdate BulkAction = "update"
+	BulkDelete BulkAction = "delete"
+)
+
+// NOTE: Either a list of ids or a filter, in the same format as the query of a saved view, e.g. "outcome=NEUTRAL&applied_within_days=30"
+type BulkJobApplicationsReqBody struct {
+	IDs          []string   `json:"ids,omitempty" binding:"required_without=Filter,excluded_with=Filter,dive,uuid" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	Filter       *string    `json:"filter,omitempty" example:"outcome=NEUTRAL&is_replied=false"`
+	Action       BulkAction `json:"action" binding:"required,oneof=update delete" example:"update"`
+	StageID      string     `json:"stageId,omitempty" binding:"omitempty,uuid" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	IsReplied    *bool      `json:"isReplied,omitempty" example:"true"`
+	AddTagIDs    []string   `json:"addTagIds,omitempty" binding:"omitempty,dive,uuid" example:"c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f"`
+	RemoveTagIDs []string   `json:"removeTagIds,omitempty" binding:"omitempty,dive,uuid" example:"d2e3f4a5-b6c7-4d8e-9f0a-1b2c3d4e5f6a"`
+}
+
+func NewBulkJobApplicationsReqBody(ids []string, filter *string, action BulkAction, stageId string, isReplied *bool, addTagIds, removeTagIds []string) BulkJobApplicationsReqBody {
+	return BulkJobApplicationsReqBody{
+		IDs:          ids,
+		Filter:       filter,
+		Action:       action,
+		StageID:      stageId,
+		IsReplied:    isReplied,
+		AddTagIDs:    addTagIds,
+		RemoveTagIDs: removeTagIds,
+	}
+}
+
+var (
+	ErrBulkWithoutIds           = errors.New("ids can't be empty")
+	ErrBulkUpdateWithoutChanges = errors.New("update requires at least one of stageId, isReplied, addTagIds or removeTagIds")
+	ErrBulkDeleteWithChanges    = errors.New("delete can't be combined with stageId, isReplied, addTagIds or removeTagIds")
+)
+
+// ValidateBulkJobApplicationsReqBody checks what the binding can't express, e.g. that the changes match the action
+func ValidateBulkJobApplicationsReqBody(body BulkJobApplicationsReqBody) error {
+	hasChanges := body.StageID != "" || body.IsReplied != nil || len(body.AddTagIDs) > 0 || len(body.RemoveTagIDs) > 0
+
+	switch {
+	case body.Filter == nil && len(body.IDs) == 0:
+		return ErrBulkWithoutIds
+	case body.Action == BulkUpdate && !hasChanges:
+		return ErrBulkUpdateWithoutChanges
+	case body.Action == BulkDelete && hasChanges:
+		return ErrBulkDeleteWithChanges
+	}
+
+	return nil
+}
+
+// NewBulkJobApplicationsFilterParams turns a filter into params listing at most limit matching job applications
+func NewBulkJobApplicationsFilterParams(userId pgtype.UUID, filter string, limit int, preferences db.GetUserPreferencesRow) (db.GetJobApplicationsParams, error) {
+	query, err := NewViewQuery(filter)
+	if err != nil {
+		return db.GetJobApplicationsParams{}, err
+	}
+
+	values, _ := url.ParseQuery(query) // NOTE: Already validated by NewViewQuery
+
+	var queryParams JobApplicationsQueryParams
+	if err := BindJobApplicationsQueryParams(values, &queryParams); err != nil {
+		return db.GetJobApplicationsParams{}, err
+	}
+
+	params, err := NewGetJobApplicationsParams(userId, queryParams, preferences)
+	if err != nil {
+		return db.GetJobApplicationsParams{}, err
+	}
+
+	params.Limit = int32(limit)
+	params.Offset = 0
+	params.WithTotal = false
+
+	return params, nil
+}
+
+// NewBulkJobApplicationIds lists the job applications to change in the order given, without duplicates
+func NewBulkJobApplicationIds(ids []string) []pgtype.UUID {
+	return toUUIDs(ids)
+}
+
+func NewBulkUpdateJobApplicationParams(jobApplicationId, userId pgtype.UUID, body BulkJobApplicationsReqBody) db.UpdateJobApplicationParams {
+	params := db.UpdateJobApplicationParams{
+		ID:     jobApplicationId,
+		UserID: userId,
+	}
+
+	if body.StageID != "" {
+		params.StageID, _ = utils.ToUUID(body.StageID) // NOTE: Already validated by the binding
+	}
+	if body.IsReplied != nil {
+		params.IsReplied = pgtype.Bool{Bool: *body.IsReplied, Valid: true}
+	}
+
+	return params
+}
+
+type BulkJobApplicationsItem struct {
+	ID    string `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	Error string `json:"error,omitempty" example:"job application not found"`
+}
+
+type BulkJobApplicationsResBody struct {
+	Action  BulkAction                `json:"action" example:"update"`
+	Total   int                       `json:"total" example:"40"`
+	Failed  int                       `json:"failed" example:"0"`
+	Applied int                       `json:"applied" example:"40"` // NOTE: Zero unless every item succeeded, since the whole batch is saved in one transaction
+	Items   []BulkJobApplicationsItem `json:"items"`
+}
+
+func NewBulkJobApplicationsResBody(action BulkAction, items []BulkJobApplicationsItem) BulkJobApplicationsResBody {
+	resBody := BulkJobApplicationsResBody{
+		Action: action,
+		Total:  len(items),
+		Items:  items,
+	}
+
+	for _, item := range items {
+		if item.Error != "" {
+			resBody.Failed++
+		}
+	}
+
+	if resBody.Failed == 0 {
+		resBody.Applied = resBody.Total
+	}
+
+	return resBody
+}
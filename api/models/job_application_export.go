@@ -0,0 +1,114 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatJSON ExportFormat = "json"
+	ExportFormatXLSX ExportFormat = "xlsx"
+)
+
+// NOTE: Pagination params, including the cursor, are accepted, but ignored, since every matching job application is exported
+type ExportJobApplicationsQueryParams struct {
+	JobApplicationsQueryParams
+	Format ExportFormat `form:"format" binding:"omitempty,oneof=csv json xlsx"`
+}
+
+// ExportJobApplicationsColumns match the fields the import endpoint maps by default, so an export can be imported back as is
+var ExportJobApplicationsColumns = []string{"id", "companyId", "companyName", "jobTitle", "dateApplied", "stage", "outcome", "isReplied", "minSalary", "maxSalary", "salaryCurrency", "salaryPeriod", "salaryType", "contractType", "jobPostingURL", "notes", "tags"}
+
+type ExportJobApplicationEntry struct {
+	ID             string          `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyID      string          `json:"companyId" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string          `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle       string          `json:"jobTitle" example:"Software Engineer"`
+	DateApplied    string          `json:"dateApplied" example:"2025-03-14"`
+	Stage          string          `json:"stage" example:"Tech interview"`
+	Outcome        db.StageOutcome `json:"outcome" example:"NEUTRAL"`
+	IsReplied      bool            `json:"isReplied" example:"false"`
+	MinSalary      float64         `json:"minSalary,omitempty" example:"50000.00"`
+	MaxSalary      float64         `json:"maxSalary,omitempty" example:"70000.00"`
+	SalaryCurrency string          `json:"salaryCurrency" example:"PLN"`
+	SalaryPeriod   db.SalaryPeriod `json:"salaryPeriod" example:"MONTH"`
+	SalaryType     db.SalaryType   `json:"salaryType" example:"GROSS"`
+	ContractType   db.ContractType `json:"contractType,omitempty" example:"B2B"`
+	JobPostingURL  string          `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          string          `json:"notes,omitempty" example:"Follow up in two weeks"`
+	Tags           []string        `json:"tags" example:"remote"`
+}
+
+func NewExportJobApplicationEntries(jobApplications []db.GetJobApplicationsRow, tags []db.GetJobApplicationTagsRow) []ExportJobApplicationEntry {
+	entries := []ExportJobApplicationEntry{}
+
+	tagsByJobApplication := map[pgtype.UUID][]string{}
+	for _, tag := range tags {
+		tagsByJobApplication[tag.JobApplicationID] = append(tagsByJobApplication[tag.JobApplicationID], tag.Name)
+	}
+
+	for _, jobApplication := range jobApplications {
+		tagNames := tagsByJobApplication[jobApplication.ID]
+		if tagNames == nil {
+			tagNames = []string{}
+		}
+
+		entries = append(entries, ExportJobApplicationEntry{
+			ID:             jobApplication.ID.String(),
+			CompanyID:      jobApplication.CompanyID.String(),
+			CompanyName:    jobApplication.CompanyName,
+			JobTitle:       jobApplication.JobTitle,
+			DateApplied:    fromDate(jobApplication.DateApplied),
+			Stage:          jobApplication.StageName,
+			Outcome:        jobApplication.StageOutcome,
+			IsReplied:      jobApplication.IsReplied,
+			MinSalary:      jobApplication.MinSalary.Float64,
+			MaxSalary:      jobApplication.MaxSalary.Float64,
+			SalaryCurrency: jobApplication.SalaryCurrency,
+			SalaryPeriod:   jobApplication.SalaryPeriod,
+			SalaryType:     jobApplication.SalaryType,
+			ContractType:   jobApplication.ContractType.ContractType,
+			JobPostingURL:  jobApplication.JobPostingUrl.String,
+			Notes:          jobApplication.Notes.String,
+			Tags:           tagNames,
+		})
+	}
+
+	return entries
+}
+
+// NewExportJobApplicationCells lays an entry out along ExportJobApplicationsColumns, leaving unset salaries empty
+func NewExportJobApplicationCells(entry ExportJobApplicationEntry) []any {
+	var minSalary, maxSalary any
+	if entry.MinSalary != 0 {
+		minSalary = entry.MinSalary
+	}
+	if entry.MaxSalary != 0 {
+		maxSalary = entry.MaxSalary
+	}
+
+	return []any{
+		entry.ID,
+		entry.CompanyID,
+		entry.CompanyName,
+		entry.JobTitle,
+		entry.DateApplied,
+		entry.Stage,
+		string(entry.Outcome),
+		entry.IsReplied,
+		minSalary,
+		maxSalary,
+		entry.SalaryCurrency,
+		string(entry.SalaryPeriod),
+		string(entry.SalaryType),
+		string(entry.ContractType),
+		entry.JobPostingURL,
+		entry.Notes,
+		strings.Join(entry.Tags, ", "),
+	}
+}
@@ -0,0 +1,160 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+type ImportJobApplicationsQueryParams struct {
+	DryRun bool `form:"dryRun"`
+}
+
+// ImportJobApplicationsFields lists the CreateJobApplicationReqBody fields a CSV column can be mapped onto
+var ImportJobApplicationsFields = []string{"companyId", "companyName", "jobTitle", "dateApplied", "stageId", "minSalary", "maxSalary", "salaryCurrency", "salaryPeriod", "salaryType", "contractType", "jobPostingURL", "notes"}
+
+// NewImportJobApplicationsMapping falls back to columns named exactly like the fields when no mapping is given
+func NewImportJobApplicationsMapping(header []string) map[string]string {
+	mapping := map[string]string{}
+
+	for _, column := range header {
+		for _, field := range ImportJobApplicationsFields {
+			if strings.EqualFold(strings.TrimSpace(column), field) {
+				mapping[field] = column
+			}
+		}
+	}
+
+	return mapping
+}
+
+// parseAmount reads a number written the way the locale of the user writes them, e.g. "7 500,50" for pl-PL or "7,500.50" for en-US.
+// Anything else is rejected rather than guessed at, e.g. "7500,50" for en-US, and so are values that aren't plain finite numbers, like "Inf".
+func parseAmount(value, locale string) (float64, error) {
+	decimal, group := ".", ","
+	if utils.DecimalSeparator(locale) == ',' {
+		decimal, group = ",", "."
+	}
+
+	invalid := fmt.Errorf("is not a number in the %v format, e.g. \"7%v500%v50\"", locale, group, decimal)
+
+	// NOTE: Spaces, including the non-breaking ones spreadsheets export, group digits in many locales
+	value = strings.NewReplacer(" ", group, "\u00a0", group, "\u202f", group).Replace(strings.TrimSpace(value))
+
+	isDigits := func(s string) bool {
+		return s != "" && strings.Trim(s, "0123456789") == ""
+	}
+
+	integer, fraction, hasFraction := strings.Cut(value, decimal)
+	if hasFraction && !isDigits(fraction) {
+		return 0, invalid
+	}
+
+	groups := strings.Split(integer, group)
+	for i, digits := range groups {
+		if !isDigits(digits) || (i > 0 && len(digits) != 3) || (len(groups) > 1 && len(digits) > 3) {
+			return 0, invalid
+		}
+	}
+
+	normalised := strings.Join(groups, "")
+	if hasFraction {
+		normalised += "." + fraction
+	}
+
+	amount, err := strconv.ParseFloat(normalised, 64)
+	if err != nil || math.IsInf(amount, 0) {
+		return 0, invalid
+	}
+
+	return amount, nil
+}
+
+// NewImportedJobApplicationReqBody converts a CSV record, keyed by field name, into a request body.
+// Dates are accepted either as RFC 3339 timestamps, taken as the day they fall on in the time zone of the user, or as plain YYYY-MM-DD days, the way spreadsheets export them.
+// Salaries are read in the number format of the locale of the user.
+func NewImportedJobApplicationReqBody(record map[string]string, preferences db.GetUserPreferencesRow) (CreateJobApplicationReqBody, []string) {
+	errs := []string{}
+
+	dateApplied := toLocalDate(record["dateApplied"], preferences)
+	if _, err := time.Parse(time.DateOnly, dateApplied); err != nil && dateApplied != "" {
+		errs = append(errs, fmt.Sprintf("dateApplied: %q is neither an RFC 3339 timestamp nor a YYYY-MM-DD date", dateApplied))
+	}
+
+	// NOTE: An empty cell is no salary at all, rather than a salary of 0
+	parseSalary := func(field string) *float64 {
+		value := record[field]
+		if strings.TrimSpace(value) == "" {
+			return nil
+		}
+		salary, err := parseAmount(value, preferences.Locale)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %q %v", field, value, err))
+		}
+		return &salary
+	}
+
+	minSalary := parseSalary("minSalary")
+	maxSalary := parseSalary("maxSalary")
+
+	// NOTE: Codes are matched case-insensitively, e.g. "pln" or "b2b", and validated along with the rest of the body
+	code := func(field string) string {
+		return strings.ToUpper(strings.TrimSpace(record[field]))
+	}
+
+	body := NewCreateJobApplicationReqBody(
+		record["companyId"],
+		record["companyName"],
+		record["jobTitle"],
+		dateApplied,
+		record["stageId"],
+		minSalary,
+		maxSalary,
+		code("salaryCurrency"),
+		db.SalaryPeriod(code("salaryPeriod")),
+		db.SalaryType(code("salaryType")),
+		db.ContractType(code("contractType")),
+		record["jobPostingURL"],
+		record["notes"],
+	)
+
+	return body, errs
+}
+
+type ImportJobApplicationsRow struct {
+	Row    int      `json:"row" example:"2"` // NOTE: Line number within the CSV file, the header being line 1
+	ID     string   `json:"id,omitempty" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	Errors []string `json:"errors,omitempty" example:"Key: 'CreateJobApplicationReqBody.JobTitle' Error:Field validation for 'JobTitle' failed on the 'required' tag"`
+}
+
+type ImportJobApplicationsResBody struct {
+	DryRun   bool                       `json:"dryRun" example:"false"`
+	Total    int                        `json:"total" example:"120"`
+	Valid    int                        `json:"valid" example:"118"`
+	Imported int                        `json:"imported" example:"0"`
+	Rows     []ImportJobApplicationsRow `json:"rows"`
+}
+
+func NewImportJobApplicationsResBody(dryRun bool, rows []ImportJobApplicationsRow) ImportJobApplicationsResBody {
+	resBody := ImportJobApplicationsResBody{
+		DryRun: dryRun,
+		Total:  len(rows),
+		Rows:   rows,
+	}
+
+	for _, row := range rows {
+		if len(row.Errors) == 0 {
+			resBody.Valid++
+		}
+		if row.ID != "" {
+			resBody.Imported++
+		}
+	}
+
+	return resBody
+}
@@ -2,7 +2,9 @@ package models
 
 import (
 	"errors"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
 )
 
@@ -63,3 +65,70 @@ func NewVerifyEmailReqBody(verificationToken string) VerifyEmailReqBody {
 		VerificationToken: verificationToken,
 	}
 }
+
+// newLocation falls back to UTC for a time zone that can't be loaded, e.g. when the time zone database is missing
+func newLocation(timezone string) *time.Location {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+type PreferencesResBody struct {
+	Timezone  string       `json:"timezone" example:"Europe/Warsaw"`
+	Locale    string       `json:"locale" example:"en-US"`
+	WeekStart db.WeekStart `json:"weekStart" example:"MONDAY"`
+	Currency  string       `json:"currency" example:"PLN"`
+}
+
+func NewPreferencesResBody(preferences db.GetUserPreferencesRow) PreferencesResBody {
+	return PreferencesResBody{
+		Timezone:  preferences.Timezone,
+		Locale:    preferences.Locale,
+		WeekStart: preferences.WeekStart,
+		Currency:  preferences.Currency,
+	}
+}
+
+type UpdatePreferencesReqBody struct {
+	Timezone  string       `json:"timezone" binding:"required,timezone" example:"Europe/Warsaw"`               // NOTE: IANA time zone every date is compared in, e.g. when filtering by the date applied
+	Locale    string       `json:"locale" binding:"required,bcp47_language_tag" example:"en-US"`               // NOTE: BCP 47 language tag dates in emails are formatted for
+	WeekStart db.WeekStart `json:"weekStart" binding:"required,oneof=MONDAY SATURDAY SUNDAY" example:"MONDAY"` // NOTE: First day of the week applications are counted per in the stats
+	Currency  string       `json:"currency" binding:"required,iso4217" example:"PLN"`                          // NOTE: ISO 4217 code salaries are entered and compared in unless given otherwise
+}
+
+func NewUpdatePreferencesReqBody(timezone, locale string, weekStart db.WeekStart, currency string) UpdatePreferencesReqBody {
+	return UpdatePreferencesReqBody{
+		Timezone:  timezone,
+		Locale:    locale,
+		WeekStart: weekStart,
+		Currency:  currency,
+	}
+}
+
+type UpdatePreferencesResBody struct {
+	Timezone  string       `json:"timezone" example:"Europe/Warsaw"`
+	Locale    string       `json:"locale" example:"en-US"`
+	WeekStart db.WeekStart `json:"weekStart" example:"MONDAY"`
+	Currency  string       `json:"currency" example:"PLN"`
+}
+
+func NewUpdatePreferencesResBody(preferences db.UpdateUserPreferencesRow) UpdatePreferencesResBody {
+	return UpdatePreferencesResBody{
+		Timezone:  preferences.Timezone,
+		Locale:    preferences.Locale,
+		WeekStart: preferences.WeekStart,
+		Currency:  preferences.Currency,
+	}
+}
+
+func NewUpdateUserPreferencesParams(userId pgtype.UUID, body UpdatePreferencesReqBody) db.UpdateUserPreferencesParams {
+	return db.UpdateUserPreferencesParams{
+		ID:        userId,
+		Timezone:  body.Timezone,
+		Locale:    body.Locale,
+		WeekStart: body.WeekStart,
+		Currency:  body.Currency,
+	}
+}
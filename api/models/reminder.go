@@ -0,0 +1,204 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+type RemindersQueryParams struct {
+	Status           db.ReminderStatus `form:"status" binding:"omitempty,oneof=PENDING DELIVERED DISMISSED"`
+	JobApplicationID string            `form:"job_application_id" binding:"omitempty,uuid"`
+}
+
+func NewGetRemindersParams(userId pgtype.UUID, queryParams RemindersQueryParams) db.GetRemindersParams {
+	var jobApplicationId pgtype.UUID
+	if queryParams.JobApplicationID != "" {
+		jobApplicationId, _ = utils.ToUUID(queryParams.JobApplicationID) // NOTE: Already validated by the binding
+	}
+
+	return db.GetRemindersParams{
+		UserID:           userId,
+		Status:           db.NullReminderStatus{ReminderStatus: queryParams.Status, Valid: queryParams.Status != ""},
+		JobApplicationID: jobApplicationId,
+	}
+}
+
+// nullifyTimestamp leaves timestamps that were never set out of the response
+func nullifyTimestamp(timestamp pgtype.Timestamptz) *time.Time {
+	if !timestamp.Valid {
+		return nil
+	}
+	return &timestamp.Time
+}
+
+type reminderEntry struct {
+	ID               string            `json:"id" example:"7b6a5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d"`
+	JobApplicationID string            `json:"jobApplicationId" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyName      string            `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle         string            `json:"jobTitle" example:"Software Engineer"`
+	RemindAt         time.Time         `json:"remindAt" example:"2025-03-21T09:00:00Z"`
+	Note             string            `json:"note,omitempty" example:"Ask the recruiter about the next steps"`
+	UnlessReplied    bool              `json:"unlessReplied" example:"true"`
+	IsSuggested      bool              `json:"isSuggested" example:"false"` // NOTE: Suggested automatically for applications left without a reply
+	Status           db.ReminderStatus `json:"status" example:"PENDING"`
+	DeliveredAt      *time.Time        `json:"deliveredAt" example:"2025-03-21T09:00:12Z"`
+}
+
+type RemindersResBody struct {
+	Data []reminderEntry `json:"data"`
+}
+
+func NewRemindersResBody(reminders []db.GetRemindersRow) RemindersResBody {
+	data := []reminderEntry{}
+
+	for _, reminder := range reminders {
+		data = append(data, reminderEntry{
+			ID:               reminder.ID.String(),
+			JobApplicationID: reminder.JobApplicationID.String(),
+			CompanyName:      reminder.CompanyName,
+			JobTitle:         reminder.JobTitle,
+			RemindAt:         reminder.RemindAt.Time,
+			Note:             reminder.Note.String,
+			UnlessReplied:    reminder.UnlessReplied,
+			IsSuggested:      reminder.IsSuggested,
+			Status:           reminder.Status,
+			DeliveredAt:      nullifyTimestamp(reminder.DeliveredAt),
+		})
+	}
+
+	return RemindersResBody{
+		Data: data,
+	}
+}
+
+// NOTE: Either an absolute time or a number of days from now
+type CreateReminderReqBody struct {
+	RemindAt      *time.Time `json:"remindAt,omitempty" binding:"required_without=AfterDays" example:"2025-03-21T09:00:00Z"`
+	AfterDays     int        `json:"afterDays,omitempty" binding:"omitempty,min=1,excluded_with=RemindAt" example:"7"`
+	Note          string     `json:"note,omitempty" example:"Ask the recruiter about the next steps"`
+	UnlessReplied bool       `json:"unlessReplied,omitempty" example:"true"` // NOTE: Skips the reminder if the application gets a reply before it's due
+}
+
+func NewCreateReminderReqBody(remindAt *time.Time, afterDays int, note string, unlessReplied bool) CreateReminderReqBody {
+	return CreateReminderReqBody{
+		RemindAt:      remindAt,
+		AfterDays:     afterDays,
+		Note:          note,
+		UnlessReplied: unlessReplied,
+	}
+}
+
+type CreateReminderResBody struct {
+	ID               string            `json:"id" example:"7b6a5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d"`
+	JobApplicationID string            `json:"jobApplicationId" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	RemindAt         time.Time         `json:"remindAt" example:"2025-03-21T09:00:00Z"`
+	Note             string            `json:"note,omitempty" example:"Ask the recruiter about the next steps"`
+	UnlessReplied    bool              `json:"unlessReplied" example:"true"`
+	IsSuggested      bool              `json:"isSuggested" example:"false"`
+	Status           db.ReminderStatus `json:"status" example:"PENDING"`
+	DeliveredAt      *time.Time        `json:"deliveredAt" example:"2025-03-21T09:00:12Z"`
+}
+
+func NewCreateReminderResBody(reminder db.CreateReminderRow) CreateReminderResBody {
+	return CreateReminderResBody{
+		ID:               reminder.ID.String(),
+		JobApplicationID: reminder.JobApplicationID.String(),
+		RemindAt:         reminder.RemindAt.Time,
+		Note:             reminder.Note.String,
+		UnlessReplied:    reminder.UnlessReplied,
+		IsSuggested:      reminder.IsSuggested,
+		Status:           reminder.Status,
+		DeliveredAt:      nullifyTimestamp(reminder.DeliveredAt),
+	}
+}
+
+// newRemindAt resolves a time given either explicitly or as a number of days from now.
+// Days are added in the time zone of the user, so that a reminder keeps its time of day across a daylight saving time change.
+func newRemindAt(remindAt *time.Time, afterDays int, preferences db.GetUserPreferencesRow) pgtype.Timestamptz {
+	if remindAt != nil {
+		return pgtype.Timestamptz{Time: *remindAt, Valid: true}
+	}
+	return pgtype.Timestamptz{Time: time.Now().In(newLocation(preferences.Timezone)).AddDate(0, 0, afterDays), Valid: true}
+}
+
+func NewCreateReminderParams(jobApplicationId, userId pgtype.UUID, body CreateReminderReqBody, preferences db.GetUserPreferencesRow) db.CreateReminderParams {
+	return db.CreateReminderParams{
+		JobApplicationID: jobApplicationId,
+		UserID:           userId,
+		RemindAt:         newRemindAt(body.RemindAt, body.AfterDays, preferences),
+		Note:             pgtype.Text{String: body.Note, Valid: body.Note != ""},
+		UnlessReplied:    body.UnlessReplied,
+	}
+}
+
+// NOTE: Either an absolute time or a number of days from now
+type SnoozeReminderReqBody struct {
+	Until *time.Time `json:"until,omitempty" binding:"required_without=Days" example:"2025-03-28T09:00:00Z"`
+	Days  int        `json:"days,omitempty" binding:"omitempty,min=1,excluded_with=Until" example:"3"`
+}
+
+func NewSnoozeReminderReqBody(until *time.Time, days int) SnoozeReminderReqBody {
+	return SnoozeReminderReqBody{
+		Until: until,
+		Days:  days,
+	}
+}
+
+type SnoozeReminderResBody struct {
+	ID               string            `json:"id" example:"7b6a5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d"`
+	JobApplicationID string            `json:"jobApplicationId" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	RemindAt         time.Time         `json:"remindAt" example:"2025-03-28T09:00:00Z"`
+	Note             string            `json:"note,omitempty" example:"Ask the recruiter about the next steps"`
+	UnlessReplied    bool              `json:"unlessReplied" example:"true"`
+	IsSuggested      bool              `json:"isSuggested" example:"false"`
+	Status           db.ReminderStatus `json:"status" example:"PENDING"`
+	DeliveredAt      *time.Time        `json:"deliveredAt" example:"2025-03-21T09:00:12Z"`
+}
+
+func NewSnoozeReminderResBody(reminder db.SnoozeReminderRow) SnoozeReminderResBody {
+	return SnoozeReminderResBody{
+		ID:               reminder.ID.String(),
+		JobApplicationID: reminder.JobApplicationID.String(),
+		RemindAt:         reminder.RemindAt.Time,
+		Note:             reminder.Note.String,
+		UnlessReplied:    reminder.UnlessReplied,
+		IsSuggested:      reminder.IsSuggested,
+		Status:           reminder.Status,
+		DeliveredAt:      nullifyTimestamp(reminder.DeliveredAt),
+	}
+}
+
+func NewSnoozeReminderParams(reminderId, userId pgtype.UUID, body SnoozeReminderReqBody, preferences db.GetUserPreferencesRow) db.SnoozeReminderParams {
+	return db.SnoozeReminderParams{
+		ID:       reminderId,
+		UserID:   userId,
+		RemindAt: newRemindAt(body.Until, body.Days, preferences),
+	}
+}
+
+type DismissReminderResBody struct {
+	ID               string            `json:"id" example:"7b6a5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d"`
+	JobApplicationID string            `json:"jobApplicationId" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	RemindAt         time.Time         `json:"remindAt" example:"2025-03-21T09:00:00Z"`
+	Note             string            `json:"note,omitempty" example:"Ask the recruiter about the next steps"`
+	UnlessReplied    bool              `json:"unlessReplied" example:"true"`
+	IsSuggested      bool              `json:"isSuggested" example:"false"`
+	Status           db.ReminderStatus `json:"status" example:"DISMISSED"`
+	DeliveredAt      *time.Time        `json:"deliveredAt" example:"2025-03-21T09:00:12Z"`
+}
+
+func NewDismissReminderResBody(reminder db.DismissReminderRow) DismissReminderResBody {
+	return DismissReminderResBody{
+		ID:               reminder.ID.String(),
+		JobApplicationID: reminder.JobApplicationID.String(),
+		RemindAt:         reminder.RemindAt.Time,
+		Note:             reminder.Note.String,
+		UnlessReplied:    reminder.UnlessReplied,
+		IsSuggested:      reminder.IsSuggested,
+		Status:           reminder.Status,
+		DeliveredAt:      nullifyTimestamp(reminder.DeliveredAt),
+	}
+}
@@ -0,0 +1,194 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+type stageEntry struct {
+	ID         string          `json:"id" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	Name       string          `json:"name" example:"Tech interview"`
+	Position   int32           `json:"position" example:"2"`
+	Color      string          `json:"color" example:"#0284c7"`
+	IsTerminal bool            `json:"isTerminal" example:"false"`
+	Outcome    db.StageOutcome `json:"outcome" example:"NEUTRAL"`
+}
+
+type StagesResBody struct {
+	Data []stageEntry `json:"data"`
+}
+
+func NewStagesResBody(stages []db.GetStagesRow) StagesResBody {
+	data := []stageEntry{}
+
+	for _, stage := range stages {
+		data = append(data, stageEntry{
+			ID:         stage.ID.String(),
+			Name:       stage.Name,
+			Position:   stage.Position,
+			Color:      stage.Color,
+			IsTerminal: stage.IsTerminal,
+			Outcome:    stage.Outcome,
+		})
+	}
+
+	return StagesResBody{
+		Data: data,
+	}
+}
+
+type StageResBody struct {
+	ID         string          `json:"id" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	Name       string          `json:"name" example:"Tech interview"`
+	Position   int32           `json:"position" example:"2"`
+	Color      string          `json:"color" example:"#0284c7"`
+	IsTerminal bool            `json:"isTerminal" example:"false"`
+	Outcome    db.StageOutcome `json:"outcome" example:"NEUTRAL"`
+}
+
+func NewStageResBody(stage db.GetStageRow) StageResBody {
+	return StageResBody{
+		ID:         stage.ID.String(),
+		Name:       stage.Name,
+		Position:   stage.Position,
+		Color:      stage.Color,
+		IsTerminal: stage.IsTerminal,
+		Outcome:    stage.Outcome,
+	}
+}
+
+type CreateStageReqBody struct {
+	Name       string          `json:"name" binding:"required" example:"Tech interview"`
+	Position   *int32          `json:"position,omitempty" binding:"omitempty,gte=0" example:"2"` // NOTE: Appended after the last stage when omitted
+	Color      string          `json:"color,omitempty" binding:"omitempty,hexcolor" example:"#0284c7"`
+	IsTerminal bool            `json:"isTerminal" example:"false"`
+	Outcome    db.StageOutcome `json:"outcome,omitempty" binding:"omitempty,oneof=NEUTRAL POSITIVE NEGATIVE" example:"NEUTRAL"`
+}
+
+func NewCreateStageReqBody(name string, position *int32, color string, isTerminal bool, outcome db.StageOutcome) CreateStageReqBody {
+	return CreateStageReqBody{
+		Name:       name,
+		Position:   position,
+		Color:      color,
+		IsTerminal: isTerminal,
+		Outcome:    outcome,
+	}
+}
+
+type CreateStageResBody struct {
+	ID         string          `json:"id" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	Name       string          `json:"name" example:"Tech interview"`
+	Position   int32           `json:"position" example:"2"`
+	Color      string          `json:"color" example:"#0284c7"`
+	IsTerminal bool            `json:"isTerminal" example:"false"`
+	Outcome    db.StageOutcome `json:"outcome" example:"NEUTRAL"`
+}
+
+func NewCreateStageResBody(stage db.CreateStageRow) CreateStageResBody {
+	return CreateStageResBody{
+		ID:         stage.ID.String(),
+		Name:       stage.Name,
+		Position:   stage.Position,
+		Color:      stage.Color,
+		IsTerminal: stage.IsTerminal,
+		Outcome:    stage.Outcome,
+	}
+}
+
+func NewCreateStageParams(userId pgtype.UUID, body CreateStageReqBody) db.CreateStageParams {
+	params := db.CreateStageParams{
+		UserID:     userId,
+		Name:       body.Name,
+		Color:      pgtype.Text{String: body.Color, Valid: true},
+		IsTerminal: body.IsTerminal,
+		Outcome:    body.Outcome,
+	}
+
+	if body.Position != nil {
+		params.Position = pgtype.Int4{Int32: *body.Position, Valid: true}
+	}
+	if body.Outcome == "" {
+		params.Outcome = db.StageOutcomeNEUTRAL
+	}
+
+	return params
+}
+
+type UpdateStageReqBody struct {
+	Name       string           `json:"name,omitempty" example:"Tech interview"`
+	Position   *int32           `json:"position,omitempty" binding:"omitempty,gte=0" example:"2"`
+	Color      string           `json:"color,omitempty" binding:"omitempty,hexcolor" example:"#0284c7"`
+	IsTerminal *bool            `json:"isTerminal,omitempty" example:"false"`
+	Outcome    *db.StageOutcome `json:"outcome,omitempty" binding:"omitempty,oneof=NEUTRAL POSITIVE NEGATIVE" example:"NEUTRAL"`
+}
+
+func NewUpdateStageReqBody(name string, position *int32, color string, isTerminal *bool, outcome *db.StageOutcome) UpdateStageReqBody {
+	return UpdateStageReqBody{
+		Name:       name,
+		Position:   position,
+		Color:      color,
+		IsTerminal: isTerminal,
+		Outcome:    outcome,
+	}
+}
+
+type UpdateStageResBody struct {
+	ID         string          `json:"id" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	Name       string          `json:"name" example:"Tech interview"`
+	Position   int32           `json:"position" example:"2"`
+	Color      string          `json:"color" example:"#0284c7"`
+	IsTerminal bool            `json:"isTerminal" example:"false"`
+	Outcome    db.StageOutcome `json:"outcome" example:"NEUTRAL"`
+}
+
+func NewUpdateStageResBody(stage db.UpdateStageRow) UpdateStageResBody {
+	return UpdateStageResBody{
+		ID:         stage.ID.String(),
+		Name:       stage.Name,
+		Position:   stage.Position,
+		Color:      stage.Color,
+		IsTerminal: stage.IsTerminal,
+		Outcome:    stage.Outcome,
+	}
+}
+
+func NewUpdateStageParams(stageId, userId pgtype.UUID, body UpdateStageReqBody) db.UpdateStageParams {
+	params := db.UpdateStageParams{
+		ID:     stageId,
+		UserID: userId,
+		Name:   pgtype.Text{String: body.Name, Valid: true},
+		Color:  pgtype.Text{String: body.Color, Valid: true},
+	}
+
+	if body.Position != nil {
+		params.Position = pgtype.Int4{Int32: *body.Position, Valid: true}
+	}
+	if body.IsTerminal != nil {
+		params.IsTerminal = pgtype.Bool{Bool: *body.IsTerminal, Valid: true}
+	}
+	if body.Outcome != nil {
+		params.Outcome = db.NullStageOutcome{StageOutcome: *body.Outcome, Valid: true}
+	}
+
+	return params
+}
+
+type DeleteStageResBody struct {
+	ID         string          `json:"id" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	Name       string          `json:"name" example:"Tech interview"`
+	Position   int32           `json:"position" example:"2"`
+	Color      string          `json:"color" example:"#0284c7"`
+	IsTerminal bool            `json:"isTerminal" example:"false"`
+	Outcome    db.StageOutcome `json:"outcome" example:"NEUTRAL"`
+}
+
+func NewDeleteStageResBody(stage db.DeleteStageRow) DeleteStageResBody {
+	return DeleteStageResBody{
+		ID:         stage.ID.String(),
+		Name:       stage.Name,
+		Position:   stage.Position,
+		Color:      stage.Color,
+		IsTerminal: stage.IsTerminal,
+		Outcome:    stage.Outcome,
+	}
+}
@@ -0,0 +1,234 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+type StatsGroupBy string
+
+const (
+	StatsGroupByCompany  StatsGroupBy = "company"
+	StatsGroupByJobTitle StatsGroupBy = "job_title"
+)
+
+type StatsQueryParams struct {
+	From           string          `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To             string          `form:"to" binding:"omitempty,datetime=2006-01-02"`
+	GroupBy        StatsGroupBy    `form:"group_by" binding:"omitempty,oneof=company job_title"`
+	SalaryCurrency string          `form:"salary_currency" binding:"omitempty,iso4217"`
+	SalaryPeriod   db.SalaryPeriod `form:"salary_period" binding:"omitempty,oneof=HOUR MONTH YEAR"`
+	SalaryType     db.SalaryType   `form:"salary_type" binding:"omitempty,oneof=GROSS NET"`
+}
+
+func NewGetJobApplicationStatsParams(userId pgtype.UUID, queryParams StatsQueryParams, preferences db.GetUserPreferencesRow) db.GetJobApplicationStatsParams {
+	var from, to pgtype.Date
+	if queryParams.From != "" {
+		date, _ := time.Parse(time.DateOnly, queryParams.From) // NOTE: Already validated by the binding
+		from = pgtype.Date{Time: date, Valid: true}
+	}
+	if queryParams.To != "" {
+		date, _ := time.Parse(time.DateOnly, queryParams.To) // NOTE: Already validated by the binding
+		to = pgtype.Date{Time: date, Valid: true}
+	}
+
+	salaryCurrency, salaryPeriod := newSalaryUnit(queryParams.SalaryCurrency, queryParams.SalaryPeriod, preferences)
+
+	return db.GetJobApplicationStatsParams{
+		Timezone:       preferences.Timezone,
+		GroupBy:        string(queryParams.GroupBy),
+		SalaryCurrency: salaryCurrency,
+		SalaryPeriod:   salaryPeriod,
+		SalaryType:     db.NullSalaryType{SalaryType: queryParams.SalaryType, Valid: queryParams.SalaryType != ""},
+		UserID:         userId,
+		From:           from,
+		To:             to,
+	}
+}
+
+// NOTE: The stage and weekly stats queries take the same params, except for the salary unit and time zone
+func NewGetJobApplicationStageStatsParams(params db.GetJobApplicationStatsParams) db.GetJobApplicationStageStatsParams {
+	return db.GetJobApplicationStageStatsParams{
+		GroupBy: params.GroupBy,
+		UserID:  params.UserID,
+		From:    params.From,
+		To:      params.To,
+	}
+}
+
+func NewGetJobApplicationWeeklyStatsParams(params db.GetJobApplicationStatsParams, preferences db.GetUserPreferencesRow) db.GetJobApplicationWeeklyStatsParams {
+	return db.GetJobApplicationWeeklyStatsParams{
+		GroupBy:   params.GroupBy,
+		WeekStart: preferences.WeekStart,
+		UserID:    params.UserID,
+		From:      params.From,
+		To:        params.To,
+	}
+}
+
+type statsStage struct {
+	ID      string          `json:"id" example:"8a0c5a52-3f5e-4b8e-9a57-2f1f4c1d2e3b"`
+	Name    string          `json:"name" example:"Tech interview"`
+	Color   string          `json:"color" example:"#0284c7"`
+	Outcome db.StageOutcome `json:"outcome" example:"NEUTRAL"`
+	Current int             `json:"current" example:"4"`  // NOTE: Applications in this stage right now
+	Reached int             `json:"reached" example:"10"` // NOTE: Applications that have been in this stage at any point
+}
+
+type statsWeek struct {
+	Week  string `json:"week" example:"2025-03-10"` // NOTE: First day of the week, according to the week start the user prefers
+	Count int    `json:"count" example:"5"`
+}
+
+// NOTE: Converted to the requested currency and period, gross and net amounts alike. Salaries in a currency without an exchange rate are left out.
+type statsSalary struct {
+	Currency string          `json:"currency" example:"PLN"`
+	Period   db.SalaryPeriod `json:"period" example:"MONTH"`
+	Count    int             `json:"count" example:"8"`
+	Min      *float64        `json:"min" example:"40000.00"`
+	P25      *float64        `json:"p25" example:"50000.00"`
+	Median   *float64        `json:"median" example:"60000.00"`
+	P75      *float64        `json:"p75" example:"75000.00"`
+	Max      *float64        `json:"max" example:"120000.00"`
+}
+
+type statsEntry struct {
+	Total                int          `json:"total" example:"20"`
+	Replied              int          `json:"replied" example:"8"`
+	Accepted             int          `json:"accepted" example:"1"`
+	Rejected             int          `json:"rejected" example:"5"`
+	ReplyRate            float64      `json:"replyRate" example:"0.4"`
+	AcceptanceRate       float64      `json:"acceptanceRate" example:"0.05"`
+	MedianDaysToResponse *float64     `json:"medianDaysToResponse" example:"6.5"` // NOTE: Days from applying to the first reply or decision, null without any
+	Stages               []statsStage `json:"stages"`
+	Weeks                []statsWeek  `json:"weeks"` // NOTE: Weeks without applications are left out
+	Salary               statsSalary  `json:"salary"`
+}
+
+type statsGroup struct {
+	Key  string `json:"key" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"` // NOTE: Company uuid or lowercased job title
+	Name string `json:"name" example:"Evil Corp Inc."`
+	statsEntry
+}
+
+type StatsResBody struct {
+	From    string       `json:"from,omitempty" example:"2025-01-01"`
+	To      string       `json:"to,omitempty" example:"2025-03-31"`
+	GroupBy StatsGroupBy `json:"groupBy,omitempty" example:"company"`
+	statsEntry
+	Groups []statsGroup `json:"groups"` // NOTE: Empty unless grouped, sorted by the number of applications
+}
+
+func nullifyZero(value float64, count int64) *float64 {
+	if count == 0 {
+		return nil
+	}
+	return &value
+}
+
+func newStatsEntry(params db.GetJobApplicationStatsParams, stats db.GetJobApplicationStatsRow) statsEntry {
+	return statsEntry{
+		Total:                int(stats.Total),
+		Replied:              int(stats.Replied),
+		Accepted:             int(stats.Accepted),
+		Rejected:             int(stats.Rejected),
+		ReplyRate:            stats.ReplyRate,
+		AcceptanceRate:       stats.AcceptanceRate,
+		MedianDaysToResponse: nullifyZero(stats.MedianDaysToResponse, stats.Responded),
+		Stages:               []statsStage{},
+		Weeks:                []statsWeek{},
+		Salary: statsSalary{
+			Currency: params.SalaryCurrency,
+			Period:   params.SalaryPeriod,
+			Count:    int(stats.SalaryCount),
+			Min:      nullifyZero(stats.MinSalary, stats.SalaryCount),
+			P25:      nullifyZero(stats.SalaryP25, stats.SalaryCount),
+			Median:   nullifyZero(stats.MedianSalary, stats.SalaryCount),
+			P75:      nullifyZero(stats.SalaryP75, stats.SalaryCount),
+			Max:      nullifyZero(stats.MaxSalary, stats.SalaryCount),
+		},
+	}
+}
+
+// NOTE: Rows marked as overall describe every application in range, the others a single group
+func NewStatsResBody(queryParams StatsQueryParams, params db.GetJobApplicationStatsParams, stages []db.GetStagesRow, stats []db.GetJobApplicationStatsRow, stageStats []db.GetJobApplicationStageStatsRow, weeklyStats []db.GetJobApplicationWeeklyStatsRow) StatsResBody {
+	resBody := StatsResBody{
+		From:    queryParams.From,
+		To:      queryParams.To,
+		GroupBy: queryParams.GroupBy,
+		Groups:  []statsGroup{},
+	}
+
+	groupIndexes := map[string]int{}
+
+	for _, row := range stats {
+		if row.IsOverall {
+			resBody.statsEntry = newStatsEntry(params, row)
+			continue
+		}
+		groupIndexes[row.GroupKey] = len(resBody.Groups)
+		resBody.Groups = append(resBody.Groups, statsGroup{
+			Key:        row.GroupKey,
+			Name:       row.GroupName,
+			statsEntry: newStatsEntry(params, row),
+		})
+	}
+
+	entryOf := func(isOverall bool, groupKey string) *statsEntry {
+		if isOverall {
+			return &resBody.statsEntry
+		}
+		if i, ok := groupIndexes[groupKey]; ok {
+			return &resBody.Groups[i].statsEntry
+		}
+		return nil
+	}
+
+	// NOTE: Every stage is listed in pipeline order, even with no applications in it, so that funnels line up across groups
+	type stageCounts struct{ current, reached int }
+	stageCountsByEntry := map[*statsEntry]map[pgtype.UUID]stageCounts{}
+	for _, row := range stageStats {
+		entry := entryOf(row.IsOverall, row.GroupKey)
+		if entry == nil {
+			continue
+		}
+		if stageCountsByEntry[entry] == nil {
+			stageCountsByEntry[entry] = map[pgtype.UUID]stageCounts{}
+		}
+		stageCountsByEntry[entry][row.StageID] = stageCounts{current: int(row.Current), reached: int(row.Reached)}
+	}
+
+	entries := []*statsEntry{&resBody.statsEntry}
+	for i := range resBody.Groups {
+		entries = append(entries, &resBody.Groups[i].statsEntry)
+	}
+
+	for _, entry := range entries {
+		for _, stage := range stages {
+			counts := stageCountsByEntry[entry][stage.ID]
+			entry.Stages = append(entry.Stages, statsStage{
+				ID:      stage.ID.String(),
+				Name:    stage.Name,
+				Color:   stage.Color,
+				Outcome: stage.Outcome,
+				Current: counts.current,
+				Reached: counts.reached,
+			})
+		}
+	}
+
+	for _, row := range weeklyStats {
+		entry := entryOf(row.IsOverall, row.GroupKey)
+		if entry == nil {
+			continue
+		}
+		entry.Weeks = append(entry.Weeks, statsWeek{
+			Week:  row.Week.Time.Format(time.DateOnly),
+			Count: int(row.Count),
+		})
+	}
+
+	return resBody
+}
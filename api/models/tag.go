@@ -0,0 +1,125 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+type tagEntry struct {
+	ID    string `json:"id" example:"9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b"`
+	Name  string `json:"name" example:"remote"`
+	Color string `json:"color" example:"#7c3aed"`
+}
+
+type TagsResBody struct {
+	Data []tagEntry `json:"data"`
+}
+
+func NewTagsResBody(tags []db.GetTagsRow) TagsResBody {
+	data := []tagEntry{}
+
+	for _, tag := range tags {
+		data = append(data, tagEntry{
+			ID:    tag.ID.String(),
+			Name:  tag.Name,
+			Color: tag.Color,
+		})
+	}
+
+	return TagsResBody{
+		Data: data,
+	}
+}
+
+type CreateTagReqBody struct {
+	Name  string `json:"name" binding:"required" example:"remote"`
+	Color string `json:"color,omitempty" binding:"omitempty,hexcolor" example:"#7c3aed"`
+}
+
+func NewCreateTagReqBody(name, color string) CreateTagReqBody {
+	return CreateTagReqBody{
+		Name:  name,
+		Color: color,
+	}
+}
+
+type CreateTagResBody struct {
+	ID    string `json:"id" example:"9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b"`
+	Name  string `json:"name" example:"remote"`
+	Color string `json:"color" example:"#7c3aed"`
+}
+
+func NewCreateTagResBody(tag db.CreateTagRow) CreateTagResBody {
+	return CreateTagResBody{
+		ID:    tag.ID.String(),
+		Name:  tag.Name,
+		Color: tag.Color,
+	}
+}
+
+func NewCreateTagParams(userId pgtype.UUID, body CreateTagReqBody) db.CreateTagParams {
+	return db.CreateTagParams{
+		UserID: userId,
+		Name:   body.Name,
+		Color:  pgtype.Text{String: body.Color, Valid: true},
+	}
+}
+
+type UpdateTagReqBody struct {
+	Name  string `json:"name,omitempty" example:"remote"`
+	Color string `json:"color,omitempty" binding:"omitempty,hexcolor" example:"#7c3aed"`
+}
+
+func NewUpdateTagReqBody(name, color string) UpdateTagReqBody {
+	return UpdateTagReqBody{
+		Name:  name,
+		Color: color,
+	}
+}
+
+type UpdateTagResBody struct {
+	ID    string `json:"id" example:"9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b"`
+	Name  string `json:"name" example:"remote"`
+	Color string `json:"color" example:"#7c3aed"`
+}
+
+func NewUpdateTagResBody(tag db.UpdateTagRow) UpdateTagResBody {
+	return UpdateTagResBody{
+		ID:    tag.ID.String(),
+		Name:  tag.Name,
+		Color: tag.Color,
+	}
+}
+
+func NewUpdateTagParams(tagId, userId pgtype.UUID, body UpdateTagReqBody) db.UpdateTagParams {
+	return db.UpdateTagParams{
+		ID:     tagId,
+		UserID: userId,
+		Name:   pgtype.Text{String: body.Name, Valid: true},
+		Color:  pgtype.Text{String: body.Color, Valid: true},
+	}
+}
+
+type DeleteTagResBody struct {
+	ID    string `json:"id" example:"9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b"`
+	Name  string `json:"name" example:"remote"`
+	Color string `json:"color" example:"#7c3aed"`
+}
+
+func NewDeleteTagResBody(tag db.DeleteTagRow) DeleteTagResBody {
+	return DeleteTagResBody{
+		ID:    tag.ID.String(),
+		Name:  tag.Name,
+		Color: tag.Color,
+	}
+}
+
+type JobApplicationTagsResBody struct {
+	Data []jobApplicationTag `json:"data"`
+}
+
+func NewJobApplicationTagsResBody(tags []db.GetJobApplicationTagsRow) JobApplicationTagsResBody {
+	return JobApplicationTagsResBody{
+		Data: newJobApplicationTags(tags),
+	}
+}
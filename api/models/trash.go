@@ -0,0 +1,117 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/trash"
+)
+
+// newPurgeAt tells when a trashed job application is going to be permanently deleted
+func newPurgeAt(deletedAt time.Time) time.Time {
+	return deletedAt.UTC().AddDate(0, 0, trash.RetentionDays)
+}
+
+type trashEntry struct {
+	ID             string              `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyID      string              `json:"companyId" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string              `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle       string              `json:"jobTitle" example:"Software Engineer"`
+	DateApplied    string              `json:"dateApplied" example:"2025-03-14"`
+	Stage          jobApplicationStage `json:"stage"`
+	IsReplied      bool                `json:"isReplied" example:"false"`
+	MinSalary      float64             `json:"minSalary,omitempty" example:"50000.00"`
+	MaxSalary      float64             `json:"maxSalary,omitempty" example:"70000.00"`
+	SalaryCurrency string              `json:"salaryCurrency" example:"PLN"`
+	SalaryPeriod   db.SalaryPeriod     `json:"salaryPeriod" example:"MONTH"`
+	SalaryType     db.SalaryType       `json:"salaryType" example:"GROSS"`
+	ContractType   db.ContractType     `json:"contractType,omitempty" example:"B2B"`
+	JobPostingURL  string              `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          string              `json:"notes,omitempty" example:"Follow up in two weeks"`
+	DeletedAt      time.Time           `json:"deletedAt" example:"2025-03-21T08:00:00Z"`
+	PurgeAt        time.Time           `json:"purgeAt" example:"2025-04-20T08:00:00Z"`
+}
+
+type TrashResBody struct {
+	Data []trashEntry `json:"data"`
+}
+
+func NewTrashResBody(jobApplications []db.GetTrashedJobApplicationsRow) TrashResBody {
+	data := []trashEntry{}
+
+	for _, jobApplication := range jobApplications {
+		data = append(data, trashEntry{
+			ID:          jobApplication.ID.String(),
+			CompanyID:   jobApplication.CompanyID.String(),
+			CompanyName: jobApplication.CompanyName,
+			JobTitle:    jobApplication.JobTitle,
+			DateApplied: fromDate(jobApplication.DateApplied),
+			Stage: jobApplicationStage{
+				ID:         jobApplication.StageID.String(),
+				Name:       jobApplication.StageName,
+				Color:      jobApplication.StageColor,
+				IsTerminal: jobApplication.StageIsTerminal,
+				Outcome:    jobApplication.StageOutcome,
+			},
+			IsReplied:      jobApplication.IsReplied,
+			MinSalary:      jobApplication.MinSalary.Float64,
+			MaxSalary:      jobApplication.MaxSalary.Float64,
+			SalaryCurrency: jobApplication.SalaryCurrency,
+			SalaryPeriod:   jobApplication.SalaryPeriod,
+			SalaryType:     jobApplication.SalaryType,
+			ContractType:   jobApplication.ContractType.ContractType,
+			JobPostingURL:  jobApplication.JobPostingUrl.String,
+			Notes:          jobApplication.Notes.String,
+			DeletedAt:      jobApplication.DeletedAt.Time.UTC(),
+			PurgeAt:        newPurgeAt(jobApplication.DeletedAt.Time),
+		})
+	}
+
+	return TrashResBody{
+		Data: data,
+	}
+}
+
+type RestoreJobApplicationResBody struct {
+	ID             string              `json:"id" example:"f4d15edc-e780-42b5-957d-c4352401d9ca"`
+	CompanyID      string              `json:"companyId" example:"2e7c4b1a-8f3d-4c6e-9a5b-1d0f3e2c4b6a"`
+	CompanyName    string              `json:"companyName" example:"Evil Corp Inc."`
+	JobTitle       string              `json:"jobTitle" example:"Software Engineer"`
+	DateApplied    string              `json:"dateApplied" example:"2025-03-14"`
+	Stage          jobApplicationStage `json:"stage"`
+	IsReplied      bool                `json:"isReplied" example:"false"`
+	MinSalary      float64             `json:"minSalary,omitempty" example:"50000.00"`
+	MaxSalary      float64             `json:"maxSalary,omitempty" example:"70000.00"`
+	SalaryCurrency string              `json:"salaryCurrency" example:"PLN"`
+	SalaryPeriod   db.SalaryPeriod     `json:"salaryPeriod" example:"MONTH"`
+	SalaryType     db.SalaryType       `json:"salaryType" example:"GROSS"`
+	ContractType   db.ContractType     `json:"contractType,omitempty" example:"B2B"`
+	JobPostingURL  string              `json:"jobPostingURL,omitempty" example:"https://glassbore.com/jobs/swe420692137"`
+	Notes          string              `json:"notes,omitempty" example:"Follow up in two weeks"`
+}
+
+func NewRestoreJobApplicationResBody(jobApplication db.RestoreJobApplicationRow) RestoreJobApplicationResBody {
+	return RestoreJobApplicationResBody{
+		ID:          jobApplication.ID.String(),
+		CompanyID:   jobApplication.CompanyID.String(),
+		CompanyName: jobApplication.CompanyName,
+		JobTitle:    jobApplication.JobTitle,
+		DateApplied: fromDate(jobApplication.DateApplied),
+		Stage: jobApplicationStage{
+			ID:         jobApplication.StageID.String(),
+			Name:       jobApplication.StageName,
+			Color:      jobApplication.StageColor,
+			IsTerminal: jobApplication.StageIsTerminal,
+			Outcome:    jobApplication.StageOutcome,
+		},
+		IsReplied:      jobApplication.IsReplied,
+		MinSalary:      jobApplication.MinSalary.Float64,
+		MaxSalary:      jobApplication.MaxSalary.Float64,
+		SalaryCurrency: jobApplication.SalaryCurrency,
+		SalaryPeriod:   jobApplication.SalaryPeriod,
+		SalaryType:     jobApplication.SalaryType,
+		ContractType:   jobApplication.ContractType.ContractType,
+		JobPostingURL:  jobApplication.JobPostingUrl.String,
+		Notes:          jobApplication.Notes.String,
+	}
+}
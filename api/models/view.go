@@ -0,0 +1,210 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+// DefaultView can be passed instead of a view uuid to apply whichever view is marked as the default
+const DefaultView = "default"
+
+// NOTE: Every job applications list param, except the ones picking a page, which make no sense to save
+var viewQueryParams = func() map[string]bool {
+	params := map[string]bool{}
+	t := reflect.TypeOf(JobApplicationsQueryParams{})
+	for i := range t.NumField() {
+		params[t.Field(i).Tag.Get("form")] = true
+	}
+	delete(params, "page")
+	delete(params, "cursor")
+	return params
+}()
+
+// BindJobApplicationsQueryParams binds and validates list params coming from anywhere, not only from the request url
+func BindJobApplicationsQueryParams(values url.Values, queryParams any) error {
+	if err := binding.MapFormWithTag(queryParams, values, "form"); err != nil {
+		return err
+	}
+	return binding.Validator.ValidateStruct(queryParams)
+}
+
+// NewViewQuery validates a saved query string the same way the job applications list would, and puts its params in a stable order
+func NewViewQuery(query string) (string, error) {
+	values, err := url.ParseQuery(strings.TrimPrefix(query, "?"))
+	if err != nil {
+		return "", err
+	}
+
+	for key := range values {
+		if !viewQueryParams[key] {
+			return "", fmt.Errorf("%q can't be saved in a view", key)
+		}
+	}
+
+	var queryParams JobApplicationsQueryParams
+	if err := BindJobApplicationsQueryParams(values, &queryParams); err != nil {
+		return "", err
+	}
+	if _, err := NewGetJobApplicationsParams(pgtype.UUID{}, queryParams, db.GetUserPreferencesRow{}); err != nil {
+		return "", err
+	}
+
+	return values.Encode(), nil
+}
+
+type viewEntry struct {
+	ID        string `json:"id" example:"3f2a1b0c-9d8e-4f7a-6b5c-4d3e2f1a0b9c"`
+	Name      string `json:"name" example:"Waiting for a reply"`
+	Query     string `json:"query" example:"applied_within_days=30&is_replied=false&outcome=NEUTRAL"`
+	IsDefault bool   `json:"isDefault" example:"true"`
+}
+
+type ViewsResBody struct {
+	Data []viewEntry `json:"data"`
+}
+
+func NewViewsResBody(views []db.GetViewsRow) ViewsResBody {
+	data := []viewEntry{}
+
+	for _, view := range views {
+		data = append(data, viewEntry{
+			ID:        view.ID.String(),
+			Name:      view.Name,
+			Query:     view.Query,
+			IsDefault: view.IsDefault,
+		})
+	}
+
+	return ViewsResBody{
+		Data: data,
+	}
+}
+
+type ViewResBody struct {
+	ID        string `json:"id" example:"3f2a1b0c-9d8e-4f7a-6b5c-4d3e2f1a0b9c"`
+	Name      string `json:"name" example:"Waiting for a reply"`
+	Query     string `json:"query" example:"applied_within_days=30&is_replied=false&outcome=NEUTRAL"`
+	IsDefault bool   `json:"isDefault" example:"true"`
+}
+
+func NewViewResBody(view db.GetViewRow) ViewResBody {
+	return ViewResBody{
+		ID:        view.ID.String(),
+		Name:      view.Name,
+		Query:     view.Query,
+		IsDefault: view.IsDefault,
+	}
+}
+
+type CreateViewReqBody struct {
+	Name      string `json:"name" binding:"required" example:"Waiting for a reply"`
+	Query     string `json:"query" example:"outcome=NEUTRAL&is_replied=false&applied_within_days=30"` // NOTE: Job applications list query string, without page or cursor
+	IsDefault bool   `json:"isDefault,omitempty" example:"true"`                                      // NOTE: Takes the default over from any other view
+}
+
+func NewCreateViewReqBody(name, query string, isDefault bool) CreateViewReqBody {
+	return CreateViewReqBody{
+		Name:      name,
+		Query:     query,
+		IsDefault: isDefault,
+	}
+}
+
+type CreateViewResBody struct {
+	ID        string `json:"id" example:"3f2a1b0c-9d8e-4f7a-6b5c-4d3e2f1a0b9c"`
+	Name      string `json:"name" example:"Waiting for a reply"`
+	Query     string `json:"query" example:"applied_within_days=30&is_replied=false&outcome=NEUTRAL"`
+	IsDefault bool   `json:"isDefault" example:"true"`
+}
+
+func NewCreateViewResBody(view db.CreateViewRow) CreateViewResBody {
+	return CreateViewResBody{
+		ID:        view.ID.String(),
+		Name:      view.Name,
+		Query:     view.Query,
+		IsDefault: view.IsDefault,
+	}
+}
+
+// NOTE: Expects the query to be normalised by NewViewQuery already
+func NewCreateViewParams(userId pgtype.UUID, body CreateViewReqBody) db.CreateViewParams {
+	return db.CreateViewParams{
+		UserID:    userId,
+		Name:      body.Name,
+		Query:     body.Query,
+		IsDefault: body.IsDefault,
+	}
+}
+
+type UpdateViewReqBody struct {
+	Name      string  `json:"name,omitempty" example:"Waiting for a reply"`
+	Query     *string `json:"query,omitempty" example:"outcome=NEUTRAL&is_replied=false&applied_within_days=30"` // NOTE: An empty string clears every filter
+	IsDefault *bool   `json:"isDefault,omitempty" example:"true"`
+}
+
+func NewUpdateViewReqBody(name string, query *string, isDefault *bool) UpdateViewReqBody {
+	return UpdateViewReqBody{
+		Name:      name,
+		Query:     query,
+		IsDefault: isDefault,
+	}
+}
+
+type UpdateViewResBody struct {
+	ID        string `json:"id" example:"3f2a1b0c-9d8e-4f7a-6b5c-4d3e2f1a0b9c"`
+	Name      string `json:"name" example:"Waiting for a reply"`
+	Query     string `json:"query" example:"applied_within_days=30&is_replied=false&outcome=NEUTRAL"`
+	IsDefault bool   `json:"isDefault" example:"true"`
+}
+
+func NewUpdateViewResBody(view db.UpdateViewRow) UpdateViewResBody {
+	return UpdateViewResBody{
+		ID:        view.ID.String(),
+		Name:      view.Name,
+		Query:     view.Query,
+		IsDefault: view.IsDefault,
+	}
+}
+
+// NOTE: Expects the query to be normalised by NewViewQuery already
+func NewUpdateViewParams(viewId, userId pgtype.UUID, body UpdateViewReqBody) db.UpdateViewParams {
+	var query pgtype.Text
+	if body.Query != nil {
+		query = pgtype.Text{String: *body.Query, Valid: true}
+	}
+
+	var isDefault pgtype.Bool
+	if body.IsDefault != nil {
+		isDefault = pgtype.Bool{Bool: *body.IsDefault, Valid: true}
+	}
+
+	return db.UpdateViewParams{
+		ID:        viewId,
+		UserID:    userId,
+		Name:      pgtype.Text{String: body.Name, Valid: true},
+		Query:     query,
+		IsDefault: isDefault,
+	}
+}
+
+type DeleteViewResBody struct {
+	ID        string `json:"id" example:"3f2a1b0c-9d8e-4f7a-6b5c-4d3e2f1a0b9c"`
+	Name      string `json:"name" example:"Waiting for a reply"`
+	Query     string `json:"query" example:"applied_within_days=30&is_replied=false&outcome=NEUTRAL"`
+	IsDefault bool   `json:"isDefault" example:"true"`
+}
+
+func NewDeleteViewResBody(view db.DeleteViewRow) DeleteViewResBody {
+	return DeleteViewResBody{
+		ID:        view.ID.String(),
+		Name:      view.Name,
+		Query:     view.Query,
+		IsDefault: view.IsDefault,
+	}
+}
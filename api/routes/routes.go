@@ -10,6 +10,7 @@ import (
 	"github.com/jakub-szewczyk/career-compass-gin/docs"
 	_ "github.com/jakub-szewczyk/career-compass-gin/docs"
 	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/storage"
 	"github.com/swaggo/files"
 	"github.com/swaggo/gin-swagger"
 )
@@ -19,7 +20,7 @@ import (
 // @securityDefinitions.apikey	BearerAuth
 // @in							header
 // @name						Authorization
-func Setup(ctx context.Context, env handlers.Env, queries *db.Queries) *gin.Engine {
+func Setup(ctx context.Context, env handlers.Env, conn handlers.Conn, queries *db.Queries, storage storage.Storage) *gin.Engine {
 	// TODO: Read from env vars
 	docs.SwaggerInfo.Version = "1.0"
 	docs.SwaggerInfo.Host = "localhost:" + env.Port
@@ -29,13 +30,13 @@ func Setup(ctx context.Context, env handlers.Env, queries *db.Queries) *gin.Engi
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{env.FrontendURL},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "If-Match", "If-None-Match", "Idempotency-Key"},
+		ExposeHeaders:    []string{"Content-Length", "ETag", "Idempotent-Replayed"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
-	h := handlers.NewHandler(ctx, env, queries)
+	h := handlers.NewHandler(ctx, env, conn, queries, storage)
 
 	api := r.Group("/api")
 
@@ -44,8 +45,11 @@ func Setup(ctx context.Context, env handlers.Env, queries *db.Queries) *gin.Engi
 
 	api.GET("/health-check", h.HealthCheck)
 
-	api.POST("/sign-up", h.SignUp)
+	api.POST("/sign-up", h.IdempotentSignUp(), h.SignUp)
 	api.POST("/sign-in", h.SignIn)
+	api.POST("/sign-in/mfa", h.SignInMFA)
+
+	api.POST("/token/refresh", h.RefreshToken)
 
 	api.POST("/password/reset", h.InitPasswordReset)
 	api.PUT("/password/reset", h.ResetPassword)
@@ -53,16 +57,94 @@ func Setup(ctx context.Context, env handlers.Env, queries *db.Queries) *gin.Engi
 	// NOTE: Private routes
 	api.Use(h.Auth())
 
+	api.POST("/sign-out", h.SignOut)
+	api.POST("/sign-out/all", h.SignOutAll)
+
 	api.GET("/profile", h.Profile)
 
+	api.GET("/profile/preferences", h.Preferences)
+	api.PUT("/profile/preferences", h.UpdatePreferences)
+
 	api.GET("/profile/verify-email", h.SendVerificationEmail)
 	api.PATCH("/profile/verify-email", h.VerifyEmail)
 
+	api.POST("/profile/mfa/totp", h.EnrollTOTP)
+	api.POST("/profile/mfa/totp/confirm", h.ConfirmTOTP)
+	api.POST("/profile/mfa/totp/disable", h.DisableTOTP)
+	api.POST("/profile/mfa/recovery-codes", h.RegenerateRecoveryCodes)
+
+	api.GET("/stages", h.Stages)
+	api.GET("/stages/:stageId", h.Stage)
+	api.POST("/stages", h.CreateStage)
+	api.PUT("/stages/:stageId", h.UpdateStage)
+	api.DELETE("/stages/:stageId", h.DeleteStage)
+
+	api.GET("/companies", h.Companies)
+	api.GET("/companies/:companyId", h.Company)
+	api.POST("/companies", h.CreateCompany)
+	api.PUT("/companies/:companyId", h.UpdateCompany)
+	api.DELETE("/companies/:companyId", h.DeleteCompany)
+	api.POST("/companies/:companyId/merge", h.MergeCompanies)
+
 	api.GET("/job-applications", h.JobApplications)
+	api.GET("/job-applications/export", h.ExportJobApplications)
 	api.GET("/job-applications/:jobApplicationId", h.JobApplication)
-	api.POST("/job-applications", h.CreateJobApplication)
+	api.GET("/job-applications/:jobApplicationId/timeline", h.JobApplicationTimeline)
+	api.POST("/job-applications", h.Idempotent(), h.CreateJobApplication)
+	api.POST("/job-applications/import", h.ImportJobApplications)
+	api.POST("/job-applications/bulk", h.BulkJobApplications)
 	api.PUT("/job-applications/:jobApplicationId", h.UpdateJobApplication)
+	api.PATCH("/job-applications/:jobApplicationId", h.PatchJobApplication)
 	api.DELETE("/job-applications/:jobApplicationId", h.DeleteJobApplication)
+	api.POST("/job-applications/:jobApplicationId/restore", h.RestoreJobApplication)
+
+	api.GET("/job-applications/:jobApplicationId/interviews", h.Interviews)
+	api.GET("/job-applications/:jobApplicationId/interviews/:interviewId", h.Interview)
+	api.POST("/job-applications/:jobApplicationId/interviews", h.CreateInterview)
+	api.PUT("/job-applications/:jobApplicationId/interviews/:interviewId", h.UpdateInterview)
+	api.DELETE("/job-applications/:jobApplicationId/interviews/:interviewId", h.DeleteInterview)
+
+	api.GET("/interviews", h.UpcomingInterviews)
+
+	api.GET("/job-applications/:jobApplicationId/attachments", h.JobApplicationAttachments)
+	api.GET("/job-applications/:jobApplicationId/attachments/:attachmentId", h.Attachment)
+	api.POST("/job-applications/:jobApplicationId/attachments", h.CreateAttachment)
+	api.DELETE("/job-applications/:jobApplicationId/attachments/:attachmentId", h.DeleteAttachment)
+
+	api.GET("/attachments", h.Attachments)
+
+	api.POST("/job-applications/:jobApplicationId/reminders", h.CreateReminder)
+
+	api.GET("/reminders", h.Reminders)
+	api.POST("/reminders/:reminderId/snooze", h.SnoozeReminder)
+	api.POST("/reminders/:reminderId/dismiss", h.DismissReminder)
+
+	api.GET("/contacts", h.Contacts)
+	api.GET("/contacts/:contactId", h.Contact)
+	api.POST("/contacts", h.CreateContact)
+	api.PUT("/contacts/:contactId", h.UpdateContact)
+	api.DELETE("/contacts/:contactId", h.DeleteContact)
+
+	api.PUT("/job-applications/:jobApplicationId/contacts/:contactId", h.LinkJobApplicationContact)
+	api.DELETE("/job-applications/:jobApplicationId/contacts/:contactId", h.UnlinkJobApplicationContact)
+
+	api.GET("/tags", h.Tags)
+	api.POST("/tags", h.CreateTag)
+	api.PUT("/tags/:tagId", h.UpdateTag)
+	api.DELETE("/tags/:tagId", h.DeleteTag)
+
+	api.PUT("/job-applications/:jobApplicationId/tags/:tagId", h.TagJobApplication)
+	api.DELETE("/job-applications/:jobApplicationId/tags/:tagId", h.UntagJobApplication)
+
+	api.GET("/stats", h.Stats)
+
+	api.GET("/trash", h.Trash)
+
+	api.GET("/views", h.Views)
+	api.GET("/views/:viewId", h.View)
+	api.POST("/views", h.CreateView)
+	api.PUT("/views/:viewId", h.UpdateView)
+	api.DELETE("/views/:viewId", h.DeleteView)
 
 	return r
 }
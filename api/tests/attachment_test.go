@@ -0,0 +1,470 @@
+package tests
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+var pdf = []byte("%PDF-1.7\n1 0 obj\n<< /Type /Catalog >>\nendobj\n%%EOF\n")
+
+func newAttachmentRequest(url, filename string, contents []byte, kind string) *http.Request {
+	var body bytes.Buffer
+
+	w := multipart.NewWriter(&body)
+
+	file, _ := w.CreateFormFile("file", filename)
+	file.Write(contents)
+
+	if kind != "" {
+		w.WriteField("kind", kind)
+	}
+
+	w.Close()
+
+	req, _ := http.NewRequest("POST", url, &body)
+	req.Header.Add("Content-Type", w.FormDataContentType())
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func setUpAttachment(userId, jobApplicationId pgtype.UUID, filename string, contents []byte, kind db.AttachmentKind) db.CreateAttachmentRow {
+	checksum := sha256.Sum256(contents)
+	key := userId.String() + "/" + jobApplicationId.String() + "/" + filename
+
+	if err := files.Put(ctx, key, bytes.NewReader(contents), int64(len(contents)), "application/pdf"); err != nil {
+		panic(err)
+	}
+
+	attachment, err := queries.CreateAttachment(ctx, db.CreateAttachmentParams{
+		Kind:             kind,
+		Filename:         filename,
+		ContentType:      "application/pdf",
+		Size:             int64(len(contents)),
+		Checksum:         hex.EncodeToString(checksum[:]),
+		StorageKey:       key,
+		JobApplicationID: jobApplicationId,
+		UserID:           userId,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return attachment
+}
+
+func TestAttachments(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	evilCorp := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	apple := setUpJobApplication(user.ID, "Apple", "Frontend Developer")
+
+	cv := []byte("%PDF-1.7\n% CV, version 2\n%%EOF\n")
+
+	setUpAttachment(user.ID, evilCorp.ID, "cv-v2.pdf", cv, db.AttachmentKindCV)
+	setUpAttachment(user.ID, apple.ID, "cv-v2.pdf", cv, db.AttachmentKindCV)
+	setUpAttachment(user.ID, apple.ID, "cover-letter.pdf", pdf, db.AttachmentKindCOVERLETTER)
+
+	checksum := sha256.Sum256(cv)
+
+	t.Run("job applications sent with a file", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/attachments?checksum="+hex.EncodeToString(checksum[:]), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.AttachmentsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 2)
+
+		companies := []string{resBodyRaw.Data[0].CompanyName, resBodyRaw.Data[1].CompanyName}
+
+		assert.ElementsMatch(t, []string{"Evil Corp Inc.", "Apple"}, companies)
+	})
+
+	t.Run("filtered by kind", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/attachments?kind=COVER_LETTER", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.AttachmentsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 1)
+		assert.Equal(t, "cover-letter.pdf", resBodyRaw.Data[0].Filename)
+		assert.Equal(t, "Apple", resBodyRaw.Data[0].CompanyName)
+	})
+
+	t.Run("invalid checksum", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/attachments?checksum=abc", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("job application attachments", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/"+apple.ID.String()+"/attachments", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.AttachmentsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 2)
+		for _, attachment := range resBodyRaw.Data {
+			assert.Equal(t, apple.ID.String(), attachment.JobApplicationID)
+		}
+	})
+}
+
+func TestCreateAttachment(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	url := "/api/job-applications/" + jobApplication.ID.String() + "/attachments"
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newAttachmentRequest(url, "cv.pdf", pdf, "CV"))
+
+		var resBodyRaw models.CreateAttachmentResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		checksum := sha256.Sum256(pdf)
+
+		assert.NotEmpty(t, resBodyRaw.ID)
+		assert.Equal(t, jobApplication.ID.String(), resBodyRaw.JobApplicationID)
+		assert.Equal(t, db.AttachmentKindCV, resBodyRaw.Kind)
+		assert.Equal(t, "cv.pdf", resBodyRaw.Filename)
+		assert.Equal(t, "application/pdf", resBodyRaw.ContentType)
+		assert.Equal(t, int64(len(pdf)), resBodyRaw.Size)
+		assert.Equal(t, hex.EncodeToString(checksum[:]), resBodyRaw.Checksum)
+	})
+
+	t.Run("kind defaults to other", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newAttachmentRequest(url, "notes.txt", []byte("Asked about the team size"), ""))
+
+		var resBodyRaw models.CreateAttachmentResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, db.AttachmentKindOTHER, resBodyRaw.Kind)
+		assert.Equal(t, "text/plain", resBodyRaw.ContentType)
+	})
+
+	t.Run("content type sniffed from contents", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newAttachmentRequest(url, "cv.png", pdf, "CV"))
+
+		var resBodyRaw models.CreateAttachmentResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, "application/pdf", resBodyRaw.ContentType)
+	})
+
+	t.Run("unsupported file type", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newAttachmentRequest(url, "cv.pdf", []byte("<html><body>Not a PDF</body></html>"), "CV"))
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newAttachmentRequest(url, "cv.pdf", pdf, "PORTFOLIO"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var body bytes.Buffer
+
+		mw := multipart.NewWriter(&body)
+		mw.WriteField("kind", "CV")
+		mw.Close()
+
+		req, _ := http.NewRequest("POST", url, &body)
+		req.Header.Add("Content-Type", mw.FormDataContentType())
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("file too large", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		large := append(append([]byte{}, pdf...), bytes.Repeat([]byte{' '}, 10<<20)...)
+
+		r.ServeHTTP(w, newAttachmentRequest(url, "cv.pdf", large, "CV"))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("quota exceeded", func(t *testing.T) {
+		other := setUpJobApplication(user.ID, "Apple", "Frontend Developer")
+
+		usage, _ := queries.GetAttachmentsUsage(ctx, user.ID)
+
+		queries.CreateAttachment(ctx, db.CreateAttachmentParams{
+			Kind:             db.AttachmentKindOTHER,
+			Filename:         "portfolio.pdf",
+			ContentType:      "application/pdf",
+			Size:             100<<20 - usage - int64(len(pdf)) + 1,
+			Checksum:         strings.Repeat("0", 64),
+			StorageKey:       user.ID.String() + "/portfolio",
+			JobApplicationID: other.ID,
+			UserID:           user.ID,
+		})
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newAttachmentRequest(url, "cv.pdf", pdf, "CV"))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+		attachments, _ := queries.GetAttachments(ctx, db.GetAttachmentsParams{UserID: user.ID, JobApplicationID: jobApplication.ID})
+
+		assert.Len(t, attachments, 3, "rejected file should not be saved")
+	})
+
+	t.Run("job application not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newAttachmentRequest("/api/job-applications/7b6a5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d/attachments", "cv.pdf", pdf, "CV"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAttachment(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	attachment := setUpAttachment(user.ID, jobApplication.ID, "cv 2025.pdf", pdf, db.AttachmentKindCV)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/"+jobApplication.ID.String()+"/attachments/"+attachment.ID.String(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="cv 2025.pdf"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(t, pdf, w.Body.Bytes())
+	})
+
+	t.Run("wrong job application", func(t *testing.T) {
+		other := setUpJobApplication(user.ID, "Apple", "Frontend Developer")
+
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/"+other.ID.String()+"/attachments/"+attachment.ID.String(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	attachment := setUpAttachment(user.ID, jobApplication.ID, "cv.pdf", pdf, db.AttachmentKindCV)
+
+	t.Run("valid request", func(t *testing.T) {
+		stored, _ := queries.GetAttachment(ctx, db.GetAttachmentParams{ID: attachment.ID, JobApplicationID: jobApplication.ID, UserID: user.ID})
+
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", "/api/job-applications/"+jobApplication.ID.String()+"/attachments/"+attachment.ID.String(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.DeleteAttachmentResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, attachment.ID.String(), resBodyRaw.ID)
+
+		_, err = files.Get(ctx, stored.StorageKey)
+
+		assert.ErrorIs(t, err, storage.ErrNotFound, "contents should be deleted")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", "/api/job-applications/"+jobApplication.ID.String()+"/attachments/"+attachment.ID.String(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+// fakeS3 is a minimal stand-in for an S3-compatible service, e.g. MinIO, keeping objects in memory
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		s.objects[r.URL.Path] = body
+	case http.MethodGet:
+		body, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(body)
+	case http.MethodDelete:
+		delete(s.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}}
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	s3, err := storage.NewS3Storage(server.URL, "us-east-1", "career-compass", "minio", "minio123")
+
+	assert.NoError(t, err)
+
+	t.Run("put and get", func(t *testing.T) {
+		err := s3.Put(ctx, "user/cv 2025.pdf", bytes.NewReader(pdf), int64(len(pdf)), "application/pdf")
+
+		assert.NoError(t, err)
+		assert.Contains(t, fake.objects, "/career-compass/user/cv 2025.pdf", "bucket should be addressed path-style")
+
+		body, err := s3.Get(ctx, "user/cv 2025.pdf")
+
+		assert.NoError(t, err)
+
+		contents, _ := io.ReadAll(body)
+		body.Close()
+
+		assert.Equal(t, pdf, contents)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := s3.Delete(ctx, "user/cv 2025.pdf")
+
+		assert.NoError(t, err)
+
+		_, err = s3.Get(ctx, "user/cv 2025.pdf")
+
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		s3, _ := storage.NewS3Storage(server.URL, "us-east-1", "career-compass", "someone", "secret")
+
+		err := s3.Put(ctx, "user/cv.pdf", bytes.NewReader(pdf), int64(len(pdf)), "application/pdf")
+
+		assert.Error(t, err)
+	})
+}
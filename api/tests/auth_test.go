@@ -79,4 +79,162 @@ func TestSignIn(t *testing.T) {
 	assert.Equal(t, "jakub.szewczyk@test.com", resBodyRaw.User.Email)
 	assert.Equal(t, false, resBodyRaw.User.IsEmailVerified)
 	assert.NotEmpty(t, resBodyRaw.Token, "missing token")
+	assert.NotEmpty(t, resBodyRaw.RefreshToken, "missing refresh token")
+}
+
+func TestRefreshToken(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewRefreshTokenReqBody(refreshToken)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/token/refresh", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.RefreshTokenResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Token, "missing token")
+		assert.NotEmpty(t, resBodyRaw.RefreshToken, "missing refresh token")
+		assert.NotEqual(t, refreshToken, resBodyRaw.RefreshToken)
+
+		w = httptest.NewRecorder()
+
+		req, _ = http.NewRequest("GET", "/api/profile", nil)
+		req.Header.Add("Authorization", "Bearer "+resBodyRaw.Token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("rotated refresh token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewRefreshTokenReqBody(refreshToken)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/token/refresh", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		assert.Equal(t, "invalid or expired refresh token", resBodyRaw.Error)
+	})
+
+	t.Run("invalid payload - missing refresh token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/token/refresh", nil)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSignOut(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/sign-out", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("revoked session", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/profile", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		assert.Equal(t, "session has been revoked or expired", resBodyRaw.Error)
+	})
+
+	t.Run("revoked refresh token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewRefreshTokenReqBody(refreshToken)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/token/refresh", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestSignOutAll(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	w := httptest.NewRecorder()
+
+	bodyRaw := models.NewSignInReqBody("jakub.szewczyk@test.com", "qwerty!123456789")
+	bodyJSON, _ := json.Marshal(bodyRaw)
+
+	req, _ := http.NewRequest("POST", "/api/sign-in", strings.NewReader(string(bodyJSON)))
+
+	r.ServeHTTP(w, req)
+
+	var signInResBody models.SignInResBody
+	json.Unmarshal(w.Body.Bytes(), &signInResBody)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/sign-out/all", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("every session revoked", func(t *testing.T) {
+		for _, tkn := range []string{token, signInResBody.Token} {
+			w := httptest.NewRecorder()
+
+			req, _ := http.NewRequest("GET", "/api/profile", nil)
+			req.Header.Add("Authorization", "Bearer "+tkn)
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+		}
+	})
 }
@@ -0,0 +1,446 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompanies(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	setUpJobApplication(user.ID, "Evil Corp", "Senior Software Engineer")
+	setUpJobApplication(user.ID, "evil corp.", "Staff Software Engineer")
+	setUpJobApplication(user.ID, "Apple", "iOS Developer")
+
+	t.Run("valid request - spelling variants collapsed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/companies", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CompaniesResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 2, resBodyRaw.Total)
+		assert.Equal(t, "Apple", resBodyRaw.Data[0].Name)
+		assert.Equal(t, 1, resBodyRaw.Data[0].JobApplicationCount)
+		assert.Equal(t, "Evil Corp Inc.", resBodyRaw.Data[1].Name)
+		assert.Equal(t, 3, resBodyRaw.Data[1].JobApplicationCount)
+	})
+
+	t.Run("valid request - search", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/companies?search=evil", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CompaniesResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 1, resBodyRaw.Total)
+		assert.Equal(t, "Evil Corp Inc.", resBodyRaw.Data[0].Name)
+	})
+
+	t.Run("valid request - search normalising to nothing", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/companies?search=%2C%2C%2C", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CompaniesResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 0, resBodyRaw.Total)
+	})
+}
+
+func TestCompany(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	softwareEngineer := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	seniorSoftwareEngineer := setUpJobApplication(user.ID, "Evil Corp", "Senior Software Engineer")
+	setUpJobApplication(user.ID, "Evil Corp", "Staff Software Engineer")
+
+	queries.UpdateJobApplication(ctx, db.UpdateJobApplicationParams{
+		ID:      softwareEngineer.ID,
+		UserID:  user.ID,
+		StageID: stages[1].ID,
+	})
+	queries.UpdateJobApplication(ctx, db.UpdateJobApplicationParams{
+		ID:      seniorSoftwareEngineer.ID,
+		UserID:  user.ID,
+		StageID: stages[2].ID,
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/companies/%v", softwareEngineer.CompanyID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CompanyResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, softwareEngineer.CompanyID.String(), resBodyRaw.ID)
+		assert.Equal(t, "Evil Corp Inc.", resBodyRaw.Name)
+		assert.Len(t, resBodyRaw.JobApplications, 3)
+		assert.Equal(t, 3, resBodyRaw.Outcomes.Total)
+		assert.Equal(t, 1, resBodyRaw.Outcomes.Neutral)
+		assert.Equal(t, 1, resBodyRaw.Outcomes.Negative)
+		assert.Equal(t, 1, resBodyRaw.Outcomes.Positive)
+	})
+
+	t.Run("non-existing company", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/companies/f4d15edc-e780-42b5-957d-c4352401d9ca", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCreateCompany(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			name     = "Evil Corp Inc."
+			website  = "https://evilcorp.com"
+			industry = "Conglomerate"
+			size     = "10000+"
+			location = "New York, NY"
+			notes    = "Great benefits, questionable ethics"
+		)
+
+		bodyRaw := models.NewCreateCompanyReqBody(name, website, industry, size, location, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/companies", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateCompanyResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.ID, "missing company id")
+		assert.Equal(t, name, resBodyRaw.Name)
+		assert.Equal(t, website, resBodyRaw.Website)
+		assert.Equal(t, industry, resBodyRaw.Industry)
+		assert.Equal(t, size, resBodyRaw.Size)
+		assert.Equal(t, location, resBodyRaw.Location)
+		assert.Equal(t, notes, resBodyRaw.Notes)
+	})
+
+	t.Run("invalid payload - name clashing after normalisation", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateCompanyReqBody("EVIL CORP", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/companies", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "a company with this name already exists", resBodyRaw.Error)
+	})
+
+	t.Run("invalid payload - incorrect website", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateCompanyReqBody("Apple", "apple", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/companies", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'Website' failed on the 'url' tag")
+	})
+}
+
+func TestUpdateCompany(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp", "Software Engineer")
+	setUpJobApplication(user.ID, "Apple", "iOS Developer")
+
+	t.Run("valid request - rename", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewUpdateCompanyReqBody("E Corp", "https://e-corp.com", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/companies/%v", jobApplication.CompanyID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpdateCompanyResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "E Corp", resBodyRaw.Name)
+		assert.Equal(t, "https://e-corp.com", resBodyRaw.Website)
+
+		updated, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{
+			ID:     jobApplication.ID,
+			UserID: user.ID,
+		})
+
+		assert.Equal(t, "E Corp", updated.CompanyName, "job applications should follow the company name")
+	})
+
+	t.Run("invalid payload - name clashing after normalisation", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewUpdateCompanyReqBody("Apple Inc.", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/companies/%v", jobApplication.CompanyID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("non-existing company", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewUpdateCompanyReqBody("Initech", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", "/api/companies/f4d15edc-e780-42b5-957d-c4352401d9ca", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteCompany(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	company, _ := queries.CreateCompany(ctx, db.CreateCompanyParams{
+		UserID: user.ID,
+		Name:   "Initech",
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/companies/%v", company.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.DeleteCompanyResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, company.ID.String(), resBodyRaw.ID)
+	})
+
+	t.Run("company with job applications", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/companies/%v", jobApplication.CompanyID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "company is still assigned to job applications", resBodyRaw.Error)
+	})
+
+	t.Run("non-existing company", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/companies/%v", company.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestMergeCompanies(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	target, _ := queries.CreateCompany(ctx, db.CreateCompanyParams{
+		UserID: user.ID,
+		Name:   "Evil Corp Inc.",
+	})
+	source, _ := queries.CreateCompany(ctx, db.CreateCompanyParams{
+		UserID:  user.ID,
+		Name:    "E-Corp",
+		Website: pgtype.Text{String: "https://evilcorp.com", Valid: true},
+	})
+
+	softwareEngineer := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	seniorSoftwareEngineer := setUpJobApplication(user.ID, "E-Corp", "Senior Software Engineer")
+
+	t.Run("invalid payload - merging into itself", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewMergeCompaniesReqBody([]string{target.ID.String()})
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/companies/%v/merge", target.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("non-existing company", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewMergeCompaniesReqBody([]string{source.ID.String(), "f4d15edc-e780-42b5-957d-c4352401d9ca"})
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/companies/%v/merge", target.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewMergeCompaniesReqBody([]string{source.ID.String()})
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/companies/%v/merge", target.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CompanyResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, target.ID.String(), resBodyRaw.ID)
+		assert.Equal(t, "https://evilcorp.com", resBodyRaw.Website, "missing details should be taken over from merged companies")
+		assert.Len(t, resBodyRaw.JobApplications, 2)
+		assert.Equal(t, 2, resBodyRaw.Outcomes.Total)
+
+		moved, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{
+			ID:     seniorSoftwareEngineer.ID,
+			UserID: user.ID,
+		})
+
+		assert.Equal(t, target.ID, moved.CompanyID)
+		assert.Equal(t, "Evil Corp Inc.", moved.CompanyName)
+		assert.Equal(t, target.ID, softwareEngineer.CompanyID)
+
+		_, err = queries.GetCompany(ctx, db.GetCompanyParams{
+			ID:     source.ID,
+			UserID: user.ID,
+		})
+
+		assert.Error(t, err, "merged company should be deleted")
+	})
+}
@@ -0,0 +1,509 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func setUpContact(userId pgtype.UUID, name, email, company, role string) db.CreateContactRow {
+	contact, err := queries.CreateContact(ctx, db.CreateContactParams{
+		UserID:  userId,
+		Name:    name,
+		Email:   pgtype.Text{String: email, Valid: email != ""},
+		Company: pgtype.Text{String: company, Valid: company != ""},
+		Role:    pgtype.Text{String: role, Valid: role != ""},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return contact
+}
+
+func TestContacts(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	setUpContact(user.ID, "Jane Doe", "jane.doe@evilcorp.com", "Evil Corp Inc.", "Technical Recruiter")
+	setUpContact(user.ID, "John Smith", "john@talentscout.io", "Talent Scout", "Recruiter")
+	setUpContact(user.ID, "Alice Johnson", "", "Apple", "Engineering Manager")
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/contacts", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.ContactsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 0, resBodyRaw.Page)
+		assert.Equal(t, 10, resBodyRaw.Size)
+		assert.Equal(t, 3, resBodyRaw.Total)
+		assert.Equal(t, "Alice Johnson", resBodyRaw.Data[0].Name)
+		assert.Equal(t, "Jane Doe", resBodyRaw.Data[1].Name)
+		assert.Equal(t, "John Smith", resBodyRaw.Data[2].Name)
+	})
+
+	t.Run("valid request - search by company", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/contacts?search=evil", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.ContactsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 1, resBodyRaw.Total)
+		assert.Equal(t, "Jane Doe", resBodyRaw.Data[0].Name)
+	})
+
+	t.Run("valid request - search by role", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/contacts?search=recruiter", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.ContactsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 2, resBodyRaw.Total)
+	})
+
+	t.Run("valid request - pagination", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/contacts?page=1&size=2", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.ContactsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 3, resBodyRaw.Total)
+		assert.Len(t, resBodyRaw.Data, 1)
+		assert.Equal(t, "John Smith", resBodyRaw.Data[0].Name)
+	})
+}
+
+func TestContact(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	contact := setUpContact(user.ID, "Jane Doe", "jane.doe@evilcorp.com", "Evil Corp Inc.", "Technical Recruiter")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	queries.LinkJobApplicationContact(ctx, db.LinkJobApplicationContactParams{
+		JobApplicationID: jobApplication.ID,
+		ContactID:        contact.ID,
+		UserID:           user.ID,
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/contacts/%v", contact.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.ContactResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, contact.ID.String(), resBodyRaw.ID)
+		assert.Equal(t, "Jane Doe", resBodyRaw.Name)
+		assert.Equal(t, "jane.doe@evilcorp.com", resBodyRaw.Email)
+		assert.Equal(t, "Evil Corp Inc.", resBodyRaw.Company)
+		assert.Equal(t, "Technical Recruiter", resBodyRaw.Role)
+		assert.Len(t, resBodyRaw.JobApplications, 1)
+		assert.Equal(t, jobApplication.ID.String(), resBodyRaw.JobApplications[0].ID)
+	})
+
+	t.Run("non-existing contact", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/contacts/f4d15edc-e780-42b5-957d-c4352401d9ca", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCreateContact(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			name        = "Jane Doe"
+			email       = "jane.doe@evilcorp.com"
+			phone       = "+48 123 456 789"
+			linkedInURL = "https://www.linkedin.com/in/jane-doe"
+			company     = "Evil Corp Inc."
+			role        = "Technical Recruiter"
+			notes       = "Prefers to be contacted via LinkedIn"
+		)
+
+		bodyRaw := models.NewCreateContactReqBody(name, email, phone, linkedInURL, company, role, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/contacts", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateContactResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.ID, "missing contact id")
+		assert.Equal(t, name, resBodyRaw.Name)
+		assert.Equal(t, email, resBodyRaw.Email)
+		assert.Equal(t, phone, resBodyRaw.Phone)
+		assert.Equal(t, linkedInURL, resBodyRaw.LinkedInURL)
+		assert.Equal(t, company, resBodyRaw.Company)
+		assert.Equal(t, role, resBodyRaw.Role)
+		assert.Equal(t, notes, resBodyRaw.Notes)
+	})
+
+	t.Run("invalid payload - missing name", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateContactReqBody("", "jane.doe@evilcorp.com", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/contacts", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'Name' failed on the 'required' tag")
+	})
+
+	t.Run("invalid payload - incorrect email", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateContactReqBody("Jane Doe", "jane.doe", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/contacts", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'Email' failed on the 'email' tag")
+	})
+
+	t.Run("invalid payload - incorrect LinkedIn URL", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateContactReqBody("Jane Doe", "", "", "linkedin/jane-doe", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/contacts", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'LinkedInURL' failed on the 'url' tag")
+	})
+}
+
+func TestUpdateContact(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	contact := setUpContact(user.ID, "Jane Doe", "jane.doe@evilcorp.com", "Evil Corp Inc.", "Technical Recruiter")
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewUpdateContactReqBody("", "jane@apple.com", "", "", "Apple", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/contacts/%v", contact.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpdateContactResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, contact.ID.String(), resBodyRaw.ID)
+		assert.Equal(t, "Jane Doe", resBodyRaw.Name)
+		assert.Equal(t, "jane@apple.com", resBodyRaw.Email)
+		assert.Equal(t, "Apple", resBodyRaw.Company)
+		assert.Equal(t, "Technical Recruiter", resBodyRaw.Role)
+	})
+
+	t.Run("non-existing contact", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewUpdateContactReqBody("Jane Doe", "", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", "/api/contacts/f4d15edc-e780-42b5-957d-c4352401d9ca", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteContact(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	contact := setUpContact(user.ID, "Jane Doe", "jane.doe@evilcorp.com", "Evil Corp Inc.", "Technical Recruiter")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	queries.LinkJobApplicationContact(ctx, db.LinkJobApplicationContactParams{
+		JobApplicationID: jobApplication.ID,
+		ContactID:        contact.ID,
+		UserID:           user.ID,
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/contacts/%v", contact.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.DeleteContactResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, contact.ID.String(), resBodyRaw.ID)
+
+		contacts, _ := queries.GetJobApplicationContacts(ctx, db.GetJobApplicationContactsParams{
+			JobApplicationID: jobApplication.ID,
+			UserID:           user.ID,
+		})
+
+		assert.Empty(t, contacts, "contact should be unlinked from job applications")
+	})
+
+	t.Run("non-existing contact", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/contacts/%v", contact.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestJobApplicationContacts(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	contact := setUpContact(user.ID, "Jane Doe", "jane.doe@evilcorp.com", "Evil Corp Inc.", "Technical Recruiter")
+
+	evilCorp := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	evilCorpAgain := setUpJobApplication(user.ID, "Evil Corp Inc.", "Senior Software Engineer")
+
+	t.Run("valid request - link", func(t *testing.T) {
+		for _, jobApplication := range []db.CreateJobApplicationRow{evilCorp, evilCorpAgain} {
+			w := httptest.NewRecorder()
+
+			req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v/contacts/%v", jobApplication.ID, contact.ID), nil)
+			req.Header.Add("Authorization", "Bearer "+token)
+
+			r.ServeHTTP(w, req)
+
+			var resBodyRaw models.JobApplicationContactsResBody
+			err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+			assert.NoError(t, err, "error unmarshaling response body")
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			assert.Len(t, resBodyRaw.Data, 1)
+			assert.Equal(t, contact.ID.String(), resBodyRaw.Data[0].ID)
+		}
+	})
+
+	t.Run("valid request - link twice", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v/contacts/%v", evilCorp.ID, contact.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationContactsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, resBodyRaw.Data, 1)
+	})
+
+	t.Run("valid request - contacts in job application details", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications/%v", evilCorp.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Contacts, 1)
+		assert.Equal(t, contact.ID.String(), resBodyRaw.Contacts[0].ID)
+		assert.Equal(t, "Jane Doe", resBodyRaw.Contacts[0].Name)
+		assert.Equal(t, "Technical Recruiter", resBodyRaw.Contacts[0].Role)
+	})
+
+	t.Run("valid request - unlink", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/job-applications/%v/contacts/%v", evilCorp.ID, contact.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationContactsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, resBodyRaw.Data)
+
+		jobApplications, _ := queries.GetContactJobApplications(ctx, db.GetContactJobApplicationsParams{
+			ContactID: contact.ID,
+			UserID:    user.ID,
+		})
+
+		assert.Len(t, jobApplications, 1, "contact should stay linked to the other job application")
+	})
+
+	t.Run("non-existing contact", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v/contacts/f4d15edc-e780-42b5-957d-c4352401d9ca", evilCorp.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("non-existing job application", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/f4d15edc-e780-42b5-957d-c4352401d9ca/contacts/%v", contact.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("non-existing link", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/job-applications/%v/contacts/%v", evilCorp.ID, contact.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
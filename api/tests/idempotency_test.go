@@ -0,0 +1,224 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/idempotency"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func newIdempotentRequest(method, url, key string, reqBody any) *http.Request {
+	reqBodyRaw, _ := json.Marshal(reqBody)
+
+	req, _ := http.NewRequest(method, url, strings.NewReader(string(reqBodyRaw)))
+	req.Header.Add("Authorization", "Bearer "+token)
+	if key != "" {
+		req.Header.Add("Idempotency-Key", key)
+	}
+
+	return req
+}
+
+func TestIdempotency(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	minSalary, maxSalary := 50_000.00, 70_000.00
+
+	reqBody := models.NewCreateJobApplicationReqBody("", "Evil Corp Inc.", "Software Engineer", time.Now().Add(time.Hour*-1).Format(time.DateOnly), stages[0].ID.String(), &minSalary, &maxSalary, "", "", "", "", "", "")
+
+	var jobApplicationId string
+
+	t.Run("original request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newIdempotentRequest("POST", "/api/job-applications", "a1b2c3", reqBody))
+
+		var resBodyRaw models.CreateJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+
+		jobApplicationId = resBodyRaw.ID
+	})
+
+	t.Run("retried request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newIdempotentRequest("POST", "/api/job-applications", "a1b2c3", reqBody))
+
+		var resBodyRaw models.CreateJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, jobApplicationId, resBodyRaw.ID)
+
+		jobApplications, _ := queries.GetJobApplications(ctx, db.GetJobApplicationsParams{UserID: user.ID, Limit: 100})
+		assert.Len(t, jobApplications, 1)
+	})
+
+	t.Run("same key for a different request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		otherReqBody := models.NewCreateJobApplicationReqBody("", "Apple", "Frontend Developer", time.Now().Add(time.Hour*-1).Format(time.DateOnly), stages[0].ID.String(), &minSalary, &maxSalary, "", "", "", "", "", "")
+
+		r.ServeHTTP(w, newIdempotentRequest("POST", "/api/job-applications", "a1b2c3", otherReqBody))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		jobApplications, _ := queries.GetJobApplications(ctx, db.GetJobApplicationsParams{UserID: user.ID, Limit: 100})
+		assert.Len(t, jobApplications, 1)
+	})
+
+	t.Run("request still being processed", func(t *testing.T) {
+		queries.CreateIdempotencyKey(ctx, db.CreateIdempotencyKeyParams{
+			UserID:      user.ID,
+			Key:         "d4e5f6",
+			RequestHash: "pending",
+			ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+		})
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newIdempotentRequest("POST", "/api/job-applications", "d4e5f6", reqBody))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("expired key", func(t *testing.T) {
+		queries.CreateIdempotencyKey(ctx, db.CreateIdempotencyKeyParams{
+			UserID:      user.ID,
+			Key:         "g7h8i9",
+			RequestHash: "expired",
+			ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(time.Hour * -1), Valid: true},
+		})
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newIdempotentRequest("POST", "/api/job-applications", "g7h8i9", reqBody))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		jobApplications, _ := queries.GetJobApplications(ctx, db.GetJobApplicationsParams{UserID: user.ID, Limit: 100})
+		assert.Len(t, jobApplications, 2)
+	})
+
+	t.Run("failed request is replayed", func(t *testing.T) {
+		invalidReqBody := models.NewCreateJobApplicationReqBody("", "", "", time.Now().Format(time.DateOnly), stages[0].ID.String(), nil, nil, "", "", "", "", "", "")
+
+		for range 2 {
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, newIdempotentRequest("POST", "/api/job-applications", "j0k1l2", invalidReqBody))
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		}
+	})
+
+	t.Run("without key", func(t *testing.T) {
+		for range 2 {
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, newIdempotentRequest("POST", "/api/job-applications", "", reqBody))
+
+			assert.Equal(t, http.StatusCreated, w.Code)
+		}
+
+		jobApplications, _ := queries.GetJobApplications(ctx, db.GetJobApplicationsParams{UserID: user.ID, Limit: 100})
+		assert.Len(t, jobApplications, 4)
+	})
+
+	t.Run("key too long", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newIdempotentRequest("POST", "/api/job-applications", strings.Repeat("a", 256), reqBody))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("sign up", func(t *testing.T) {
+		signUpReqBody := models.NewSignUpReqBody("John", "Doe", "john.doe@test.com", "qwerty!123456789", "qwerty!123456789")
+
+		var userId string
+
+		for i := range 2 {
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, newIdempotentRequest("POST", "/api/sign-up", "m3n4o5", signUpReqBody))
+
+			var resBodyRaw models.SignUpResBody
+			err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+			assert.NoError(t, err, "error unmarshaling response body")
+
+			assert.Equal(t, http.StatusCreated, w.Code)
+
+			if i == 0 {
+				userId = resBodyRaw.User.ID
+				assert.NotEmpty(t, resBodyRaw.Token)
+				assert.NotEmpty(t, resBodyRaw.RefreshToken)
+			} else {
+				assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+				assert.Equal(t, userId, resBodyRaw.User.ID)
+				assert.Empty(t, resBodyRaw.Token)
+				assert.Empty(t, resBodyRaw.RefreshToken)
+			}
+		}
+	})
+
+	t.Run("sign up with the same key for a different email", func(t *testing.T) {
+		signUpReqBody := models.NewSignUpReqBody("Jane", "Doe", "jane.doe@test.com", "qwerty!123456789", "qwerty!123456789")
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newIdempotentRequest("POST", "/api/sign-up", "m3n4o5", signUpReqBody))
+
+		var resBodyRaw models.SignUpResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+		assert.NotEmpty(t, resBodyRaw.Token)
+	})
+
+	t.Run("sweep", func(t *testing.T) {
+		queries.CreateIdempotencyKey(ctx, db.CreateIdempotencyKeyParams{
+			UserID:      user.ID,
+			Key:         "p6q7r8",
+			RequestHash: "expired",
+			ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(time.Hour * -1), Valid: true},
+		})
+
+		err := idempotency.NewSweeper(queries).Flush(ctx)
+
+		assert.NoError(t, err)
+
+		_, err = queries.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{UserID: user.ID, Key: "p6q7r8"})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		_, err = queries.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{UserID: user.ID, Key: "a1b2c3"})
+		assert.NoError(t, err)
+	})
+}
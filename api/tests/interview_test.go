@@ -0,0 +1,522 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func setUpJobApplication(userId pgtype.UUID, companyName, jobTitle string) db.CreateJobApplicationRow {
+	jobApplication, err := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:      userId,
+		CompanyName: companyName,
+		JobTitle:    jobTitle,
+		DateApplied: pgtype.Date{Time: time.Now().Add(time.Hour * -24), Valid: true},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return jobApplication
+}
+
+func TestInterviews(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	technical, _ := queries.CreateInterview(ctx, db.CreateInterviewParams{
+		JobApplicationID: jobApplication.ID,
+		UserID:           user.ID,
+		ScheduledAt:      pgtype.Timestamptz{Time: time.Now().Add(time.Hour * 48), Valid: true},
+		Timezone:         "Europe/Warsaw",
+		Type:             db.InterviewTypeTECHNICAL,
+		Interviewers:     []string{"Jane Doe"},
+		Outcome:          db.InterviewOutcomePENDING,
+	})
+	phone, _ := queries.CreateInterview(ctx, db.CreateInterviewParams{
+		JobApplicationID: jobApplication.ID,
+		UserID:           user.ID,
+		ScheduledAt:      pgtype.Timestamptz{Time: time.Now().Add(time.Hour * 24), Valid: true},
+		Timezone:         "UTC",
+		Type:             db.InterviewTypePHONE,
+		Interviewers:     []string{},
+		Outcome:          db.InterviewOutcomePENDING,
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications/%v/interviews", jobApplication.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.InterviewsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 2)
+		assert.Equal(t, phone.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, technical.ID.String(), resBodyRaw.Data[1].ID)
+		assert.Equal(t, "Europe/Warsaw", resBodyRaw.Data[1].Timezone)
+		assert.Equal(t, []string{"Jane Doe"}, resBodyRaw.Data[1].Interviewers)
+	})
+
+	t.Run("non-existing job application", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/f4d15edc-e780-42b5-957d-c4352401d9ca/interviews", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestInterview(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	scheduledAt := time.Date(2025, 4, 1, 8, 0, 0, 0, time.UTC)
+
+	interview, _ := queries.CreateInterview(ctx, db.CreateInterviewParams{
+		JobApplicationID: jobApplication.ID,
+		UserID:           user.ID,
+		ScheduledAt:      pgtype.Timestamptz{Time: scheduledAt, Valid: true},
+		Timezone:         "Europe/Warsaw",
+		Duration:         pgtype.Int4{Int32: 60, Valid: true},
+		Type:             db.InterviewTypeONSITE,
+		Location:         pgtype.Text{String: "Evil Corp HQ, 3rd floor", Valid: true},
+		Interviewers:     []string{"Jane Doe", "John Smith"},
+		PreparationNotes: pgtype.Text{String: "Revise system design basics", Valid: true},
+		Outcome:          db.InterviewOutcomePENDING,
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications/%v/interviews/%v", jobApplication.ID, interview.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.InterviewResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, interview.ID.String(), resBodyRaw.ID)
+		assert.True(t, scheduledAt.Equal(resBodyRaw.ScheduledAt))
+		assert.Contains(t, w.Body.String(), `"scheduledAt":"2025-04-01T10:00:00+02:00"`, "scheduled time should be rendered in the interview timezone")
+		assert.Equal(t, "Europe/Warsaw", resBodyRaw.Timezone)
+		assert.Equal(t, int32(60), resBodyRaw.Duration)
+		assert.Equal(t, db.InterviewTypeONSITE, resBodyRaw.Type)
+		assert.Equal(t, "Evil Corp HQ, 3rd floor", resBodyRaw.Location)
+		assert.Equal(t, []string{"Jane Doe", "John Smith"}, resBodyRaw.Interviewers)
+		assert.Equal(t, "Revise system design basics", resBodyRaw.PreparationNotes)
+		assert.Equal(t, db.InterviewOutcomePENDING, resBodyRaw.Outcome)
+	})
+
+	t.Run("non-existing interview", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications/%v/interviews/f4d15edc-e780-42b5-957d-c4352401d9ca", jobApplication.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCreateInterview(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			scheduledAt      = time.Now().Add(time.Hour * 24).UTC().Truncate(time.Second)
+			timezone         = "Europe/Warsaw"
+			duration         = int32(45)
+			interviewType    = db.InterviewTypeTECHNICAL
+			meetingURL       = "https://meet.example.com/abc-defg-hij"
+			interviewers     = []string{"Jane Doe"}
+			preparationNotes = "Revise system design basics"
+		)
+
+		bodyRaw := models.NewCreateInterviewReqBody(scheduledAt, timezone, duration, interviewType, "", meetingURL, interviewers, preparationNotes, "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/job-applications/%v/interviews", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateInterviewResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.ID, "missing interview id")
+		assert.True(t, scheduledAt.Equal(resBodyRaw.ScheduledAt))
+		assert.Equal(t, timezone, resBodyRaw.Timezone)
+		assert.Equal(t, duration, resBodyRaw.Duration)
+		assert.Equal(t, interviewType, resBodyRaw.Type)
+		assert.Equal(t, meetingURL, resBodyRaw.MeetingURL)
+		assert.Equal(t, interviewers, resBodyRaw.Interviewers)
+		assert.Equal(t, preparationNotes, resBodyRaw.PreparationNotes)
+		assert.Equal(t, db.InterviewOutcomePENDING, resBodyRaw.Outcome)
+	})
+
+	t.Run("valid request - default timezone", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateInterviewReqBody(time.Now().Add(time.Hour*24), "", 0, db.InterviewTypeHR, "", "", nil, "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/job-applications/%v/interviews", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateInterviewResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, "UTC", resBodyRaw.Timezone)
+		assert.Empty(t, resBodyRaw.Interviewers)
+	})
+
+	t.Run("invalid payload - missing type", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateInterviewReqBody(time.Now().Add(time.Hour*24), "", 0, "", "", "", nil, "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/job-applications/%v/interviews", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'Type' failed on the 'required' tag")
+	})
+
+	t.Run("invalid payload - incorrect timezone", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateInterviewReqBody(time.Now().Add(time.Hour*24), "Mars/Olympus_Mons", 0, db.InterviewTypePHONE, "", "", nil, "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/job-applications/%v/interviews", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'Timezone' failed on the 'timezone' tag")
+	})
+
+	t.Run("non-existing job application", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateInterviewReqBody(time.Now().Add(time.Hour*24), "", 0, db.InterviewTypePHONE, "", "", nil, "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications/f4d15edc-e780-42b5-957d-c4352401d9ca/interviews", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestUpdateInterview(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	interview, _ := queries.CreateInterview(ctx, db.CreateInterviewParams{
+		JobApplicationID: jobApplication.ID,
+		UserID:           user.ID,
+		ScheduledAt:      pgtype.Timestamptz{Time: time.Now().Add(time.Hour * 24), Valid: true},
+		Timezone:         "UTC",
+		Type:             db.InterviewTypePHONE,
+		Location:         pgtype.Text{String: "Phone call", Valid: true},
+		Interviewers:     []string{"Jane Doe"},
+		Outcome:          db.InterviewOutcomePENDING,
+	})
+
+	t.Run("valid request - recording outcome", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		outcome := db.InterviewOutcomePASSED
+
+		bodyRaw := models.NewUpdateInterviewReqBody(nil, "", nil, nil, "", "", []string{"Jane Doe", "John Smith"}, "", &outcome)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v/interviews/%v", jobApplication.ID, interview.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpdateInterviewResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, interview.ID.String(), resBodyRaw.ID)
+		assert.Equal(t, db.InterviewOutcomePASSED, resBodyRaw.Outcome)
+		assert.Equal(t, []string{"Jane Doe", "John Smith"}, resBodyRaw.Interviewers)
+		assert.Equal(t, db.InterviewTypePHONE, resBodyRaw.Type)
+		assert.Equal(t, "Phone call", resBodyRaw.Location)
+	})
+
+	t.Run("valid request - rescheduling", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		scheduledAt := time.Now().Add(time.Hour * 72).UTC().Truncate(time.Second)
+
+		bodyRaw := models.NewUpdateInterviewReqBody(&scheduledAt, "America/New_York", nil, nil, "", "", nil, "", nil)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v/interviews/%v", jobApplication.ID, interview.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpdateInterviewResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.True(t, scheduledAt.Equal(resBodyRaw.ScheduledAt))
+		assert.Equal(t, "America/New_York", resBodyRaw.Timezone)
+		assert.Equal(t, []string{"Jane Doe", "John Smith"}, resBodyRaw.Interviewers)
+	})
+
+	t.Run("non-existing interview", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewUpdateInterviewReqBody(nil, "", nil, nil, "", "", nil, "", nil)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v/interviews/f4d15edc-e780-42b5-957d-c4352401d9ca", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteInterview(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	interview, _ := queries.CreateInterview(ctx, db.CreateInterviewParams{
+		JobApplicationID: jobApplication.ID,
+		UserID:           user.ID,
+		ScheduledAt:      pgtype.Timestamptz{Time: time.Now().Add(time.Hour * 24), Valid: true},
+		Timezone:         "UTC",
+		Type:             db.InterviewTypeHR,
+		Interviewers:     []string{},
+		Outcome:          db.InterviewOutcomePENDING,
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/job-applications/%v/interviews/%v", jobApplication.ID, interview.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.DeleteInterviewResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, interview.ID.String(), resBodyRaw.ID)
+
+		_, err = queries.GetInterview(ctx, db.GetInterviewParams{
+			ID:               interview.ID,
+			JobApplicationID: jobApplication.ID,
+			UserID:           user.ID,
+		})
+
+		assert.Error(t, err, "interview should be deleted")
+	})
+
+	t.Run("non-existing interview", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/job-applications/%v/interviews/%v", jobApplication.ID, interview.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestUpcomingInterviews(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	evilCorp := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	apple := setUpJobApplication(user.ID, "Apple", "iOS Developer")
+
+	now := time.Now()
+
+	past, _ := queries.CreateInterview(ctx, db.CreateInterviewParams{
+		JobApplicationID: evilCorp.ID,
+		UserID:           user.ID,
+		ScheduledAt:      pgtype.Timestamptz{Time: now.Add(time.Hour * -24), Valid: true},
+		Timezone:         "UTC",
+		Type:             db.InterviewTypePHONE,
+		Interviewers:     []string{},
+		Outcome:          db.InterviewOutcomePASSED,
+	})
+	tomorrow, _ := queries.CreateInterview(ctx, db.CreateInterviewParams{
+		JobApplicationID: apple.ID,
+		UserID:           user.ID,
+		ScheduledAt:      pgtype.Timestamptz{Time: now.Add(time.Hour * 24), Valid: true},
+		Timezone:         "UTC",
+		Type:             db.InterviewTypeHR,
+		Interviewers:     []string{},
+		Outcome:          db.InterviewOutcomePENDING,
+	})
+	nextWeek, _ := queries.CreateInterview(ctx, db.CreateInterviewParams{
+		JobApplicationID: evilCorp.ID,
+		UserID:           user.ID,
+		ScheduledAt:      pgtype.Timestamptz{Time: now.Add(time.Hour * 24 * 7), Valid: true},
+		Timezone:         "UTC",
+		Type:             db.InterviewTypeTECHNICAL,
+		Interviewers:     []string{},
+		Outcome:          db.InterviewOutcomePENDING,
+	})
+
+	t.Run("valid request - default range", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/interviews", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpcomingInterviewsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 2)
+		assert.Equal(t, tomorrow.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, apple.ID.String(), resBodyRaw.Data[0].JobApplicationID)
+		assert.Equal(t, apple.CompanyName, resBodyRaw.Data[0].CompanyName)
+		assert.Equal(t, nextWeek.ID.String(), resBodyRaw.Data[1].ID)
+		assert.Equal(t, evilCorp.JobTitle, resBodyRaw.Data[1].JobTitle)
+	})
+
+	t.Run("valid request - custom range", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		query := url.Values{}
+		query.Set("from", now.Add(time.Hour*-48).Format(time.RFC3339))
+		query.Set("to", now.Add(time.Hour*48).Format(time.RFC3339))
+
+		req, _ := http.NewRequest("GET", "/api/interviews?"+query.Encode(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpcomingInterviewsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 2)
+		assert.Equal(t, past.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, tomorrow.ID.String(), resBodyRaw.Data[1].ID)
+	})
+
+	t.Run("invalid query params - incorrect from", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/interviews?from=yesterday", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,217 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBulkRequest(reqBody models.BulkJobApplicationsReqBody) *http.Request {
+	reqBodyRaw, _ := json.Marshal(reqBody)
+
+	req, _ := http.NewRequest("POST", "/api/job-applications/bulk", strings.NewReader(string(reqBodyRaw)))
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func TestBulkJobApplications(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	remote := setUpTag(user.ID, "remote", "")
+	dreamJob := setUpTag(user.ID, "dream job", "")
+
+	evilCorp := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	apple := setUpJobApplication(user.ID, "Apple", "Frontend Developer")
+	google := setUpJobApplication(user.ID, "Google", "Backend Developer")
+
+	setUpJobApplicationTag(user.ID, apple.ID, dreamJob.ID)
+
+	t.Run("update stage by ids", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newBulkRequest(models.NewBulkJobApplicationsReqBody([]string{evilCorp.ID.String(), apple.ID.String(), evilCorp.ID.String()}, nil, models.BulkUpdate, stages[1].ID.String(), nil, nil, nil)))
+
+		var resBodyRaw models.BulkJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, models.BulkUpdate, resBodyRaw.Action)
+		assert.Equal(t, 2, resBodyRaw.Total)
+		assert.Equal(t, 0, resBodyRaw.Failed)
+		assert.Equal(t, 2, resBodyRaw.Applied)
+		assert.Equal(t, evilCorp.ID.String(), resBodyRaw.Items[0].ID)
+		assert.Equal(t, apple.ID.String(), resBodyRaw.Items[1].ID)
+		assert.Empty(t, resBodyRaw.Items[0].Error)
+
+		jobApplication, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{ID: evilCorp.ID, UserID: user.ID})
+		assert.Equal(t, stages[1].ID, jobApplication.StageID)
+		assert.Equal(t, "Evil Corp Inc.", jobApplication.CompanyName)
+
+		jobApplication, _ = queries.GetJobApplication(ctx, db.GetJobApplicationParams{ID: google.ID, UserID: user.ID})
+		assert.Equal(t, stages[0].ID, jobApplication.StageID)
+	})
+
+	t.Run("update reply status by filter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		isReplied := true
+		filter := "company_name_or_job_title=developer"
+
+		r.ServeHTTP(w, newBulkRequest(models.NewBulkJobApplicationsReqBody(nil, &filter, models.BulkUpdate, "", &isReplied, nil, nil)))
+
+		var resBodyRaw models.BulkJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 2, resBodyRaw.Applied)
+
+		jobApplication, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{ID: google.ID, UserID: user.ID})
+		assert.True(t, jobApplication.IsReplied)
+
+		jobApplication, _ = queries.GetJobApplication(ctx, db.GetJobApplicationParams{ID: evilCorp.ID, UserID: user.ID})
+		assert.False(t, jobApplication.IsReplied)
+	})
+
+	t.Run("add and remove tags", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newBulkRequest(models.NewBulkJobApplicationsReqBody([]string{evilCorp.ID.String(), apple.ID.String()}, nil, models.BulkUpdate, "", nil, []string{remote.ID.String()}, []string{dreamJob.ID.String()})))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		tags, _ := queries.GetJobApplicationTags(ctx, db.GetJobApplicationTagsParams{
+			JobApplicationIds: []pgtype.UUID{evilCorp.ID, apple.ID},
+			UserID:            user.ID,
+		})
+
+		assert.Len(t, tags, 2)
+		for _, tag := range tags {
+			assert.Equal(t, "remote", tag.Name)
+		}
+	})
+
+	t.Run("unknown job application rolls back every change", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		unknown := "f4d15edc-e780-42b5-957d-c4352401d9ca"
+
+		r.ServeHTTP(w, newBulkRequest(models.NewBulkJobApplicationsReqBody([]string{google.ID.String(), unknown}, nil, models.BulkUpdate, stages[2].ID.String(), nil, nil, nil)))
+
+		var resBodyRaw models.BulkJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.Equal(t, 2, resBodyRaw.Total)
+		assert.Equal(t, 1, resBodyRaw.Failed)
+		assert.Equal(t, 0, resBodyRaw.Applied)
+		assert.Empty(t, resBodyRaw.Items[0].Error)
+		assert.Equal(t, unknown, resBodyRaw.Items[1].ID)
+		assert.Equal(t, "job application not found", resBodyRaw.Items[1].Error)
+
+		jobApplication, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{ID: google.ID, UserID: user.ID})
+		assert.Equal(t, stages[0].ID, jobApplication.StageID)
+	})
+
+	t.Run("delete moves to the trash", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newBulkRequest(models.NewBulkJobApplicationsReqBody([]string{evilCorp.ID.String(), google.ID.String()}, nil, models.BulkDelete, "", nil, nil, nil)))
+
+		var resBodyRaw models.BulkJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, models.BulkDelete, resBodyRaw.Action)
+		assert.Equal(t, 2, resBodyRaw.Applied)
+
+		jobApplications, _ := queries.GetJobApplications(ctx, db.GetJobApplicationsParams{UserID: user.ID, Limit: 100})
+		assert.Len(t, jobApplications, 1)
+		assert.Equal(t, "Apple", jobApplications[0].CompanyName)
+
+		trash, _ := queries.GetTrashedJobApplications(ctx, user.ID)
+		assert.Len(t, trash, 2)
+	})
+
+	t.Run("delete already trashed job application", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newBulkRequest(models.NewBulkJobApplicationsReqBody([]string{apple.ID.String(), evilCorp.ID.String()}, nil, models.BulkDelete, "", nil, nil, nil)))
+
+		var resBodyRaw models.BulkJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.Equal(t, "job application not found", resBodyRaw.Items[1].Error)
+
+		_, err = queries.GetJobApplication(ctx, db.GetJobApplicationParams{ID: apple.ID, UserID: user.ID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("stage not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newBulkRequest(models.NewBulkJobApplicationsReqBody([]string{apple.ID.String()}, nil, models.BulkUpdate, "f4d15edc-e780-42b5-957d-c4352401d9ca", nil, nil, nil)))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("tag not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newBulkRequest(models.NewBulkJobApplicationsReqBody([]string{apple.ID.String()}, nil, models.BulkUpdate, "", nil, nil, []string{"f4d15edc-e780-42b5-957d-c4352401d9ca"})))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		filter := "outcome=NEUTRAL"
+		invalidFilter := "outcome=UNKNOWN"
+		isReplied := true
+
+		for _, reqBody := range []models.BulkJobApplicationsReqBody{
+			models.NewBulkJobApplicationsReqBody(nil, nil, models.BulkDelete, "", nil, nil, nil),
+			models.NewBulkJobApplicationsReqBody([]string{}, nil, models.BulkDelete, "", nil, nil, nil),
+			models.NewBulkJobApplicationsReqBody([]string{apple.ID.String()}, &filter, models.BulkDelete, "", nil, nil, nil),
+			models.NewBulkJobApplicationsReqBody([]string{"apple"}, nil, models.BulkDelete, "", nil, nil, nil),
+			models.NewBulkJobApplicationsReqBody(nil, &invalidFilter, models.BulkDelete, "", nil, nil, nil),
+			models.NewBulkJobApplicationsReqBody([]string{apple.ID.String()}, nil, "archive", "", nil, nil, nil),
+			models.NewBulkJobApplicationsReqBody([]string{apple.ID.String()}, nil, models.BulkUpdate, "", nil, nil, nil),
+			models.NewBulkJobApplicationsReqBody([]string{apple.ID.String()}, nil, models.BulkDelete, "", &isReplied, nil, nil),
+		} {
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, newBulkRequest(reqBody))
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		}
+	})
+}
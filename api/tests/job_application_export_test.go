@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportJobApplications(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	evilCorp, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:      user.ID,
+		CompanyName: "Evil Corp Inc.",
+		JobTitle:    "Software Engineer",
+		DateApplied: pgtype.Date{Time: time.Date(2025, 3, 14, 12, 34, 56, 0, time.UTC), Valid: true},
+		MinSalary:   pgtype.Float8{Float64: 50000, Valid: true},
+		MaxSalary:   pgtype.Float8{Float64: 1000000, Valid: true},
+		Notes:       pgtype.Text{String: "Follow up in two weeks, \"ASAP\"", Valid: true},
+	})
+
+	remote := setUpTag(user.ID, "remote", "")
+	setUpJobApplicationTag(user.ID, evilCorp.ID, remote.ID)
+
+	// NOTE: More job applications than fit on a single page
+	for i := range 11 {
+		setUpJobApplication(user.ID, "Apple", fmt.Sprintf("Frontend Developer %d", i))
+	}
+
+	t.Run("valid request - csv", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/export?sort=-company_name", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), ".csv")
+
+		records, err := csv.NewReader(w.Body).ReadAll()
+
+		assert.NoError(t, err, "error parsing response body")
+
+		assert.Len(t, records, 13)
+		assert.Equal(t, models.ExportJobApplicationsColumns, records[0])
+		assert.Equal(t, []string{
+			evilCorp.ID.String(),
+			evilCorp.CompanyID.String(),
+			"Evil Corp Inc.",
+			"Software Engineer",
+			"2025-03-14",
+			evilCorp.StageName,
+			"NEUTRAL",
+			"false",
+			"50000",
+			"1000000",
+			"",
+			"Follow up in two weeks, \"ASAP\"",
+			"remote",
+		}, records[1])
+	})
+
+	t.Run("valid request - json with filters", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/export?format=json&company_name_or_job_title=evil", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw []models.ExportJobApplicationEntry
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw, 1)
+		assert.Equal(t, "Follow up in two weeks, \"ASAP\"", resBodyRaw[0].Notes)
+		assert.Equal(t, []string{"remote"}, resBodyRaw[0].Tags)
+	})
+
+	t.Run("valid request - json with no matches", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/export?format=json&company_name_or_job_title=microsoft", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "[]", w.Body.String())
+	})
+
+	t.Run("valid request - xlsx", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/export?format=xlsx", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		body := w.Body.Bytes()
+
+		z, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+
+		assert.NoError(t, err, "error reading xlsx file")
+
+		var sheet string
+		for _, f := range z.File {
+			if f.Name == "xl/worksheets/sheet1.xml" {
+				rc, _ := f.Open()
+				raw, _ := io.ReadAll(rc)
+				sheet = string(raw)
+			}
+		}
+
+		assert.Equal(t, 13, strings.Count(sheet, "<row>"))
+		assert.Contains(t, sheet, "Evil Corp Inc.")
+		assert.Contains(t, sheet, "<c><v>1000000</v></c>")
+		assert.Contains(t, sheet, "Follow up in two weeks, &#34;ASAP&#34;")
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/export?format=pdf", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
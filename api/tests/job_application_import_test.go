@@ -0,0 +1,299 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newImportRequest(url, csv, mapping string) *http.Request {
+	var body bytes.Buffer
+
+	w := multipart.NewWriter(&body)
+
+	file, _ := w.CreateFormFile("file", "job-applications.csv")
+	file.Write([]byte(csv))
+
+	if mapping != "" {
+		w.WriteField("mapping", mapping)
+	}
+
+	w.Close()
+
+	req, _ := http.NewRequest("POST", url, &body)
+	req.Header.Add("Content-Type", w.FormDataContentType())
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func countJobApplications(user db.GetUserByEmailRow) int {
+	jobApplications, _ := queries.GetJobApplications(ctx, db.GetJobApplicationsParams{
+		UserID: user.ID,
+		Limit:  100,
+	})
+	return len(jobApplications)
+}
+
+func TestImportJobApplications(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	validCSV := "companyName,jobTitle,dateApplied,minSalary,maxSalary\n" +
+		"Evil Corp Inc.,Software Engineer,2025-03-14,\"50,000\",70000\n" +
+		"Apple,Frontend Developer,2025-03-15T12:34:56Z,,\n" +
+		"evil corp,Senior Software Engineer,2025-03-16,,\n"
+
+	t.Run("valid request - dry run", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newImportRequest("/api/job-applications/import?dryRun=true", validCSV, ""))
+
+		var resBodyRaw models.ImportJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.True(t, resBodyRaw.DryRun)
+		assert.Equal(t, 3, resBodyRaw.Total)
+		assert.Equal(t, 3, resBodyRaw.Valid)
+		assert.Equal(t, 0, resBodyRaw.Imported)
+		assert.Equal(t, 2, resBodyRaw.Rows[0].Row)
+		assert.Empty(t, resBodyRaw.Rows[0].ID)
+
+		assert.Equal(t, 0, countJobApplications(user), "dry run should not save anything")
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newImportRequest("/api/job-applications/import", validCSV, ""))
+
+		var resBodyRaw models.ImportJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.False(t, resBodyRaw.DryRun)
+		assert.Equal(t, 3, resBodyRaw.Imported)
+		for _, row := range resBodyRaw.Rows {
+			assert.NotEmpty(t, row.ID)
+			assert.Empty(t, row.Errors)
+		}
+
+		assert.Equal(t, 3, countJobApplications(user))
+
+		companies, _ := queries.GetCompanies(ctx, db.GetCompaniesParams{
+			UserID: user.ID,
+			Limit:  10,
+		})
+
+		assert.Len(t, companies, 2, "rows should be matched against companies created earlier in the same import")
+	})
+
+	t.Run("valid request - mapping", func(t *testing.T) {
+		queries.Purge(ctx)
+
+		setUpUser(ctx)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		// NOTE: Excel prepends a byte order mark to UTF-8 exports
+		csv := "\ufeffCompany,Position,Applied on,Comments\n" +
+			"Evil Corp Inc.,Software Engineer,2025-03-14,Referred by Jane\n"
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newImportRequest("/api/job-applications/import", csv, `{"companyName": "Company", "jobTitle": "Position", "dateApplied": "Applied on", "notes": "Comments"}`))
+
+		var resBodyRaw models.ImportJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 1, resBodyRaw.Imported)
+
+		jobApplicationId, _ := utils.ToUUID(resBodyRaw.Rows[0].ID)
+
+		jobApplication, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{
+			ID:     jobApplicationId,
+			UserID: user.ID,
+		})
+
+		assert.Equal(t, "Evil Corp Inc.", jobApplication.CompanyName)
+		assert.Equal(t, "Software Engineer", jobApplication.JobTitle)
+		assert.Equal(t, "Referred by Jane", jobApplication.Notes.String)
+	})
+
+	t.Run("invalid rows", func(t *testing.T) {
+		queries.Purge(ctx)
+
+		setUpUser(ctx)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		csv := "companyName,jobTitle,dateApplied,minSalary,stageId\n" +
+			"Evil Corp Inc.,Software Engineer,2025-03-14,,\n" +
+			"Apple,,2025-03-15,,\n" +
+			"Microsoft,Backend Developer,yesterday,lots,\n" +
+			"Google,Go Developer,2025-03-16,,f4d15edc-e780-42b5-957d-c4352401d9ca\n"
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newImportRequest("/api/job-applications/import", csv, ""))
+
+		var resBodyRaw models.ImportJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.Equal(t, 4, resBodyRaw.Total)
+		assert.Equal(t, 1, resBodyRaw.Valid)
+		assert.Equal(t, 0, resBodyRaw.Imported)
+		assert.Empty(t, resBodyRaw.Rows[0].Errors)
+		assert.Empty(t, resBodyRaw.Rows[0].ID)
+		assert.Equal(t, 3, resBodyRaw.Rows[1].Row)
+		assert.Contains(t, resBodyRaw.Rows[1].Errors[0], "JobTitle")
+		assert.Contains(t, resBodyRaw.Rows[2].Errors[0], "dateApplied")
+		assert.Contains(t, resBodyRaw.Rows[2].Errors[1], "minSalary")
+		assert.Equal(t, []string{"stage doesn't exist"}, resBodyRaw.Rows[3].Errors)
+
+		assert.Equal(t, 0, countJobApplications(user), "no row should be saved when any of them is invalid")
+	})
+
+	t.Run("invalid rows - salary not in the format of the locale", func(t *testing.T) {
+		csv := "companyName,jobTitle,dateApplied,minSalary,maxSalary\n" +
+			"Evil Corp Inc.,Software Engineer,2025-03-14,\"7500,50\",Inf\n"
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newImportRequest("/api/job-applications/import", csv, ""))
+
+		var resBodyRaw models.ImportJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.Len(t, resBodyRaw.Rows[0].Errors, 2)
+		assert.Contains(t, resBodyRaw.Rows[0].Errors[0], "minSalary", "a decimal comma shouldn't be taken for a thousands separator")
+		assert.Contains(t, resBodyRaw.Rows[0].Errors[1], "maxSalary")
+	})
+
+	t.Run("valid request - salary in the format of the locale", func(t *testing.T) {
+		queries.Purge(ctx)
+
+		setUpUser(ctx)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		queries.UpdateUserPreferences(ctx, db.UpdateUserPreferencesParams{
+			ID:        user.ID,
+			Timezone:  "Europe/Warsaw",
+			Locale:    "pl-PL",
+			WeekStart: db.WeekStartMONDAY,
+			Currency:  "PLN",
+		})
+
+		csv := "companyName,jobTitle,dateApplied,minSalary,maxSalary\n" +
+			"Evil Corp Inc.,Software Engineer,2025-03-14,\"7500,50\",\"9\u00a0000\"\n"
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newImportRequest("/api/job-applications/import", csv, ""))
+
+		var resBodyRaw models.ImportJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		jobApplicationId, _ := utils.ToUUID(resBodyRaw.Rows[0].ID)
+
+		jobApplication, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{
+			ID:     jobApplicationId,
+			UserID: user.ID,
+		})
+
+		assert.Equal(t, 7500.50, jobApplication.MinSalary.Float64)
+		assert.Equal(t, 9000.00, jobApplication.MaxSalary.Float64)
+	})
+
+	t.Run("valid request - no salary", func(t *testing.T) {
+		csv := "companyName,jobTitle,dateApplied,minSalary,maxSalary\n" +
+			"Evil Corp Inc.,Backend Developer,2025-03-14,,\n"
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newImportRequest("/api/job-applications/import", csv, ""))
+
+		var resBodyRaw models.ImportJobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		jobApplicationId, _ := utils.ToUUID(resBodyRaw.Rows[0].ID)
+
+		jobApplication, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{
+			ID:     jobApplicationId,
+			UserID: user.ID,
+		})
+
+		assert.False(t, jobApplication.MinSalary.Valid)
+		assert.False(t, jobApplication.MaxSalary.Valid)
+	})
+
+	t.Run("unknown mapping field", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newImportRequest("/api/job-applications/import", validCSV, `{"salary": "minSalary"}`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("missing mapped column", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newImportRequest("/api/job-applications/import", validCSV, `{"jobTitle": "Position"}`))
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, `column "Position" not found in the header`, resBodyRaw.Error)
+	})
+
+	t.Run("no rows", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newImportRequest("/api/job-applications/import", "companyName,jobTitle,dateApplied\n", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -23,12 +23,14 @@ func TestJobApplications(t *testing.T) {
 
 	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
 
+	stages, _ := queries.GetStages(ctx, user.ID)
+
 	softwareEngineer, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
 		UserID:        user.ID,
 		CompanyName:   "Evil Corp Inc.",
 		JobTitle:      "Software Engineer",
-		DateApplied:   pgtype.Timestamptz{Time: time.Now().Add(time.Hour * -24 * 2), Valid: true},
-		Status:        db.StatusINPROGRESS,
+		DateApplied:   pgtype.Date{Time: time.Now().Add(time.Hour * -24 * 2), Valid: true},
+		StageID:       stages[0].ID,
 		MinSalary:     pgtype.Float8{Float64: 50_000.00, Valid: true},
 		MaxSalary:     pgtype.Float8{Float64: 70_000.00, Valid: true},
 		JobPostingUrl: pgtype.Text{String: "https://glassbore.com/jobs/swe420692137", Valid: true},
@@ -37,8 +39,8 @@ func TestJobApplications(t *testing.T) {
 		UserID:        user.ID,
 		CompanyName:   "Apple",
 		JobTitle:      "iOS Developer",
-		DateApplied:   pgtype.Timestamptz{Time: time.Now().Add(time.Hour * -24), Valid: true},
-		Status:        db.StatusREJECTED,
+		DateApplied:   pgtype.Date{Time: time.Now().Add(time.Hour * -24), Valid: true},
+		StageID:       stages[1].ID,
 		MinSalary:     pgtype.Float8{Float64: 100_000.00, Valid: true},
 		MaxSalary:     pgtype.Float8{Float64: 125_000.00, Valid: true},
 		JobPostingUrl: pgtype.Text{String: "https://glassbore.com/jobs/ios420692137", Valid: true},
@@ -47,8 +49,8 @@ func TestJobApplications(t *testing.T) {
 		UserID:        user.ID,
 		CompanyName:   "Google",
 		JobTitle:      "Angular Developer",
-		DateApplied:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
-		Status:        db.StatusACCEPTED,
+		DateApplied:   pgtype.Date{Time: time.Now(), Valid: true},
+		StageID:       stages[2].ID,
 		MinSalary:     pgtype.Float8{Float64: 70_000.00, Valid: true},
 		MaxSalary:     pgtype.Float8{Float64: 90_000.00, Valid: true},
 		JobPostingUrl: pgtype.Text{String: "https://glassbore.com/jobs/fe420692137", Valid: true},
@@ -78,8 +80,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -89,8 +91,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -100,8 +102,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
@@ -174,8 +176,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -185,8 +187,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -196,8 +198,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
@@ -228,8 +230,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -239,8 +241,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -250,8 +252,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
@@ -282,8 +284,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -293,8 +295,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -304,8 +306,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
@@ -336,8 +338,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -347,8 +349,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -358,8 +360,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
@@ -390,8 +392,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -401,8 +403,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -412,8 +414,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
@@ -444,8 +446,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -455,8 +457,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -466,18 +468,18 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
 		assert.Equal(t, softwareEngineer.JobPostingUrl.String, resBodyRaw.Data[2].JobPostingURL)
 	})
 
-	t.Run("valid request - sort ascending by status", func(t *testing.T) {
+	t.Run("valid request - sort ascending by stage", func(t *testing.T) {
 		w := httptest.NewRecorder()
 
-		req, _ := http.NewRequest("GET", "/api/job-applications?sort=status", nil)
+		req, _ := http.NewRequest("GET", "/api/job-applications?sort=stage", nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -498,8 +500,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -509,8 +511,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -520,18 +522,18 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
 		assert.Equal(t, angularDeveloper.JobPostingUrl.String, resBodyRaw.Data[2].JobPostingURL)
 	})
 
-	t.Run("valid request - sort descending by status", func(t *testing.T) {
+	t.Run("valid request - sort descending by stage", func(t *testing.T) {
 		w := httptest.NewRecorder()
 
-		req, _ := http.NewRequest("GET", "/api/job-applications?sort=-status", nil)
+		req, _ := http.NewRequest("GET", "/api/job-applications?sort=-stage", nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -552,8 +554,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -563,8 +565,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -574,8 +576,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
@@ -606,8 +608,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -617,8 +619,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -628,8 +630,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
@@ -660,8 +662,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -671,8 +673,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -682,8 +684,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[2].ID)
 		assert.Equal(t, softwareEngineer.CompanyName, resBodyRaw.Data[2].CompanyName)
 		assert.Equal(t, softwareEngineer.JobTitle, resBodyRaw.Data[2].JobTitle)
-		assert.Equal(t, softwareEngineer.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[2].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, softwareEngineer.Status, resBodyRaw.Data[2].Status)
+		assert.Equal(t, softwareEngineer.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[2].DateApplied)
+		assert.Equal(t, softwareEngineer.StageID.String(), resBodyRaw.Data[2].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[2].IsReplied)
 		assert.Equal(t, softwareEngineer.MinSalary.Float64, resBodyRaw.Data[2].MinSalary)
 		assert.Equal(t, softwareEngineer.MaxSalary.Float64, resBodyRaw.Data[2].MaxSalary)
@@ -716,8 +718,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -748,8 +750,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, angularDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, angularDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, angularDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, angularDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, angularDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, angularDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, angularDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, angularDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
@@ -759,8 +761,8 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[1].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[1].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[1].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[1].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[1].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[1].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[1].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[1].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[1].MaxSalary)
@@ -793,18 +795,18 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
 		assert.Equal(t, iOSDeveloper.JobPostingUrl.String, resBodyRaw.Data[0].JobPostingURL)
 	})
 
-	t.Run("valid request - filter by status", func(t *testing.T) {
+	t.Run("valid request - filter by stage", func(t *testing.T) {
 		w := httptest.NewRecorder()
 
-		req, _ := http.NewRequest("GET", "/api/job-applications?status=REJECTED", nil)
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications?stage_id=%v", stages[1].ID), nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -825,400 +827,1210 @@ func TestJobApplications(t *testing.T) {
 		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[0].ID)
 		assert.Equal(t, iOSDeveloper.CompanyName, resBodyRaw.Data[0].CompanyName)
 		assert.Equal(t, iOSDeveloper.JobTitle, resBodyRaw.Data[0].JobTitle)
-		assert.Equal(t, iOSDeveloper.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.Data[0].DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, iOSDeveloper.Status, resBodyRaw.Data[0].Status)
+		assert.Equal(t, iOSDeveloper.DateApplied.Time.Format(time.DateOnly), resBodyRaw.Data[0].DateApplied)
+		assert.Equal(t, iOSDeveloper.StageID.String(), resBodyRaw.Data[0].Stage.ID)
 		assert.Equal(t, false, resBodyRaw.Data[0].IsReplied)
 		assert.Equal(t, iOSDeveloper.MinSalary.Float64, resBodyRaw.Data[0].MinSalary)
 		assert.Equal(t, iOSDeveloper.MaxSalary.Float64, resBodyRaw.Data[0].MaxSalary)
 		assert.Equal(t, iOSDeveloper.JobPostingUrl.String, resBodyRaw.Data[0].JobPostingURL)
 	})
-}
-
-func TestJobApplication(t *testing.T) {
-	queries.Purge(ctx)
-
-	setUpUser(ctx)
-
-	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
-
-	var (
-		companyName   = "Evil Corp Inc."
-		jobTitle      = "Software Engineer"
-		dateApplied   = time.Now().Add(time.Hour * -1)
-		status        = db.StatusINPROGRESS
-		isReplied     = false
-		minSalary     = 50_000.00
-		maxSalary     = 70_000.00
-		jobPostingURL = "https://glassbore.com/jobs/swe420692137"
-		notes         = "Follow up in two weeks"
-	)
-
-	jobApplication, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
-		UserID:        user.ID,
-		CompanyName:   companyName,
-		JobTitle:      jobTitle,
-		DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-		Status:        status,
-		MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
-		MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
-		JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
-		Notes:         pgtype.Text{String: notes, Valid: true},
-	})
 
-	t.Run("valid request", func(t *testing.T) {
+	t.Run("valid request - filter by stage outcome", func(t *testing.T) {
 		w := httptest.NewRecorder()
 
-		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), nil)
+		req, _ := http.NewRequest("GET", "/api/job-applications?outcome=POSITIVE", nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.JobApplicationResBody
+		var resBodyRaw models.JobApplicationsResBody
 		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
 		assert.Equal(t, http.StatusOK, w.Code)
 
-		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
-		assert.Equal(t, companyName, resBodyRaw.CompanyName)
-		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
-		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
-		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
-		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
-		assert.Equal(t, jobPostingURL, resBodyRaw.JobPostingURL)
-		assert.Equal(t, notes, resBodyRaw.Notes)
+		assert.Equal(t, 1, resBodyRaw.Total)
+		assert.Len(t, resBodyRaw.Data, 1)
+
+		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, stages[2].Name, resBodyRaw.Data[0].Stage.Name)
+		assert.Equal(t, db.StageOutcomePOSITIVE, resBodyRaw.Data[0].Stage.Outcome)
 	})
 
-	t.Run("non-existing job application", func(t *testing.T) {
+	t.Run("valid request - filter by multiple stages", func(t *testing.T) {
 		w := httptest.NewRecorder()
 
-		req, _ := http.NewRequest("GET", "/api/job-applications/f4d15edc-e780-42b5-957d-c4352401d9ca", nil)
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications?stage_id=%v&stage_id=%v&sort=stage", stages[0].ID, stages[2].ID), nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.Error
+		var resBodyRaw models.JobApplicationsResBody
 		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusNotFound, w.Code)
-		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "no rows in result set")
-	})
-}
-
-func TestCreateJobApplication(t *testing.T) {
-	queries.Purge(ctx)
-
-	setUpUser(ctx)
+		assert.Equal(t, http.StatusOK, w.Code)
 
-	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+		assert.Equal(t, 2, resBodyRaw.Total)
+		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[1].ID)
+	})
 
-	t.Run("valid request", func(t *testing.T) {
+	t.Run("valid request - filter by date applied range", func(t *testing.T) {
 		w := httptest.NewRecorder()
 
-		var (
-			companyName   = "Evil Corp Inc."
-			jobTitle      = "Software Engineer"
-			dateApplied   = time.Now().Add(time.Hour * -1)
-			status        = db.StatusINPROGRESS
-			isReplied     = false
-			minSalary     = 50_000.00
-			maxSalary     = 70_000.00
-			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
-			notes         = "Follow up in two weeks"
-		)
-
-		bodyRaw := models.NewCreateJobApplicationReqBody(companyName, jobTitle, dateApplied, status, minSalary, maxSalary, jobPostingURL, notes)
-		bodyJSON, _ := json.Marshal(bodyRaw)
+		from := softwareEngineer.DateApplied.Time.Format("2006-01-02")
+		to := iOSDeveloper.DateApplied.Time.Format("2006-01-02")
 
-		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("GET", "/api/job-applications?date_applied_from="+from+"&date_applied_to="+to, nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.CreateJobApplicationResBody
+		var resBodyRaw models.JobApplicationsResBody
 		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusCreated, w.Code)
-
-		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
-		assert.Equal(t, companyName, resBodyRaw.CompanyName)
-		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
-		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
-		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
-		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
-		assert.Equal(t, jobPostingURL, resBodyRaw.JobPostingURL)
-		assert.Equal(t, notes, resBodyRaw.Notes)
-
-		uuid, err := utils.ToUUID(resBodyRaw.ID)
-
-		jobApplication, err := queries.GetJobApplication(ctx, db.GetJobApplicationParams{
-			ID:     uuid,
-			UserID: user.ID,
-		})
+		assert.Equal(t, http.StatusOK, w.Code)
 
-		assert.NoError(t, err, "error querying job application")
-		assert.NotEmpty(t, jobApplication.ID, "missing job application id")
-		assert.Equal(t, jobApplication.CompanyName, resBodyRaw.CompanyName)
-		assert.Equal(t, jobApplication.JobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, jobApplication.DateApplied.Time.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, jobApplication.Status, resBodyRaw.Status)
-		assert.Equal(t, jobApplication.IsReplied, resBodyRaw.IsReplied)
-		assert.Equal(t, jobApplication.MinSalary.Float64, resBodyRaw.MinSalary)
-		assert.Equal(t, jobApplication.MaxSalary.Float64, resBodyRaw.MaxSalary)
-		assert.Equal(t, jobApplication.JobPostingUrl.String, resBodyRaw.JobPostingURL)
-		assert.Equal(t, jobApplication.Notes.String, resBodyRaw.Notes)
+		assert.Equal(t, 2, resBodyRaw.Total)
+		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[1].ID)
 	})
 
-	t.Run("invalid payload - missing company name", func(t *testing.T) {
+	t.Run("valid request - filter by salary range", func(t *testing.T) {
 		w := httptest.NewRecorder()
 
-		var (
-			companyName   = ""
-			jobTitle      = "Software Engineer"
-			dateApplied   = time.Now().Add(time.Hour * -1)
-			status        = db.StatusINPROGRESS
-			minSalary     = 50_000.00
-			maxSalary     = 70_000.00
-			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
-			notes         = "Follow up in two weeks"
-		)
-
-		bodyRaw := models.NewCreateJobApplicationReqBody(companyName, jobTitle, dateApplied, status, minSalary, maxSalary, jobPostingURL, notes)
-		bodyJSON, _ := json.Marshal(bodyRaw)
-
-		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		// NOTE: Overlaps 50,000-70,000 and 70,000-90,000, but not 100,000-125,000
+		req, _ := http.NewRequest("GET", "/api/job-applications?min_salary=60000&max_salary=80000&sort=salary", nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.Error
+		var resBodyRaw models.JobApplicationsResBody
 		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, http.StatusOK, w.Code)
 
-		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "CompanyName", "required")
+		assert.Equal(t, 2, resBodyRaw.Total)
+		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[1].ID)
 	})
 
-	t.Run("invalid payload - missing job title", func(t *testing.T) {
+	t.Run("valid request - filter by salary type", func(t *testing.T) {
 		w := httptest.NewRecorder()
 
-		var (
-			companyName   = "Evil Corp Inc."
-			jobTitle      = ""
-			dateApplied   = time.Now().Add(time.Hour * -1)
-			status        = db.StatusINPROGRESS
-			minSalary     = 50_000.00
-			maxSalary     = 70_000.00
-			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
-			notes         = "Follow up in two weeks"
-		)
-
-		bodyRaw := models.NewCreateJobApplicationReqBody(companyName, jobTitle, dateApplied, status, minSalary, maxSalary, jobPostingURL, notes)
-		bodyJSON, _ := json.Marshal(bodyRaw)
-
-		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("GET", "/api/job-applications?salary_type=GROSS", nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.Error
+		var resBodyRaw models.JobApplicationsResBody
 		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusBadRequest, w.Code)
-
-		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "JobTitle", "required")
-	})
-
-	t.Run("invalid payload - missing date applied", func(t *testing.T) {
-		w := httptest.NewRecorder()
-
-		var (
-			companyName   = "Evil Corp Inc."
-			jobTitle      = "Software Engineer"
-			dateApplied   = time.Time{}
-			status        = db.StatusINPROGRESS
-			minSalary     = 50_000.00
-			maxSalary     = 70_000.00
-			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
-			notes         = "Follow up in two weeks"
-		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 3, resBodyRaw.Total)
 
-		bodyRaw := models.NewCreateJobApplicationReqBody(companyName, jobTitle, dateApplied, status, minSalary, maxSalary, jobPostingURL, notes)
-		bodyJSON, _ := json.Marshal(bodyRaw)
+		w = httptest.NewRecorder()
 
-		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req, _ = http.NewRequest("GET", "/api/job-applications?salary_type=NET", nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.Error
-		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+		err = json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusBadRequest, w.Code)
-
-		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "DateApplied", "required")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 0, resBodyRaw.Total)
 	})
 
-	t.Run("invalid payload - missing status", func(t *testing.T) {
+	t.Run("valid request - filter by is replied", func(t *testing.T) {
 		w := httptest.NewRecorder()
 
-		var (
-			companyName             = "Evil Corp Inc."
-			jobTitle                = "Software Engineer"
-			dateApplied             = time.Now().Add(time.Hour * -1)
-			status        db.Status = ""
-			minSalary               = 50_000.00
-			maxSalary               = 70_000.00
-			jobPostingURL           = "https://glassbore.com/jobs/swe420692137"
-			notes                   = "Follow up in two weeks"
-		)
-
-		bodyRaw := models.NewCreateJobApplicationReqBody(companyName, jobTitle, dateApplied, status, minSalary, maxSalary, jobPostingURL, notes)
-		bodyJSON, _ := json.Marshal(bodyRaw)
-
-		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("GET", "/api/job-applications?is_replied=false", nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.Error
+		var resBodyRaw models.JobApplicationsResBody
 		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusBadRequest, w.Code)
-
-		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "Status", "required")
-	})
-
-	t.Run("invalid payload - incorrect status", func(t *testing.T) {
-		w := httptest.NewRecorder()
-
-		var (
-			companyName             = "Evil Corp Inc."
-			jobTitle                = "Software Engineer"
-			dateApplied             = time.Now().Add(time.Hour * -1)
-			status        db.Status = "UNKNOWN"
-			minSalary               = 50_000.00
-			maxSalary               = 70_000.00
-			jobPostingURL           = "https://glassbore.com/jobs/swe420692137"
-			notes                   = "Follow up in two weeks"
-		)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 3, resBodyRaw.Total)
 
-		bodyRaw := models.NewCreateJobApplicationReqBody(companyName, jobTitle, dateApplied, status, minSalary, maxSalary, jobPostingURL, notes)
-		bodyJSON, _ := json.Marshal(bodyRaw)
+		w = httptest.NewRecorder()
 
-		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req, _ = http.NewRequest("GET", "/api/job-applications?is_replied=true", nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.Error
-		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+		err = json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusBadRequest, w.Code)
-
-		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "Status", "Field validation for 'Status' failed on the 'oneof' tag")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 0, resBodyRaw.Total)
 	})
 
-	t.Run("invalid payload - incorrect min salary", func(t *testing.T) {
+	t.Run("valid request - sort by multiple columns", func(t *testing.T) {
 		w := httptest.NewRecorder()
 
-		var (
-			companyName   = "Evil Corp Inc."
-			jobTitle      = "Software Engineer"
-			dateApplied   = time.Now().Add(time.Hour * -1)
-			status        = db.StatusINPROGRESS
-			minSalary     = -50_000.00
-			maxSalary     = 70_000.00
-			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
-			notes         = "Follow up in two weeks"
-		)
-
-		bodyRaw := models.NewCreateJobApplicationReqBody(companyName, jobTitle, dateApplied, status, minSalary, maxSalary, jobPostingURL, notes)
-		bodyJSON, _ := json.Marshal(bodyRaw)
-
-		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("GET", "/api/job-applications?sort=is_replied,-salary", nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.Error
+		var resBodyRaw models.JobApplicationsResBody
 		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, http.StatusOK, w.Code)
 
-		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "MinSalary", "Field validation for 'MinSalary' failed on the 'oneof' tag")
+		assert.Equal(t, 3, resBodyRaw.Total)
+		assert.Equal(t, iOSDeveloper.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, angularDeveloper.ID.String(), resBodyRaw.Data[1].ID)
+		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[2].ID)
 	})
 
-	t.Run("invalid payload - incorrect max salary", func(t *testing.T) {
-		w := httptest.NewRecorder()
-
-		var (
-			companyName   = "Evil Corp Inc."
-			jobTitle      = "Software Engineer"
-			dateApplied   = time.Now().Add(time.Hour * -1)
-			status        = db.StatusINPROGRESS
-			minSalary     = 50_000.00
-			maxSalary     = -70_000.00
-			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
-			notes         = "Follow up in two weeks"
-		)
+	t.Run("valid request - preferred currency without an exchange rate", func(t *testing.T) {
+		queries.UpdateUserPreferences(ctx, db.UpdateUserPreferencesParams{
+			ID:        user.ID,
+			Timezone:  "Europe/Warsaw",
+			Locale:    "en-US",
+			WeekStart: db.WeekStartMONDAY,
+			Currency:  "JPY",
+		})
+		defer queries.UpdateUserPreferences(ctx, db.UpdateUserPreferencesParams{
+			ID:        user.ID,
+			Timezone:  "Europe/Warsaw",
+			Locale:    "en-US",
+			WeekStart: db.WeekStartMONDAY,
+			Currency:  "PLN",
+		})
 
-		bodyRaw := models.NewCreateJobApplicationReqBody(companyName, jobTitle, dateApplied, status, minSalary, maxSalary, jobPostingURL, notes)
-		bodyJSON, _ := json.Marshal(bodyRaw)
+		w := httptest.NewRecorder()
 
-		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("GET", "/api/job-applications?sort=salary", nil)
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.Error
+		var resBodyRaw models.JobApplicationsResBody
 		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusBadRequest, w.Code)
-
-		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "MaxSalary", "Field validation for 'MaxSalary' failed on the 'oneof' tag")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 3, resBodyRaw.Total)
+	})
+
+	t.Run("invalid query params - currency without an exchange rate", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?salary_currency=JPY", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid query params - unknown sort", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?sort=stage,-id", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid query params - column sorted by twice", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?sort=salary,-salary", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid query params - negative salary", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?min_salary=-1", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid query params - incorrect stage id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?stage_id=UNKNOWN", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSearchJobApplications(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	softwareEngineer, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:        user.ID,
+		CompanyName:   "Evil Corp Inc.",
+		JobTitle:      "Software Engineer",
+		DateApplied:   pgtype.Date{Time: time.Now().Add(time.Hour * -24 * 2), Valid: true},
+		JobPostingUrl: pgtype.Text{String: "https://glassbore.com/jobs/swe420692137", Valid: true},
+		Notes:         pgtype.Text{String: "Recruiter mentioned Kubernetes and <script>alert(1)</script> a lot", Valid: true},
+	})
+	kubernetesEngineer, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:      user.ID,
+		CompanyName: "Google",
+		JobTitle:    "Kubernetes Engineer",
+		DateApplied: pgtype.Date{Time: time.Now().Add(time.Hour * -24), Valid: true},
+	})
+	queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:        user.ID,
+		CompanyName:   "Apple",
+		JobTitle:      "iOS Developer",
+		DateApplied:   pgtype.Date{Time: time.Now(), Valid: true},
+		JobPostingUrl: pgtype.Text{String: "https://jobs.apple.com/ios420692137", Valid: true},
+	})
+
+	t.Run("valid request - notes ranked below job title", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?q=kubernetes", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 2, resBodyRaw.Total)
+		assert.Equal(t, kubernetesEngineer.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[1].ID)
+		assert.Contains(t, resBodyRaw.Data[0].Snippet, "<mark>Kubernetes</mark>")
+		assert.Contains(t, resBodyRaw.Data[1].Snippet, "<mark>Kubernetes</mark>")
+		assert.NotContains(t, resBodyRaw.Data[1].Snippet, "<script>")
+	})
+
+	t.Run("valid request - prefix matching", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?q=Evil+Eng", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 1, resBodyRaw.Total)
+		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[0].ID)
+	})
+
+	t.Run("valid request - job posting url", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?q=glassbore", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 1, resBodyRaw.Total)
+		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[0].ID)
+	})
+
+	t.Run("valid request - explicit sort", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?q=kubernetes&sort=date_applied", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 2, resBodyRaw.Total)
+		assert.Equal(t, softwareEngineer.ID.String(), resBodyRaw.Data[0].ID)
+	})
+
+	t.Run("valid request - tsquery syntax is ignored", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?q=%27%21%28kubernetes%3A%2A%7C", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 2, resBodyRaw.Total)
+	})
+
+	t.Run("valid request - no snippet without search", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 3, resBodyRaw.Total)
+		for _, jobApplication := range resBodyRaw.Data {
+			assert.Empty(t, jobApplication.Snippet)
+		}
+	})
+}
+
+func TestCursorJobApplications(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	dateApplied := time.Now().Add(time.Hour * -24)
+
+	// NOTE: Ties and missing salaries on purpose, so that every sort has to fall back to the id
+	for i := range 7 {
+		queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+			UserID:      user.ID,
+			CompanyName: []string{"Apple", "Google", "Evil Corp Inc."}[i%3],
+			JobTitle:    []string{"Software Engineer", "Engineering Manager"}[i%2],
+			DateApplied: pgtype.Date{Time: dateApplied.Add(time.Hour * time.Duration(i%2)), Valid: true},
+			StageID:     stages[i%2].ID,
+			MinSalary:   pgtype.Float8{Float64: float64(i%3) * 50_000.00, Valid: i%3 != 0},
+		})
+	}
+
+	getJobApplications := func(url string) (int, models.JobApplicationsCursorResBody) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", url, nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsCursorResBody
+		json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		return w.Code, resBodyRaw
+	}
+
+	for _, sort := range []models.Sort{
+		models.CompanyNameAsc, models.CompanyNameDesc, models.JobTitleAsc, models.JobTitleDesc, models.DateAppliedAsc, models.DateAppliedDesc,
+		models.StageAsc, models.StageDesc, models.SalaryAsc, models.SalaryDesc, models.IsRepliedAsc, models.IsRepliedDesc, models.Relevance,
+		"stage,-salary,job_title", "-is_replied,company_name,-date_applied",
+	} {
+		t.Run("valid request - every page sorted by "+string(sort), func(t *testing.T) {
+			query := "sort=" + string(sort)
+			if sort == models.Relevance {
+				query += "&q=engineer"
+			}
+
+			w := httptest.NewRecorder()
+
+			req, _ := http.NewRequest("GET", "/api/job-applications?size=100&"+query, nil)
+			req.Header.Add("Authorization", "Bearer "+token)
+
+			r.ServeHTTP(w, req)
+
+			var resBodyRaw models.JobApplicationsResBody
+			json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+			expected := []string{}
+			for _, jobApplication := range resBodyRaw.Data {
+				expected = append(expected, jobApplication.ID)
+			}
+
+			actual := []string{}
+			cursor := ""
+			for range 7 {
+				code, page := getJobApplications("/api/job-applications?size=2&" + query + "&cursor=" + cursor)
+
+				assert.Equal(t, http.StatusOK, code)
+
+				for _, jobApplication := range page.Data {
+					actual = append(actual, jobApplication.ID)
+				}
+
+				if page.NextCursor == nil {
+					break
+				}
+				cursor = *page.NextCursor
+			}
+
+			assert.Len(t, actual, 7)
+			assert.Equal(t, expected, actual)
+		})
+	}
+
+	t.Run("valid request - new job applications don't shift the next page", func(t *testing.T) {
+		code, firstPage := getJobApplications("/api/job-applications?size=3&sort=company_name&cursor=")
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, firstPage.Data, 3)
+		assert.NotNil(t, firstPage.NextCursor)
+
+		setUpJobApplication(user.ID, "Amazon", "Software Engineer")
+
+		code, secondPage := getJobApplications("/api/job-applications?size=3&sort=company_name&cursor=" + *firstPage.NextCursor)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, secondPage.Data, 3)
+		for _, jobApplication := range secondPage.Data {
+			assert.NotEqual(t, "Amazon", jobApplication.CompanyName)
+			for _, seen := range firstPage.Data {
+				assert.NotEqual(t, seen.ID, jobApplication.ID)
+			}
+		}
+	})
+
+	t.Run("invalid query params - malformed cursor", func(t *testing.T) {
+		code, _ := getJobApplications("/api/job-applications?cursor=definitely-not-a-cursor")
+
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("invalid query params - cursor issued for a different sort", func(t *testing.T) {
+		_, firstPage := getJobApplications("/api/job-applications?size=2&sort=job_title&cursor=")
+
+		code, _ := getJobApplications("/api/job-applications?size=2&sort=-job_title&cursor=" + *firstPage.NextCursor)
+
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("invalid query params - zero size", func(t *testing.T) {
+		code, _ := getJobApplications("/api/job-applications?size=0&cursor=")
+
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestJobApplication(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	var (
+		companyName   = "Evil Corp Inc."
+		jobTitle      = "Software Engineer"
+		dateApplied   = time.Now().Add(time.Hour * -1)
+		stageName     = "In progress"
+		isReplied     = false
+		minSalary     = 50_000.00
+		maxSalary     = 70_000.00
+		jobPostingURL = "https://glassbore.com/jobs/swe420692137"
+		notes         = "Follow up in two weeks"
+	)
+
+	jobApplication, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:        user.ID,
+		CompanyName:   companyName,
+		JobTitle:      jobTitle,
+		DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
+		MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
+		MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
+		JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
+		Notes:         pgtype.Text{String: notes, Valid: true},
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
+		assert.Equal(t, companyName, resBodyRaw.CompanyName)
+		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
+		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
+		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
+		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
+		assert.Equal(t, jobPostingURL, resBodyRaw.JobPostingURL)
+		assert.Equal(t, notes, resBodyRaw.Notes)
+	})
+
+	t.Run("non-existing job application", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/f4d15edc-e780-42b5-957d-c4352401d9ca", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Contains(t, resBodyRaw.Error, "no rows in result set")
+	})
+}
+
+func TestCreateJobApplication(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			companyName   = "Evil Corp Inc."
+			jobTitle      = "Software Engineer"
+			dateApplied   = time.Now().Add(time.Hour * -1)
+			stageId       = stages[0].ID.String()
+			isReplied     = false
+			minSalary     = 50_000.00
+			maxSalary     = 70_000.00
+			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
+			notes         = "Follow up in two weeks"
+		)
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", companyName, jobTitle, dateApplied.Format(time.DateOnly), stageId, &minSalary, &maxSalary, "", "", "", "", jobPostingURL, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
+		assert.Equal(t, companyName, resBodyRaw.CompanyName)
+		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageId, resBodyRaw.Stage.ID)
+		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
+		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
+		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
+		assert.Equal(t, "PLN", resBodyRaw.SalaryCurrency)
+		assert.Equal(t, models.DefaultSalaryPeriod, resBodyRaw.SalaryPeriod)
+		assert.Equal(t, models.DefaultSalaryType, resBodyRaw.SalaryType)
+		assert.Empty(t, resBodyRaw.ContractType)
+		assert.Equal(t, jobPostingURL, resBodyRaw.JobPostingURL)
+		assert.Equal(t, notes, resBodyRaw.Notes)
+
+		uuid, err := utils.ToUUID(resBodyRaw.ID)
+
+		jobApplication, err := queries.GetJobApplication(ctx, db.GetJobApplicationParams{
+			ID:     uuid,
+			UserID: user.ID,
+		})
+
+		assert.NoError(t, err, "error querying job application")
+		assert.NotEmpty(t, jobApplication.ID, "missing job application id")
+		assert.Equal(t, jobApplication.CompanyName, resBodyRaw.CompanyName)
+		assert.Equal(t, jobApplication.JobTitle, resBodyRaw.JobTitle)
+		assert.Equal(t, jobApplication.DateApplied.Time.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, jobApplication.StageID.String(), resBodyRaw.Stage.ID)
+		assert.Equal(t, jobApplication.IsReplied, resBodyRaw.IsReplied)
+		assert.Equal(t, jobApplication.MinSalary.Float64, resBodyRaw.MinSalary)
+		assert.Equal(t, jobApplication.MaxSalary.Float64, resBodyRaw.MaxSalary)
+		assert.Equal(t, jobApplication.JobPostingUrl.String, resBodyRaw.JobPostingURL)
+		assert.Equal(t, jobApplication.Notes.String, resBodyRaw.Notes)
+	})
+
+	t.Run("valid request - salary details", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		minSalary, maxSalary := 25.50, 30.00
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", "Evil Corp Inc.", "Software Engineer", time.Now().Add(time.Hour*-1).Format(time.DateOnly), "", &minSalary, &maxSalary, "EUR", db.SalaryPeriodHOUR, db.SalaryTypeNET, db.ContractTypeB2B, "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, 25.50, resBodyRaw.MinSalary)
+		assert.Equal(t, 30.00, resBodyRaw.MaxSalary)
+		assert.Equal(t, "EUR", resBodyRaw.SalaryCurrency)
+		assert.Equal(t, db.SalaryPeriodHOUR, resBodyRaw.SalaryPeriod)
+		assert.Equal(t, db.SalaryTypeNET, resBodyRaw.SalaryType)
+		assert.Equal(t, db.ContractTypeB2B, resBodyRaw.ContractType)
+	})
+
+	t.Run("valid request - date applied as an RFC 3339 timestamp", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		// NOTE: Already the next day in Europe/Warsaw, the time zone of the user
+		bodyRaw := models.NewCreateJobApplicationReqBody("", "Evil Corp Inc.", "Software Engineer", "2025-03-14T23:30:00Z", "", nil, nil, "", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, "2025-03-15", resBodyRaw.DateApplied)
+	})
+
+	t.Run("invalid payload - incorrect date applied", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", "Evil Corp Inc.", "Software Engineer", "14-03-2025", "", nil, nil, "", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("valid request - company matched by normalised name", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		company, _ := queries.CreateCompany(ctx, db.CreateCompanyParams{
+			UserID: user.ID,
+			Name:   "Initech LLC",
+		})
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", "initech", "Software Engineer", time.Now().Add(time.Hour*-1).Format(time.DateOnly), "", nil, nil, "", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, company.ID.String(), resBodyRaw.CompanyID)
+		assert.Equal(t, "Initech LLC", resBodyRaw.CompanyName)
+	})
+
+	t.Run("valid request - company id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		company, _ := queries.CreateCompany(ctx, db.CreateCompanyParams{
+			UserID: user.ID,
+			Name:   "Globex Corporation",
+		})
+
+		bodyRaw := models.NewCreateJobApplicationReqBody(company.ID.String(), "", "Software Engineer", time.Now().Add(time.Hour*-1).Format(time.DateOnly), "", nil, nil, "", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, company.ID.String(), resBodyRaw.CompanyID)
+		assert.Equal(t, "Globex Corporation", resBodyRaw.CompanyName)
+	})
+
+	t.Run("invalid payload - non-existing company", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("f4d15edc-e780-42b5-957d-c4352401d9ca", "", "Software Engineer", time.Now().Add(time.Hour*-1).Format(time.DateOnly), "", nil, nil, "", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "company doesn't exist", resBodyRaw.Error)
+	})
+
+	t.Run("invalid payload - missing company name", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			companyName   = ""
+			jobTitle      = "Software Engineer"
+			dateApplied   = time.Now().Add(time.Hour * -1)
+			stageId       = stages[0].ID.String()
+			minSalary     = 50_000.00
+			maxSalary     = 70_000.00
+			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
+			notes         = "Follow up in two weeks"
+		)
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", companyName, jobTitle, dateApplied.Format(time.DateOnly), stageId, &minSalary, &maxSalary, "", "", "", "", jobPostingURL, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Contains(t, resBodyRaw.Error, "CompanyName", "required")
+	})
+
+	t.Run("invalid payload - missing job title", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			companyName   = "Evil Corp Inc."
+			jobTitle      = ""
+			dateApplied   = time.Now().Add(time.Hour * -1)
+			stageId       = stages[0].ID.String()
+			minSalary     = 50_000.00
+			maxSalary     = 70_000.00
+			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
+			notes         = "Follow up in two weeks"
+		)
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", companyName, jobTitle, dateApplied.Format(time.DateOnly), stageId, &minSalary, &maxSalary, "", "", "", "", jobPostingURL, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Contains(t, resBodyRaw.Error, "JobTitle", "required")
+	})
+
+	t.Run("invalid payload - missing date applied", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			companyName   = "Evil Corp Inc."
+			jobTitle      = "Software Engineer"
+			dateApplied   = ""
+			stageId       = stages[0].ID.String()
+			minSalary     = 50_000.00
+			maxSalary     = 70_000.00
+			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
+			notes         = "Follow up in two weeks"
+		)
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", companyName, jobTitle, dateApplied, stageId, &minSalary, &maxSalary, "", "", "", "", jobPostingURL, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Contains(t, resBodyRaw.Error, "DateApplied", "required")
+	})
+
+	t.Run("valid request - default stage", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			companyName   = "Evil Corp Inc."
+			jobTitle      = "Software Engineer"
+			dateApplied   = time.Now().Add(time.Hour * -1)
+			stageId       = ""
+			minSalary     = 50_000.00
+			maxSalary     = 70_000.00
+			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
+			notes         = "Follow up in two weeks"
+		)
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", companyName, jobTitle, dateApplied.Format(time.DateOnly), stageId, &minSalary, &maxSalary, "", "", "", "", jobPostingURL, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, stages[0].ID.String(), resBodyRaw.Stage.ID)
+		assert.Equal(t, stages[0].Name, resBodyRaw.Stage.Name)
+		assert.Equal(t, stages[0].Color, resBodyRaw.Stage.Color)
+		assert.Equal(t, stages[0].IsTerminal, resBodyRaw.Stage.IsTerminal)
+		assert.Equal(t, stages[0].Outcome, resBodyRaw.Stage.Outcome)
+	})
+
+	t.Run("invalid payload - incorrect stage id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			companyName   = "Evil Corp Inc."
+			jobTitle      = "Software Engineer"
+			dateApplied   = time.Now().Add(time.Hour * -1)
+			stageId       = "UNKNOWN"
+			minSalary     = 50_000.00
+			maxSalary     = 70_000.00
+			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
+			notes         = "Follow up in two weeks"
+		)
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", companyName, jobTitle, dateApplied.Format(time.DateOnly), stageId, &minSalary, &maxSalary, "", "", "", "", jobPostingURL, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'StageID' failed on the 'uuid' tag")
+	})
+
+	t.Run("invalid payload - non-existing stage", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			companyName   = "Evil Corp Inc."
+			jobTitle      = "Software Engineer"
+			dateApplied   = time.Now().Add(time.Hour * -1)
+			stageId       = "f4d15edc-e780-42b5-957d-c4352401d9ca"
+			minSalary     = 50_000.00
+			maxSalary     = 70_000.00
+			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
+			notes         = "Follow up in two weeks"
+		)
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", companyName, jobTitle, dateApplied.Format(time.DateOnly), stageId, &minSalary, &maxSalary, "", "", "", "", jobPostingURL, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Equal(t, "stage doesn't exist", resBodyRaw.Error)
+	})
+
+	t.Run("invalid payload - incorrect min salary", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			companyName   = "Evil Corp Inc."
+			jobTitle      = "Software Engineer"
+			dateApplied   = time.Now().Add(time.Hour * -1)
+			stageId       = stages[0].ID.String()
+			minSalary     = -50_000.00
+			maxSalary     = 70_000.00
+			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
+			notes         = "Follow up in two weeks"
+		)
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", companyName, jobTitle, dateApplied.Format(time.DateOnly), stageId, &minSalary, &maxSalary, "", "", "", "", jobPostingURL, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Contains(t, resBodyRaw.Error, "MinSalary", "Field validation for 'MinSalary' failed on the 'oneof' tag")
+	})
+
+	t.Run("invalid payload - incorrect max salary", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		var (
+			companyName   = "Evil Corp Inc."
+			jobTitle      = "Software Engineer"
+			dateApplied   = time.Now().Add(time.Hour * -1)
+			stageId       = stages[0].ID.String()
+			minSalary     = 50_000.00
+			maxSalary     = -70_000.00
+			jobPostingURL = "https://glassbore.com/jobs/swe420692137"
+			notes         = "Follow up in two weeks"
+		)
+
+		bodyRaw := models.NewCreateJobApplicationReqBody("", companyName, jobTitle, dateApplied.Format(time.DateOnly), stageId, &minSalary, &maxSalary, "", "", "", "", jobPostingURL, notes)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Contains(t, resBodyRaw.Error, "MaxSalary", "Field validation for 'MaxSalary' failed on the 'oneof' tag")
 	})
 }
 
 func TestUpdateJobApplication(t *testing.T) {
 	queries.Purge(ctx)
 
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	t.Run("valid request - replacing every field", func(t *testing.T) {
+		jobApplication, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+			UserID:        user.ID,
+			CompanyName:   "Evil Corp Inc.",
+			JobTitle:      "Software Engineer",
+			DateApplied:   pgtype.Date{Time: time.Now().Add(time.Hour * -1), Valid: true},
+			MinSalary:     pgtype.Float8{Float64: 50_000.00, Valid: true},
+			MaxSalary:     pgtype.Float8{Float64: 70_000.00, Valid: true},
+			JobPostingUrl: pgtype.Text{String: "https://glassbore.com/jobs/swe420692137", Valid: true},
+			Notes:         pgtype.Text{String: "Follow up in two weeks", Valid: true},
+		})
+
+		w := httptest.NewRecorder()
+
+		dateApplied := "2006-02-01"
+		maxSalary := 90_000.00
+
+		bodyRaw := models.NewUpdateJobApplicationReqBody("", "Google", "Angular Developer", dateApplied, stages[1].ID.String(), true, nil, &maxSalary, "", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpdateJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, jobApplication.ID.String(), resBodyRaw.ID)
+		assert.Equal(t, "Google", resBodyRaw.CompanyName)
+		assert.Equal(t, "Angular Developer", resBodyRaw.JobTitle)
+		assert.Equal(t, dateApplied, resBodyRaw.DateApplied)
+		assert.Equal(t, stages[1].ID.String(), resBodyRaw.Stage.ID)
+		assert.True(t, resBodyRaw.IsReplied)
+		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
+
+		row, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{ID: jobApplication.ID, UserID: user.ID})
+
+		assert.False(t, row.MinSalary.Valid)
+		assert.False(t, row.JobPostingUrl.Valid)
+		assert.False(t, row.Notes.Valid)
+	})
+
+	t.Run("invalid payload - missing fields", func(t *testing.T) {
+		jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.UpdateJobApplicationReqBody{
+			JobTitle: "Angular Developer",
+		}
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'CompanyName' failed on the 'required_without' tag")
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'DateApplied' failed on the 'required' tag")
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'StageID' failed on the 'required' tag")
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'IsReplied' failed on the 'required' tag")
+	})
+
+	t.Run("non-existing job application", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewUpdateJobApplicationReqBody("", "Google", "Angular Developer", time.Now().Format(time.DateOnly), stages[0].ID.String(), false, nil, nil, "", "", "", "", "", "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", "/api/job-applications/f4d15edc-e780-42b5-957d-c4352401d9ca", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPatchJobApplication(t *testing.T) {
+	queries.Purge(ctx)
+
 	var (
 		companyName   = "Evil Corp Inc."
 		jobTitle      = "Software Engineer"
 		dateApplied   = time.Now().Add(time.Hour * -1)
-		status        = db.StatusINPROGRESS
+		stageName     = "In progress"
 		isReplied     = false
 		minSalary     = 50_000.00
 		maxSalary     = 70_000.00
@@ -1237,8 +2049,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1249,10 +2060,12 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		companyName := "Google"
 
-		bodyRaw := models.NewUpdateJobApplicationReqBody(companyName, "", nil, nil, nil, nil, nil, "", "")
+		bodyRaw := models.PatchJobApplicationReqBody{
+			CompanyName: &companyName,
+		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1267,8 +2080,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1287,8 +2100,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1299,12 +2111,12 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		jobTitle := "Angular Developer"
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			JobTitle: jobTitle,
+		bodyRaw := models.PatchJobApplicationReqBody{
+			JobTitle: &jobTitle,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1319,8 +2131,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1339,8 +2151,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1349,15 +2160,15 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		dateApplied := new(time.Time)
-		*dateApplied = time.Date(2006, 02, 01, 0, 0, 0, 0, time.UTC)
+		dateApplied := new(string)
+		*dateApplied = "2006-02-01"
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
+		bodyRaw := models.PatchJobApplicationReqBody{
 			DateApplied: dateApplied,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1372,8 +2183,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, *dateApplied, resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1381,7 +2192,7 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.Equal(t, notes, resBodyRaw.Notes)
 	})
 
-	t.Run("valid request - changing status", func(t *testing.T) {
+	t.Run("valid request - changing date applied to an RFC 3339 timestamp", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -1392,8 +2203,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1402,15 +2212,60 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		status := new(db.Status)
-		*status = db.StatusREJECTED
+		// NOTE: Still the previous day in UTC, but not in Europe/Warsaw, the time zone of the user
+		dateApplied := new(string)
+		*dateApplied = "2006-02-01T00:30:00+01:00"
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			Status: status,
+		bodyRaw := models.PatchJobApplicationReqBody{
+			DateApplied: dateApplied,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "2006-02-01", resBodyRaw.DateApplied)
+	})
+
+	t.Run("valid request - changing stage", func(t *testing.T) {
+		queries.Purge(ctx)
+
+		setUpUser(ctx)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		stages, _ := queries.GetStages(ctx, user.ID)
+
+		jobApplication, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+			UserID:        user.ID,
+			CompanyName:   companyName,
+			JobTitle:      jobTitle,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
+			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
+			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
+			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
+			Notes:         pgtype.Text{String: notes, Valid: true},
+		})
+
+		w := httptest.NewRecorder()
+
+		stageId := stages[1].ID.String()
+
+		bodyRaw := models.PatchJobApplicationReqBody{
+			StageID: &stageId,
+		}
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1425,8 +2280,9 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, *status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stages[1].ID.String(), resBodyRaw.Stage.ID)
+		assert.Equal(t, stages[1].Name, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1445,8 +2301,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1458,12 +2313,12 @@ func TestUpdateJobApplication(t *testing.T) {
 		isReplied := new(bool)
 		*isReplied = true
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
+		bodyRaw := models.PatchJobApplicationReqBody{
 			IsReplied: isReplied,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1478,8 +2333,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, *isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1498,8 +2353,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1511,12 +2365,12 @@ func TestUpdateJobApplication(t *testing.T) {
 		minSalary := new(float64)
 		*minSalary = 2137.00
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
+		bodyRaw := models.PatchJobApplicationReqBody{
 			MinSalary: minSalary,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1531,8 +2385,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, *minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1551,8 +2405,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1564,12 +2417,12 @@ func TestUpdateJobApplication(t *testing.T) {
 		maxSalary := new(float64)
 		*maxSalary = 42069.00
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
+		bodyRaw := models.PatchJobApplicationReqBody{
 			MaxSalary: maxSalary,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1584,8 +2437,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, *maxSalary, resBodyRaw.MaxSalary)
@@ -1604,8 +2457,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1616,12 +2468,12 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		jobPostingURL := "https://glassbore.com/jobs/fe420692137"
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			JobPostingURL: jobPostingURL,
+		bodyRaw := models.PatchJobApplicationReqBody{
+			JobPostingURL: &jobPostingURL,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1636,8 +2488,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1656,8 +2508,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1668,12 +2519,12 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		notes := "Follow up in a week"
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			Notes: notes,
+		bodyRaw := models.PatchJobApplicationReqBody{
+			Notes: &notes,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1688,8 +2539,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1697,7 +2548,93 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.Equal(t, notes, resBodyRaw.Notes)
 	})
 
-	t.Run("invalid payload - empty company name", func(t *testing.T) {
+	t.Run("invalid payload - empty company name", func(t *testing.T) {
+		queries.Purge(ctx)
+
+		setUpUser(ctx)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		jobApplication, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+			UserID:        user.ID,
+			CompanyName:   companyName,
+			JobTitle:      jobTitle,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
+			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
+			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
+			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
+			Notes:         pgtype.Text{String: notes, Valid: true},
+		})
+
+		w := httptest.NewRecorder()
+
+		emptyCompanyName := ""
+
+		bodyRaw := models.PatchJobApplicationReqBody{
+			CompanyName: &emptyCompanyName,
+		}
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'CompanyName' failed on the 'min' tag")
+	})
+
+	t.Run("invalid payload - empty job title", func(t *testing.T) {
+		queries.Purge(ctx)
+
+		setUpUser(ctx)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		jobApplication, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+			UserID:        user.ID,
+			CompanyName:   companyName,
+			JobTitle:      jobTitle,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
+			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
+			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
+			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
+			Notes:         pgtype.Text{String: notes, Valid: true},
+		})
+
+		w := httptest.NewRecorder()
+
+		emptyJobTitle := ""
+
+		bodyRaw := models.PatchJobApplicationReqBody{
+			JobTitle: &emptyJobTitle,
+		}
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'JobTitle' failed on the 'min' tag")
+	})
+
+	t.Run("invalid payload - empty date applied", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -1708,8 +2645,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1718,12 +2654,12 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			CompanyName: "",
+		bodyRaw := models.PatchJobApplicationReqBody{
+			DateApplied: nil,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1738,8 +2674,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1747,7 +2683,7 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.Equal(t, notes, resBodyRaw.Notes)
 	})
 
-	t.Run("invalid payload - empty job title", func(t *testing.T) {
+	t.Run("invalid payload - empty stage", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -1758,8 +2694,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1768,12 +2703,12 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			JobTitle: "",
+		bodyRaw := models.PatchJobApplicationReqBody{
+			StageID: nil,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1788,8 +2723,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1797,7 +2732,7 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.Equal(t, notes, resBodyRaw.Notes)
 	})
 
-	t.Run("invalid payload - empty date applied", func(t *testing.T) {
+	t.Run("invalid payload - empty is replied", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -1808,8 +2743,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1818,12 +2752,12 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			DateApplied: nil,
+		bodyRaw := models.PatchJobApplicationReqBody{
+			IsReplied: nil,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1838,8 +2772,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1847,7 +2781,7 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.Equal(t, notes, resBodyRaw.Notes)
 	})
 
-	t.Run("invalid payload - empty status", func(t *testing.T) {
+	t.Run("invalid payload - empty min salary", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -1858,8 +2792,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1868,12 +2801,12 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			Status: nil,
+		bodyRaw := models.PatchJobApplicationReqBody{
+			MinSalary: nil,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1888,8 +2821,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1897,7 +2830,7 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.Equal(t, notes, resBodyRaw.Notes)
 	})
 
-	t.Run("invalid payload - empty is replied", func(t *testing.T) {
+	t.Run("invalid payload - empty max salary", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -1908,8 +2841,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1918,12 +2850,12 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			IsReplied: nil,
+		bodyRaw := models.PatchJobApplicationReqBody{
+			MaxSalary: nil,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1938,8 +2870,8 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -1947,7 +2879,7 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.Equal(t, notes, resBodyRaw.Notes)
 	})
 
-	t.Run("invalid payload - empty min salary", func(t *testing.T) {
+	t.Run("valid request - clearing job posting URL with an empty string", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -1958,8 +2890,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -1968,12 +2899,14 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			MinSalary: nil,
+		emptyJobPostingURL := ""
+
+		bodyRaw := models.PatchJobApplicationReqBody{
+			JobPostingURL: &emptyJobPostingURL,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -1988,16 +2921,16 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
-		assert.Equal(t, jobPostingURL, resBodyRaw.JobPostingURL)
+		assert.Empty(t, resBodyRaw.JobPostingURL)
 		assert.Equal(t, notes, resBodyRaw.Notes)
 	})
 
-	t.Run("invalid payload - empty max salary", func(t *testing.T) {
+	t.Run("valid request - clearing notes with an empty string", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -2008,8 +2941,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -2018,12 +2950,14 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			MaxSalary: nil,
+		emptyNotes := ""
+
+		bodyRaw := models.PatchJobApplicationReqBody{
+			Notes: &emptyNotes,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -2038,16 +2972,16 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
 		assert.Equal(t, jobPostingURL, resBodyRaw.JobPostingURL)
-		assert.Equal(t, notes, resBodyRaw.Notes)
+		assert.Empty(t, resBodyRaw.Notes)
 	})
 
-	t.Run("invalid payload - empty job posting url", func(t *testing.T) {
+	t.Run("invalid payload - incorrect stage id", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -2058,8 +2992,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -2068,36 +3001,30 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			JobPostingURL: "",
+		stageId := "UNKNOWN"
+
+		bodyRaw := models.PatchJobApplicationReqBody{
+			StageID: &stageId,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.JobApplicationResBody
+		var resBodyRaw models.Error
 		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
-		assert.Equal(t, companyName, resBodyRaw.CompanyName)
-		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
-		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
-		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
-		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
-		assert.Equal(t, jobPostingURL, resBodyRaw.JobPostingURL)
-		assert.Equal(t, notes, resBodyRaw.Notes)
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'StageID' failed on the 'uuid' tag")
 	})
 
-	t.Run("invalid payload - empty notes", func(t *testing.T) {
+	t.Run("invalid payload - non-existing stage", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -2108,8 +3035,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -2118,36 +3044,30 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			Notes: "",
+		stageId := "f4d15edc-e780-42b5-957d-c4352401d9ca"
+
+		bodyRaw := models.PatchJobApplicationReqBody{
+			StageID: &stageId,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
 
-		var resBodyRaw models.JobApplicationResBody
+		var resBodyRaw models.Error
 		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
 
 		assert.NoError(t, err, "error unmarshaling response body")
 
-		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
-		assert.Equal(t, companyName, resBodyRaw.CompanyName)
-		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
-		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
-		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
-		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
-		assert.Equal(t, jobPostingURL, resBodyRaw.JobPostingURL)
-		assert.Equal(t, notes, resBodyRaw.Notes)
+		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
+		assert.Equal(t, "stage doesn't exist", resBodyRaw.Error)
 	})
 
-	t.Run("invalid payload - incorrect status", func(t *testing.T) {
+	t.Run("invalid payload - incorrect min salary", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -2158,8 +3078,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -2168,15 +3087,15 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		incorrectStatus := new(db.Status)
-		*incorrectStatus = "UNKNOWN"
+		incorrectMinSalary := new(float64)
+		*incorrectMinSalary = -1.00
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			Status: incorrectStatus,
+		bodyRaw := models.PatchJobApplicationReqBody{
+			MinSalary: incorrectMinSalary,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -2189,10 +3108,10 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
 		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "Status", "Field validation for 'Status' failed on the 'oneof' tag")
+		assert.Contains(t, resBodyRaw.Error, "MinSalary", "Field validation for 'MinSalary' failed on the 'gte' tag")
 	})
 
-	t.Run("invalid payload - incorrect min salary", func(t *testing.T) {
+	t.Run("invalid payload - incorrect max salary", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -2203,8 +3122,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -2213,15 +3131,15 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		incorrectMinSalary := new(float64)
-		*incorrectMinSalary = -1.00
+		incorrectMaxSalary := new(float64)
+		*incorrectMaxSalary = -1.00
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			MinSalary: incorrectMinSalary,
+		bodyRaw := models.PatchJobApplicationReqBody{
+			MaxSalary: incorrectMaxSalary,
 		}
 		bodyJSON, _ := json.Marshal(bodyRaw)
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -2234,10 +3152,10 @@ func TestUpdateJobApplication(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
 		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "MinSalary", "Field validation for 'MinSalary' failed on the 'gte' tag")
+		assert.Contains(t, resBodyRaw.Error, "MaxSalary", "Field validation for 'MaxSalary' failed on the 'gte' tag")
 	})
 
-	t.Run("invalid payload - incorrect max salary", func(t *testing.T) {
+	t.Run("valid request - clearing with null", func(t *testing.T) {
 		queries.Purge(ctx)
 
 		setUpUser(ctx)
@@ -2248,8 +3166,7 @@ func TestUpdateJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -2258,15 +3175,48 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		incorrectMaxSalary := new(float64)
-		*incorrectMaxSalary = -1.00
+		bodyJSON := `{"minSalary": null, "jobPostingURL": null, "notes": null}`
 
-		bodyRaw := models.UpdateJobApplicationReqBody{
-			MaxSalary: incorrectMaxSalary,
-		}
-		bodyJSON, _ := json.Marshal(bodyRaw)
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(bodyJSON))
+		req.Header.Add("Authorization", "Bearer "+token)
+		req.Header.Add("Content-Type", "application/merge-patch+json")
 
-		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.PatchJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, companyName, resBodyRaw.CompanyName)
+		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
+		assert.Empty(t, resBodyRaw.MinSalary)
+		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
+		assert.Empty(t, resBodyRaw.JobPostingURL)
+		assert.Empty(t, resBodyRaw.Notes)
+
+		row, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{ID: jobApplication.ID, UserID: user.ID})
+
+		assert.False(t, row.MinSalary.Valid)
+		assert.True(t, row.MaxSalary.Valid)
+		assert.False(t, row.JobPostingUrl.Valid)
+		assert.False(t, row.Notes.Valid)
+	})
+
+	t.Run("invalid payload - null job title", func(t *testing.T) {
+		queries.Purge(ctx)
+
+		setUpUser(ctx)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		jobApplication := setUpJobApplication(user.ID, companyName, jobTitle)
+
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(`{"jobTitle": null}`))
 		req.Header.Add("Authorization", "Bearer "+token)
 
 		r.ServeHTTP(w, req)
@@ -2278,8 +3228,157 @@ func TestUpdateJobApplication(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 
-		assert.NotEmpty(t, resBodyRaw.Error, "missing error message")
-		assert.Contains(t, resBodyRaw.Error, "MaxSalary", "Field validation for 'MaxSalary' failed on the 'gte' tag")
+		assert.Equal(t, "jobTitle can't be null", resBodyRaw.Error)
+	})
+
+	t.Run("non-existing job application", func(t *testing.T) {
+		queries.Purge(ctx)
+
+		setUpUser(ctx)
+
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PATCH", "/api/job-applications/f4d15edc-e780-42b5-957d-c4352401d9ca", strings.NewReader(`{"notes": null}`))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestJobApplicationETag(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	url := fmt.Sprintf("/api/job-applications/%v", jobApplication.ID)
+
+	t.Run("valid request - returning ETag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", url, nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+	})
+
+	t.Run("valid request - not modified", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", url, nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+		req.Header.Add("If-None-Match", `W/"1"`)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("valid request - matching If-Match", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PATCH", url, strings.NewReader(`{"notes": "Follow up in two weeks"}`))
+		req.Header.Add("Authorization", "Bearer "+token)
+		req.Header.Add("If-Match", `"1"`)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.PatchJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+		assert.Equal(t, "Follow up in two weeks", resBodyRaw.Notes)
+	})
+
+	t.Run("valid request - modified since", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", url, nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+		req.Header.Add("If-None-Match", `"1"`)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+	})
+
+	t.Run("stale If-Match", func(t *testing.T) {
+		stages, _ := queries.GetStages(ctx, user.ID)
+
+		for _, req := range []*http.Request{
+			func() *http.Request {
+				req, _ := http.NewRequest("PATCH", url, strings.NewReader(`{"notes": null}`))
+				return req
+			}(),
+			func() *http.Request {
+				bodyRaw := models.NewUpdateJobApplicationReqBody("", "Google", "Angular Developer", time.Now().Format(time.DateOnly), stages[0].ID.String(), false, nil, nil, "", "", "", "", "", "")
+				bodyJSON, _ := json.Marshal(bodyRaw)
+				req, _ := http.NewRequest("PUT", url, strings.NewReader(string(bodyJSON)))
+				return req
+			}(),
+			func() *http.Request {
+				req, _ := http.NewRequest("DELETE", url, nil)
+				return req
+			}(),
+		} {
+			w := httptest.NewRecorder()
+
+			req.Header.Add("Authorization", "Bearer "+token)
+			req.Header.Add("If-Match", `"1"`)
+
+			r.ServeHTTP(w, req)
+
+			var resBodyRaw models.Error
+			err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+			assert.NoError(t, err, "error unmarshaling response body")
+
+			assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+			assert.Equal(t, "job application has been changed in the meantime", resBodyRaw.Error)
+		}
+
+		row, _ := queries.GetJobApplication(ctx, db.GetJobApplicationParams{ID: jobApplication.ID, UserID: user.ID})
+
+		assert.Equal(t, int32(2), row.Version)
+		assert.Equal(t, "Evil Corp Inc.", row.CompanyName)
+		assert.Equal(t, "Follow up in two weeks", row.Notes.String)
+	})
+
+	t.Run("weak If-Match", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PATCH", url, strings.NewReader(`{"notes": null}`))
+		req.Header.Add("Authorization", "Bearer "+token)
+		req.Header.Add("If-Match", `W/"2"`)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
+
+	t.Run("valid request - deleting with any ETag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", url, nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+		req.Header.Add("If-Match", "*")
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
 	})
 }
 
@@ -2290,7 +3389,7 @@ func TestDeleteJobApplication(t *testing.T) {
 		companyName   = "Evil Corp Inc."
 		jobTitle      = "Software Engineer"
 		dateApplied   = time.Now().Add(time.Hour * -1)
-		status        = db.StatusINPROGRESS
+		stageName     = "In progress"
 		isReplied     = false
 		minSalary     = 50_000.00
 		maxSalary     = 70_000.00
@@ -2309,8 +3408,7 @@ func TestDeleteJobApplication(t *testing.T) {
 			UserID:        user.ID,
 			CompanyName:   companyName,
 			JobTitle:      jobTitle,
-			DateApplied:   pgtype.Timestamptz{Time: dateApplied, Valid: true},
-			Status:        status,
+			DateApplied:   pgtype.Date{Time: dateApplied, Valid: true},
 			MinSalary:     pgtype.Float8{Float64: minSalary, Valid: true},
 			MaxSalary:     pgtype.Float8{Float64: maxSalary, Valid: true},
 			JobPostingUrl: pgtype.Text{String: jobPostingURL, Valid: true},
@@ -2334,8 +3432,8 @@ func TestDeleteJobApplication(t *testing.T) {
 		assert.NotEmpty(t, resBodyRaw.ID, "missing job application id")
 		assert.Equal(t, companyName, resBodyRaw.CompanyName)
 		assert.Equal(t, jobTitle, resBodyRaw.JobTitle)
-		assert.Equal(t, dateApplied.UTC().Truncate(time.Microsecond), resBodyRaw.DateApplied.UTC().Truncate(time.Microsecond))
-		assert.Equal(t, status, resBodyRaw.Status)
+		assert.Equal(t, dateApplied.Format(time.DateOnly), resBodyRaw.DateApplied)
+		assert.Equal(t, stageName, resBodyRaw.Stage.Name)
 		assert.Equal(t, isReplied, resBodyRaw.IsReplied)
 		assert.Equal(t, minSalary, resBodyRaw.MinSalary)
 		assert.Equal(t, maxSalary, resBodyRaw.MaxSalary)
@@ -2346,3 +3444,83 @@ func TestDeleteJobApplication(t *testing.T) {
 		assert.Len(t, jobApplications, 0)
 	})
 }
+
+func TestJobApplicationTimeline(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	jobApplication, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:        user.ID,
+		CompanyName:   "Evil Corp Inc.",
+		JobTitle:      "Software Engineer",
+		DateApplied:   pgtype.Date{Time: time.Now().Add(time.Hour * -1), Valid: true},
+		StageID:       stages[0].ID,
+		MinSalary:     pgtype.Float8{Float64: 50_000.00, Valid: true},
+		MaxSalary:     pgtype.Float8{Float64: 70_000.00, Valid: true},
+		JobPostingUrl: pgtype.Text{String: "https://glassbore.com/jobs/swe420692137", Valid: true},
+	})
+
+	stageId := stages[1].ID.String()
+	isReplied := true
+
+	bodyRaw := models.PatchJobApplicationReqBody{
+		StageID:   &stageId,
+		IsReplied: &isReplied,
+	}
+	bodyJSON, _ := json.Marshal(bodyRaw)
+
+	req, _ := http.NewRequest("PATCH", fmt.Sprintf("/api/job-applications/%v", jobApplication.ID), strings.NewReader(string(bodyJSON)))
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications/%v/timeline", jobApplication.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationTimelineResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Events, 3)
+
+		assert.Equal(t, "stage", resBodyRaw.Events[0].Field)
+		assert.Equal(t, "", resBodyRaw.Events[0].OldValue)
+		assert.Equal(t, stages[0].ID.String(), resBodyRaw.Events[0].NewValue)
+
+		fields := []string{resBodyRaw.Events[1].Field, resBodyRaw.Events[2].Field}
+		assert.ElementsMatch(t, []string{"stage", "is_replied"}, fields)
+
+		assert.Len(t, resBodyRaw.Stages, 2)
+
+		assert.Equal(t, stages[0].ID.String(), resBodyRaw.Stages[0].StageID)
+		assert.Equal(t, stages[0].Name, resBodyRaw.Stages[0].Name)
+		assert.NotNil(t, resBodyRaw.Stages[0].LeftAt, "missing stage exit time")
+		assert.Equal(t, stages[1].ID.String(), resBodyRaw.Stages[1].StageID)
+		assert.Equal(t, stages[1].Name, resBodyRaw.Stages[1].Name)
+		assert.Nil(t, resBodyRaw.Stages[1].LeftAt)
+	})
+
+	t.Run("non-existing job application", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/f4d15edc-e780-42b5-957d-c4352401d9ca/timeline", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/mailer"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingMailer struct{}
+
+func (failingMailer) Send(ctx context.Context, msg mailer.Message) error {
+	return errors.New("connection refused")
+}
+
+func TestOutbox(t *testing.T) {
+	t.Run("sign up email delivered", func(t *testing.T) {
+		queries.Purge(ctx)
+		mail.Reset()
+
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewSignUpReqBody("Jakub", "Szewczyk", "jakub.szewczyk@test.com", "qwerty!123456789", "qwerty!123456789")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/sign-up", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		err := outbox.Flush(ctx)
+
+		assert.NoError(t, err, "error flushing outbox")
+
+		messages := mail.Messages()
+
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "jakub.szewczyk@test.com", messages[0].To)
+		assert.Equal(t, "Welcome to Career Compass, Jakub!", messages[0].Subject)
+		assert.Contains(t, messages[0].HTML, "Verify Email")
+
+		emails, _ := queries.GetEmails(ctx)
+
+		assert.Len(t, emails, 1)
+		assert.Equal(t, db.EmailStatusSENT, emails[0].Status)
+		assert.Equal(t, int32(1), emails[0].Attempts)
+		assert.True(t, emails[0].SentAt.Valid)
+	})
+
+	t.Run("failed delivery retried with backoff", func(t *testing.T) {
+		queries.Purge(ctx)
+
+		queries.EnqueueEmail(ctx, db.EnqueueEmailParams{Recipient: "jakub.szewczyk@test.com", Subject: "Test", Body: "<p>Test</p>"})
+
+		err := mailer.NewOutbox(queries, failingMailer{}).Flush(ctx)
+
+		assert.NoError(t, err, "error flushing outbox")
+
+		emails, _ := queries.GetEmails(ctx)
+
+		assert.Len(t, emails, 1)
+		assert.Equal(t, db.EmailStatusPENDING, emails[0].Status)
+		assert.Equal(t, int32(1), emails[0].Attempts)
+		assert.Equal(t, "connection refused", emails[0].LastError.String)
+		assert.True(t, emails[0].NextAttemptAt.Time.After(time.Now()), "next attempt should be delayed")
+
+		mail.Reset()
+		outbox.Flush(ctx)
+
+		assert.Len(t, mail.Messages(), 0, "email shouldn't be retried before its backoff elapses")
+	})
+}
@@ -0,0 +1,521 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func setUpTOTP(userId pgtype.UUID) (string, []string) {
+	secret, _ := utils.GenerateTOTPSecret()
+	queries.UpdateTOTPSecret(ctx, db.UpdateTOTPSecretParams{ID: userId, TotpSecret: pgtype.Text{String: secret, Valid: true}})
+	queries.EnableTOTP(ctx, userId)
+
+	recoveryCodes, _ := utils.GenerateRecoveryCodes(2)
+	queries.CreateRecoveryCodes(ctx, db.CreateRecoveryCodesParams{UserID: userId, Codes: recoveryCodes})
+
+	return secret, recoveryCodes
+}
+
+func TestEnrollTOTP(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/profile/mfa/totp", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.EnrollTOTPResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.Secret, "missing secret")
+		assert.True(t, strings.HasPrefix(resBodyRaw.URI, "otpauth://totp/"), "invalid otpauth URI")
+		assert.Contains(t, resBodyRaw.URI, "secret="+resBodyRaw.Secret)
+
+		totp, err := queries.GetTOTP(ctx, user.ID)
+
+		assert.NoError(t, err, "error querying TOTP")
+		assert.Equal(t, resBodyRaw.Secret, totp.TotpSecret.String)
+		assert.Equal(t, false, totp.IsTotpEnabled)
+	})
+
+	t.Run("already enabled", func(t *testing.T) {
+		setUpTOTP(user.ID)
+
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/profile/mfa/totp", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.Equal(t, "two-factor authentication is already enabled", resBodyRaw.Error)
+	})
+}
+
+func TestConfirmTOTP(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	t.Run("enrollment not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewConfirmTOTPReqBody("123456")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/profile/mfa/totp/confirm", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	secret, _ := utils.GenerateTOTPSecret()
+	queries.UpdateTOTPSecret(ctx, db.UpdateTOTPSecretParams{ID: user.ID, TotpSecret: pgtype.Text{String: secret, Valid: true}})
+
+	t.Run("invalid code", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		code, _ := utils.TOTPCode(secret, time.Now().Add(time.Hour))
+
+		bodyRaw := models.NewConfirmTOTPReqBody(code)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/profile/mfa/totp/confirm", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.Equal(t, "invalid code", resBodyRaw.Error)
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		code, _ := utils.TOTPCode(secret, time.Now())
+
+		bodyRaw := models.NewConfirmTOTPReqBody(code)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/profile/mfa/totp/confirm", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.RecoveryCodesResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.RecoveryCodes, 10)
+
+		totp, err := queries.GetTOTP(ctx, user.ID)
+
+		assert.NoError(t, err, "error querying TOTP")
+		assert.Equal(t, true, totp.IsTotpEnabled)
+	})
+}
+
+func TestDisableTOTP(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	_, recoveryCodes := setUpTOTP(user.ID)
+
+	t.Run("invalid code", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewDisableTOTPReqBody("000000")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/profile/mfa/totp/disable", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("valid request - recovery code", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewDisableTOTPReqBody(recoveryCodes[0])
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/profile/mfa/totp/disable", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		totp, err := queries.GetTOTP(ctx, user.ID)
+
+		assert.NoError(t, err, "error querying TOTP")
+		assert.Equal(t, false, totp.IsTotpEnabled)
+		assert.Equal(t, false, totp.TotpSecret.Valid)
+	})
+}
+
+func TestRegenerateRecoveryCodes(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	newRegenerateRequest := func(code string) *http.Request {
+		bodyRaw := models.NewRegenerateRecoveryCodesReqBody(code)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/profile/mfa/recovery-codes", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		return req
+	}
+
+	t.Run("two-factor authentication disabled", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newRegenerateRequest("123456"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	secret, recoveryCodes := setUpTOTP(user.ID)
+
+	t.Run("missing code", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/profile/mfa/recovery-codes", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("recovery code rejected", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newRegenerateRequest(recoveryCodes[1]))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	code, _ := utils.TOTPCode(secret, time.Now())
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req := newRegenerateRequest(code)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.RecoveryCodesResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Len(t, resBodyRaw.RecoveryCodes, 10)
+
+		_, err = queries.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{UserID: user.ID, Code: recoveryCodes[0]})
+
+		assert.Error(t, err, "previous recovery codes should be invalidated")
+	})
+
+	t.Run("replayed code", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, newRegenerateRequest(code))
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		assert.Equal(t, "invalid code", resBodyRaw.Error)
+	})
+}
+
+func TestSignInMFA(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	secret, recoveryCodes := setUpTOTP(user.ID)
+
+	signIn := func() models.MFAChallengeResBody {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewSignInReqBody("jakub.szewczyk@test.com", "qwerty!123456789")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/sign-in", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.MFAChallengeResBody
+		json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.NotEmpty(t, resBodyRaw.MFAToken, "missing MFA token")
+
+		return resBodyRaw
+	}
+
+	t.Run("MFA token rejected as access token", func(t *testing.T) {
+		challenge := signIn()
+
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/profile", nil)
+		req.Header.Add("Authorization", "Bearer "+challenge.MFAToken)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	code, _ := utils.TOTPCode(secret, time.Now())
+
+	t.Run("valid request - TOTP code", func(t *testing.T) {
+		challenge := signIn()
+
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewSignInMFAReqBody(challenge.MFAToken, code)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/sign-in/mfa", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.SignInResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "jakub.szewczyk@test.com", resBodyRaw.User.Email)
+		assert.NotEmpty(t, resBodyRaw.Token, "missing token")
+		assert.NotEmpty(t, resBodyRaw.RefreshToken, "missing refresh token")
+	})
+
+	t.Run("valid request - recovery code", func(t *testing.T) {
+		challenge := signIn()
+
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewSignInMFAReqBody(challenge.MFAToken, recoveryCodes[1])
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/sign-in/mfa", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("used recovery code", func(t *testing.T) {
+		challenge := signIn()
+
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewSignInMFAReqBody(challenge.MFAToken, recoveryCodes[1])
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/sign-in/mfa", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		assert.Equal(t, "invalid code", resBodyRaw.Error)
+	})
+
+	completeSignIn := func(mfaToken, code string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewSignInMFAReqBody(mfaToken, code)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/sign-in/mfa", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		return w
+	}
+
+	t.Run("replayed TOTP code", func(t *testing.T) {
+		challenge := signIn()
+
+		w := completeSignIn(challenge.MFAToken, code)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code, "a code accepted once shouldn't be accepted again")
+	})
+
+	t.Run("MFA token used twice", func(t *testing.T) {
+		challenge := signIn()
+
+		w := completeSignIn(challenge.MFAToken, recoveryCodes[0])
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		code, _ := utils.TOTPCode(secret, time.Now().Add(time.Second*30))
+
+		w = completeSignIn(challenge.MFAToken, code)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		assert.Equal(t, "invalid or expired MFA token", resBodyRaw.Error)
+	})
+
+	t.Run("MFA token used twice concurrently", func(t *testing.T) {
+		challenge := signIn()
+
+		codes, _ := utils.GenerateRecoveryCodes(2)
+		queries.CreateRecoveryCodes(ctx, db.CreateRecoveryCodesParams{UserID: user.ID, Codes: codes})
+
+		statuses := make([]int, len(codes))
+
+		var wg sync.WaitGroup
+		for i, code := range codes {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				statuses[i] = completeSignIn(challenge.MFAToken, code).Code
+			}()
+		}
+		wg.Wait()
+
+		assert.ElementsMatch(t, []int{http.StatusOK, http.StatusUnauthorized}, statuses)
+
+		unused := 0
+		for _, code := range codes {
+			if _, err := queries.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{UserID: user.ID, Code: code}); err == nil {
+				unused++
+			}
+		}
+
+		assert.Equal(t, 1, unused, "the request that lost the race shouldn't use up its recovery code")
+
+		// NOTE: Whether the losing attempt counts against the per-user limit depends on timing, which the next tests rely on
+		queries.ResetMFAAttempts(ctx, user.ID)
+	})
+
+	t.Run("too many attempts per MFA token", func(t *testing.T) {
+		challenge := signIn()
+
+		for range 5 {
+			w := completeSignIn(challenge.MFAToken, "000000")
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+		}
+
+		code, _ := utils.TOTPCode(secret, time.Now().Add(time.Second*30))
+
+		w := completeSignIn(challenge.MFAToken, code)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		assert.Equal(t, "invalid or expired MFA token", resBodyRaw.Error)
+	})
+
+	t.Run("too many attempts per user", func(t *testing.T) {
+		// NOTE: Five attempts were already made by the previous test
+		for range 5 {
+			w := completeSignIn(signIn().MFAToken, "000000")
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+		}
+
+		code, _ := utils.TOTPCode(secret, time.Now().Add(time.Second*30))
+
+		w := completeSignIn(signIn().MFAToken, code)
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	})
+
+	t.Run("access token rejected as MFA token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		code, _ := utils.TOTPCode(secret, time.Now())
+
+		bodyRaw := models.NewSignInMFAReqBody(token, code)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/sign-in/mfa", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
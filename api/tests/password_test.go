@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/jakub-szewczyk/career-compass-gin/api/models"
@@ -27,6 +28,15 @@ func TestInitPasswordReset(t *testing.T) {
 		r.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		mail.Reset()
+		outbox.Flush(ctx)
+
+		messages := mail.Messages()
+
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "jakub.szewczyk@test.com", messages[0].To)
+		assert.Equal(t, "Reset Your Password", messages[0].Subject)
 	})
 
 	t.Run("invalid payload - missing email", func(t *testing.T) {
@@ -77,6 +87,51 @@ func TestResetPassword(t *testing.T) {
 		_, err := queries.GetPasswordResetToken(ctx, token)
 
 		assert.Error(t, err)
+
+		_, err = queries.RotateRefreshToken(ctx, refreshToken)
+
+		assert.Error(t, err, "session should be revoked after password reset")
+	})
+
+	t.Run("password reset token used twice", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewResetPasswordReqBody("CareerCompass!456", "CareerCompass!456", token)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", "/api/password/reset", strings.NewReader(string(bodyJSON)))
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("concurrent requests with the same password reset token", func(t *testing.T) {
+		token, _ := queries.CreatePasswordResetToken(ctx, user.ID)
+
+		bodyRaw := models.NewResetPasswordReqBody("CareerCompass!789", "CareerCompass!789", token)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		codes := make([]int, 2)
+
+		var wg sync.WaitGroup
+		for i := range codes {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				w := httptest.NewRecorder()
+
+				req, _ := http.NewRequest("PUT", "/api/password/reset", strings.NewReader(string(bodyJSON)))
+
+				r.ServeHTTP(w, req)
+
+				codes[i] = w.Code
+			}()
+		}
+		wg.Wait()
+
+		assert.ElementsMatch(t, []int{http.StatusNoContent, http.StatusNotFound}, codes)
 	})
 
 	t.Run("invalid payload - mismatching passwords", func(t *testing.T) {
@@ -6,8 +6,10 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -186,7 +188,7 @@ func TestSendVerificationEmail(t *testing.T) {
 
 		newTkn, _ := queries.GetVerificationToken(ctx, user.ID)
 
-		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, http.StatusNoContent, w.Code)
 
 		assert.Equal(t, tkn.Token, newTkn.Token)
 		assert.Equal(t, tkn.ExpiresAt, newTkn.ExpiresAt)
@@ -205,9 +207,147 @@ func TestSendVerificationEmail(t *testing.T) {
 
 		renewedToken, _ := queries.GetVerificationToken(ctx, user.ID)
 
-		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, http.StatusNoContent, w.Code)
 
 		assert.NotEqual(t, expiredToken.Token, renewedToken.Token)
 		assert.NotEqual(t, expiredToken.ExpiresAt.Time.String(), renewedToken.ExpiresAt.Time.String())
+
+		mail.Reset()
+		outbox.Flush(ctx)
+
+		messages := mail.Messages()
+
+		assert.Len(t, messages, 2)
+		assert.Equal(t, "jakub.szewczyk@test.com", messages[1].To)
+		assert.Contains(t, messages[1].HTML, renewedToken.Token)
+	})
+}
+
+func TestPreferences(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	t.Run("valid request - defaults", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/profile/preferences", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.PreferencesResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "Europe/Warsaw", resBodyRaw.Timezone)
+		assert.Equal(t, "en-US", resBodyRaw.Locale)
+		assert.Equal(t, db.WeekStartMONDAY, resBodyRaw.WeekStart)
+		assert.Equal(t, "PLN", resBodyRaw.Currency)
+	})
+
+	t.Run("missing authorization token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/profile/preferences", nil)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestUpdatePreferences(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewUpdatePreferencesReqBody("America/New_York", "en-GB", db.WeekStartSUNDAY, "EUR")
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("PUT", "/api/profile/preferences", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpdatePreferencesResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "America/New_York", resBodyRaw.Timezone)
+		assert.Equal(t, "en-GB", resBodyRaw.Locale)
+		assert.Equal(t, db.WeekStartSUNDAY, resBodyRaw.WeekStart)
+		assert.Equal(t, "EUR", resBodyRaw.Currency)
+	})
+
+	t.Run("valid request - preferred currency used by default", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		minSalary, maxSalary := 50_000.00, 70_000.00
+
+		reqBody := models.NewCreateJobApplicationReqBody("", "Evil Corp Inc.", "Software Engineer", time.Now().Format(time.DateOnly), stages[0].ID.String(), &minSalary, &maxSalary, "", "", "", "", "", "")
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, "EUR", resBodyRaw.SalaryCurrency)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		for _, reqBody := range []models.UpdatePreferencesReqBody{
+			models.NewUpdatePreferencesReqBody("", "en-US", db.WeekStartMONDAY, "PLN"),
+			models.NewUpdatePreferencesReqBody("Europe/Atlantis", "en-US", db.WeekStartMONDAY, "PLN"),
+			models.NewUpdatePreferencesReqBody("Europe/Warsaw", "not a locale", db.WeekStartMONDAY, "PLN"),
+			models.NewUpdatePreferencesReqBody("Europe/Warsaw", "en-US", "TUESDAY", "PLN"),
+			models.NewUpdatePreferencesReqBody("Europe/Warsaw", "en-US", db.WeekStartMONDAY, "ZZZ"),
+		} {
+			w := httptest.NewRecorder()
+
+			reqBodyRaw, _ := json.Marshal(reqBody)
+
+			req, _ := http.NewRequest("PUT", "/api/profile/preferences", strings.NewReader(string(reqBodyRaw)))
+			req.Header.Add("Authorization", "Bearer "+token)
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		}
+	})
+
+	t.Run("invalid payload - currency without an exchange rate", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewUpdatePreferencesReqBody("Europe/Warsaw", "en-US", db.WeekStartMONDAY, "JPY")
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("PUT", "/api/profile/preferences", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 }
@@ -0,0 +1,453 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func setUpReminder(userId, jobApplicationId pgtype.UUID, remindAt time.Time, note string, unlessReplied bool) db.CreateReminderRow {
+	reminder, err := queries.CreateReminder(ctx, db.CreateReminderParams{
+		JobApplicationID: jobApplicationId,
+		UserID:           userId,
+		RemindAt:         pgtype.Timestamptz{Time: remindAt, Valid: true},
+		Note:             pgtype.Text{String: note, Valid: note != ""},
+		UnlessReplied:    unlessReplied,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return reminder
+}
+
+func TestReminders(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	softwareEngineer := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	iOSDeveloper := setUpJobApplication(user.ID, "Apple", "iOS Developer")
+
+	later := setUpReminder(user.ID, softwareEngineer.ID, time.Now().Add(time.Hour*48), "Ask about the next steps", true)
+	sooner := setUpReminder(user.ID, iOSDeveloper.ID, time.Now().Add(time.Hour*24), "", false)
+	dismissed := setUpReminder(user.ID, iOSDeveloper.ID, time.Now().Add(time.Hour*72), "", false)
+
+	queries.DismissReminder(ctx, db.DismissReminderParams{ID: dismissed.ID, UserID: user.ID})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/reminders", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.RemindersResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 3)
+		assert.Equal(t, sooner.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, "Apple", resBodyRaw.Data[0].CompanyName)
+		assert.Equal(t, later.ID.String(), resBodyRaw.Data[1].ID)
+		assert.Equal(t, "Ask about the next steps", resBodyRaw.Data[1].Note)
+		assert.True(t, resBodyRaw.Data[1].UnlessReplied)
+		assert.Equal(t, db.ReminderStatusPENDING, resBodyRaw.Data[1].Status)
+		assert.Nil(t, resBodyRaw.Data[1].DeliveredAt)
+		assert.Equal(t, db.ReminderStatusDISMISSED, resBodyRaw.Data[2].Status)
+	})
+
+	t.Run("valid request - filters", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/reminders?status=PENDING&job_application_id="+iOSDeveloper.ID.String(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.RemindersResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 1)
+		assert.Equal(t, sooner.ID.String(), resBodyRaw.Data[0].ID)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/reminders?status=SNOOZED", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCreateReminder(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	t.Run("valid request - after days", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewCreateReminderReqBody(nil, 7, "Follow up with the recruiter", true)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications/"+jobApplication.ID.String()+"/reminders", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateReminderResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.ID)
+		assert.Equal(t, jobApplication.ID.String(), resBodyRaw.JobApplicationID)
+		// NOTE: Days are added in the time zone of the user, so a change to or from daylight saving time in between doesn't shift the hour
+		warsaw, _ := time.LoadLocation("Europe/Warsaw")
+
+		assert.WithinDuration(t, time.Now().In(warsaw).AddDate(0, 0, 7), resBodyRaw.RemindAt, time.Minute)
+		assert.Equal(t, "Follow up with the recruiter", resBodyRaw.Note)
+		assert.True(t, resBodyRaw.UnlessReplied)
+		assert.False(t, resBodyRaw.IsSuggested)
+		assert.Equal(t, db.ReminderStatusPENDING, resBodyRaw.Status)
+	})
+
+	t.Run("valid request - remind at", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		remindAt := time.Date(2030, 3, 21, 9, 0, 0, 0, time.UTC)
+		reqBody := models.NewCreateReminderReqBody(&remindAt, 0, "", false)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications/"+jobApplication.ID.String()+"/reminders", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateReminderResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.True(t, remindAt.Equal(resBodyRaw.RemindAt))
+		assert.False(t, resBodyRaw.UnlessReplied)
+	})
+
+	t.Run("missing time", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewCreateReminderReqBody(nil, 0, "Follow up", false)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications/"+jobApplication.ID.String()+"/reminders", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("both times", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		remindAt := time.Now().Add(time.Hour)
+		reqBody := models.NewCreateReminderReqBody(&remindAt, 7, "", false)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications/"+jobApplication.ID.String()+"/reminders", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("non-existent job application", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewCreateReminderReqBody(nil, 7, "", false)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications/00000000-0000-0000-0000-000000000000/reminders", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestSnoozeReminder(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	reminder := setUpReminder(user.ID, jobApplication.ID, time.Now().Add(-time.Minute), "", false)
+
+	scheduler.Flush(ctx)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewSnoozeReminderReqBody(nil, 3)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/reminders/"+reminder.ID.String()+"/snooze", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.SnoozeReminderResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		warsaw, _ := time.LoadLocation("Europe/Warsaw")
+
+		assert.WithinDuration(t, time.Now().In(warsaw).AddDate(0, 0, 3), resBodyRaw.RemindAt, time.Minute)
+		assert.Equal(t, db.ReminderStatusPENDING, resBodyRaw.Status)
+		assert.Nil(t, resBodyRaw.DeliveredAt)
+	})
+
+	t.Run("missing time", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/reminders/"+reminder.ID.String()+"/snooze", strings.NewReader("{}"))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("non-existent reminder", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewSnoozeReminderReqBody(nil, 3)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/reminders/00000000-0000-0000-0000-000000000000/snooze", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDismissReminder(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	reminder := setUpReminder(user.ID, jobApplication.ID, time.Now().Add(-time.Minute), "", false)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/reminders/"+reminder.ID.String()+"/dismiss", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.DismissReminderResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, db.ReminderStatusDISMISSED, resBodyRaw.Status)
+
+		assert.NoError(t, scheduler.Flush(ctx))
+
+		emails, _ := queries.GetEmails(ctx)
+		assert.Empty(t, emails, "dismissed reminders shouldn't be sent")
+	})
+
+	t.Run("non-existent reminder", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/reminders/00000000-0000-0000-0000-000000000000/dismiss", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestReminderScheduler(t *testing.T) {
+	t.Run("due reminder delivered", func(t *testing.T) {
+		queries.Purge(ctx)
+		mail.Reset()
+
+		setUpUser(ctx)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+		due := setUpReminder(user.ID, jobApplication.ID, time.Now().Add(-time.Minute), "Ask about the next steps", false)
+		setUpReminder(user.ID, jobApplication.ID, time.Now().Add(time.Hour), "", false)
+
+		err := scheduler.Flush(ctx)
+
+		assert.NoError(t, err, "error flushing reminders")
+
+		reminders, _ := queries.GetReminders(ctx, db.GetRemindersParams{UserID: user.ID})
+
+		assert.Len(t, reminders, 2)
+		assert.Equal(t, due.ID, reminders[0].ID)
+		assert.Equal(t, db.ReminderStatusDELIVERED, reminders[0].Status)
+		assert.True(t, reminders[0].DeliveredAt.Valid)
+		assert.Equal(t, db.ReminderStatusPENDING, reminders[1].Status)
+
+		outbox.Flush(ctx)
+
+		messages := mail.Messages()
+
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "jakub.szewczyk@test.com", messages[0].To)
+		assert.Equal(t, "Reminder: Software Engineer at Evil Corp Inc.", messages[0].Subject)
+		assert.Contains(t, messages[0].HTML, "Ask about the next steps")
+
+		scheduler.Flush(ctx)
+
+		emails, _ := queries.GetEmails(ctx)
+		assert.Len(t, emails, 1, "delivered reminders shouldn't be sent again")
+	})
+
+	t.Run("replied application", func(t *testing.T) {
+		queries.Purge(ctx)
+
+		setUpUser(ctx)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+		queries.UpdateJobApplication(ctx, db.UpdateJobApplicationParams{
+			ID:        jobApplication.ID,
+			UserID:    user.ID,
+			IsReplied: pgtype.Bool{Bool: true, Valid: true},
+		})
+
+		setUpReminder(user.ID, jobApplication.ID, time.Now().Add(-time.Minute), "", true)
+
+		err := scheduler.Flush(ctx)
+
+		assert.NoError(t, err, "error flushing reminders")
+
+		reminders, _ := queries.GetReminders(ctx, db.GetRemindersParams{UserID: user.ID})
+
+		assert.Len(t, reminders, 1)
+		assert.Equal(t, db.ReminderStatusDISMISSED, reminders[0].Status)
+
+		emails, _ := queries.GetEmails(ctx)
+		assert.Empty(t, emails)
+	})
+
+	t.Run("follow-up suggested", func(t *testing.T) {
+		queries.Purge(ctx)
+		mail.Reset()
+
+		setUpUser(ctx)
+
+		user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+		stale, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+			UserID:      user.ID,
+			CompanyName: "Evil Corp Inc.",
+			JobTitle:    "Software Engineer",
+			DateApplied: pgtype.Date{Time: time.Now().AddDate(0, 0, -8), Valid: true},
+		})
+		replied, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+			UserID:      user.ID,
+			CompanyName: "Apple",
+			JobTitle:    "iOS Developer",
+			DateApplied: pgtype.Date{Time: time.Now().AddDate(0, 0, -8), Valid: true},
+		})
+		queries.UpdateJobApplication(ctx, db.UpdateJobApplicationParams{
+			ID:        replied.ID,
+			UserID:    user.ID,
+			IsReplied: pgtype.Bool{Bool: true, Valid: true},
+		})
+		// NOTE: Too recent
+		setUpJobApplication(user.ID, "Google", "Angular Developer")
+		// NOTE: Too old
+		queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+			UserID:      user.ID,
+			CompanyName: "Netflix",
+			JobTitle:    "Backend Developer",
+			DateApplied: pgtype.Date{Time: time.Now().AddDate(0, 0, -60), Valid: true},
+		})
+
+		err := scheduler.Flush(ctx)
+
+		assert.NoError(t, err, "error flushing reminders")
+
+		reminders, _ := queries.GetReminders(ctx, db.GetRemindersParams{UserID: user.ID})
+
+		assert.Len(t, reminders, 1)
+		assert.Equal(t, stale.ID, reminders[0].JobApplicationID)
+		assert.True(t, reminders[0].IsSuggested)
+		assert.True(t, reminders[0].UnlessReplied)
+		assert.Equal(t, db.ReminderStatusDELIVERED, reminders[0].Status)
+
+		outbox.Flush(ctx)
+
+		messages := mail.Messages()
+
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "Time to follow up with Evil Corp Inc.?", messages[0].Subject)
+		assert.Contains(t, messages[0].HTML, stale.DateApplied.Time.Format("January 2, 2006"), "dates should be formatted for the locale of the user")
+
+		queries.DismissReminder(ctx, db.DismissReminderParams{ID: reminders[0].ID, UserID: user.ID})
+
+		scheduler.Flush(ctx)
+
+		reminders, _ = queries.GetReminders(ctx, db.GetRemindersParams{UserID: user.ID})
+
+		assert.Len(t, reminders, 1, "dismissed follow-ups shouldn't be suggested again")
+	})
+}
@@ -13,7 +13,10 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jakub-szewczyk/career-compass-gin/api/handlers"
 	"github.com/jakub-szewczyk/career-compass-gin/api/routes"
+	"github.com/jakub-szewczyk/career-compass-gin/mailer"
+	"github.com/jakub-szewczyk/career-compass-gin/reminders"
 	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/storage"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -23,7 +26,12 @@ import (
 var r *gin.Engine
 var ctx context.Context
 var token string
+var refreshToken string
 var queries *db.Queries
+var mail *mailer.MemoryMailer
+var outbox *mailer.Outbox
+var scheduler *reminders.Scheduler
+var files *storage.FileStorage
 
 // FIXME: Return value is nil
 func setUpUser(ctx context.Context) (*db.CreateUserRow, error) {
@@ -37,8 +45,16 @@ func setUpUser(ctx context.Context) (*db.CreateUserRow, error) {
 		return nil, err
 	}
 
+	session, err := queries.CreateSession(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken = session.RefreshToken
+
 	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"uid": user.ID,
+		"sid": session.ID,
 		"sub": user.Email,
 		"exp": jwt.NewNumericDate(time.Now().Add(time.Hour * 24)),
 	})
@@ -94,7 +110,19 @@ func TestMain(m *testing.M) {
 
 	queries = db.New(conn)
 
-	r = routes.Setup(ctx, handlers.NewEnv(port.Port(), databaseURL, "testing", "", "", "", "", "", "http://localhost:5173", "", ""), queries)
+	mail = mailer.NewMemoryMailer()
+	outbox = mailer.NewOutbox(queries, mail)
+	scheduler = reminders.NewScheduler(conn, queries, 7, "http://localhost:5173")
+
+	storageDir, err := os.MkdirTemp("", "career-compass-gin-test-")
+	if err != nil {
+		log.Fatalf("failed to create storage directory: %s", err)
+	}
+	defer os.RemoveAll(storageDir)
+
+	files = storage.NewFileStorage(storageDir)
+
+	r = routes.Setup(ctx, handlers.NewEnv(port.Port(), databaseURL, "testing", "http://localhost:5173", "", ""), conn, queries, files)
 
 	code := m.Run()
 
@@ -0,0 +1,382 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStages(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	t.Run("valid request - default stages", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stages", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StagesResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 3)
+
+		assert.Equal(t, "In progress", resBodyRaw.Data[0].Name)
+		assert.Equal(t, int32(0), resBodyRaw.Data[0].Position)
+		assert.False(t, resBodyRaw.Data[0].IsTerminal)
+		assert.Equal(t, db.StageOutcomeNEUTRAL, resBodyRaw.Data[0].Outcome)
+
+		assert.Equal(t, "Rejected", resBodyRaw.Data[1].Name)
+		assert.Equal(t, int32(1), resBodyRaw.Data[1].Position)
+		assert.True(t, resBodyRaw.Data[1].IsTerminal)
+		assert.Equal(t, db.StageOutcomeNEGATIVE, resBodyRaw.Data[1].Outcome)
+
+		assert.Equal(t, "Accepted", resBodyRaw.Data[2].Name)
+		assert.Equal(t, int32(2), resBodyRaw.Data[2].Position)
+		assert.True(t, resBodyRaw.Data[2].IsTerminal)
+		assert.Equal(t, db.StageOutcomePOSITIVE, resBodyRaw.Data[2].Outcome)
+	})
+}
+
+func TestStage(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/stages/%v", stages[1].ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StageResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, stages[1].ID.String(), resBodyRaw.ID)
+		assert.Equal(t, stages[1].Name, resBodyRaw.Name)
+		assert.Equal(t, stages[1].Color, resBodyRaw.Color)
+	})
+
+	t.Run("non-existing stage", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stages/f4d15edc-e780-42b5-957d-c4352401d9ca", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCreateStage(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateStageReqBody("Tech interview", nil, "#7c3aed", false, "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/stages", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateStageResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.ID, "missing stage id")
+		assert.Equal(t, "Tech interview", resBodyRaw.Name)
+		assert.Equal(t, int32(3), resBodyRaw.Position, "stage should be appended after the default ones")
+		assert.Equal(t, "#7c3aed", resBodyRaw.Color)
+		assert.False(t, resBodyRaw.IsTerminal)
+		assert.Equal(t, db.StageOutcomeNEUTRAL, resBodyRaw.Outcome)
+
+		stages, _ := queries.GetStages(ctx, user.ID)
+
+		assert.Len(t, stages, 4)
+		assert.Equal(t, resBodyRaw.ID, stages[3].ID.String())
+	})
+
+	t.Run("valid request - terminal stage", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		position := int32(0)
+
+		bodyRaw := models.NewCreateStageReqBody("Ghosted", &position, "", true, db.StageOutcomeNEGATIVE)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/stages", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateStageResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, "Ghosted", resBodyRaw.Name)
+		assert.Equal(t, position, resBodyRaw.Position)
+		assert.Equal(t, "#64748b", resBodyRaw.Color)
+		assert.True(t, resBodyRaw.IsTerminal)
+		assert.Equal(t, db.StageOutcomeNEGATIVE, resBodyRaw.Outcome)
+	})
+
+	t.Run("invalid payload - duplicate name", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateStageReqBody("Rejected", nil, "", true, db.StageOutcomeNEGATIVE)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/stages", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "a stage with this name already exists", resBodyRaw.Error)
+	})
+
+	t.Run("invalid payload - missing name", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateStageReqBody("", nil, "", false, "")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/stages", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'Name' failed on the 'required' tag")
+	})
+
+	t.Run("invalid payload - incorrect color", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateStageReqBody("Offer", nil, "green", false, db.StageOutcomePOSITIVE)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/stages", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'Color' failed on the 'hexcolor' tag")
+	})
+
+	t.Run("invalid payload - incorrect outcome", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewCreateStageReqBody("Offer", nil, "", false, "UNKNOWN")
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("POST", "/api/stages", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, resBodyRaw.Error, "Field validation for 'Outcome' failed on the 'oneof' tag")
+	})
+}
+
+func TestUpdateStage(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		position := int32(5)
+		outcome := db.StageOutcomePOSITIVE
+
+		bodyRaw := models.NewUpdateStageReqBody("Screening", &position, "", nil, &outcome)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/stages/%v", stages[0].ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpdateStageResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, stages[0].ID.String(), resBodyRaw.ID)
+		assert.Equal(t, "Screening", resBodyRaw.Name)
+		assert.Equal(t, position, resBodyRaw.Position)
+		assert.Equal(t, stages[0].Color, resBodyRaw.Color)
+		assert.Equal(t, stages[0].IsTerminal, resBodyRaw.IsTerminal)
+		assert.Equal(t, outcome, resBodyRaw.Outcome)
+	})
+
+	t.Run("invalid payload - duplicate name", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewUpdateStageReqBody("Accepted", nil, "", nil, nil)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/stages/%v", stages[1].ID), strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "a stage with this name already exists", resBodyRaw.Error)
+	})
+
+	t.Run("non-existing stage", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		bodyRaw := models.NewUpdateStageReqBody("Screening", nil, "", nil, nil)
+		bodyJSON, _ := json.Marshal(bodyRaw)
+
+		req, _ := http.NewRequest("PUT", "/api/stages/f4d15edc-e780-42b5-957d-c4352401d9ca", strings.NewReader(string(bodyJSON)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteStage(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:      user.ID,
+		CompanyName: "Evil Corp Inc.",
+		JobTitle:    "Software Engineer",
+		DateApplied: pgtype.Date{Time: time.Now(), Valid: true},
+		StageID:     stages[0].ID,
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/stages/%v", stages[2].ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.DeleteStageResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, stages[2].ID.String(), resBodyRaw.ID)
+
+		remaining, _ := queries.GetStages(ctx, user.ID)
+
+		assert.Len(t, remaining, 2)
+	})
+
+	t.Run("stage in use", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/stages/%v", stages[0].ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "stage is still assigned to job applications", resBodyRaw.Error)
+	})
+
+	t.Run("non-existing stage", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", "/api/stages/f4d15edc-e780-42b5-957d-c4352401d9ca", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
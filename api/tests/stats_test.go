@@ -0,0 +1,370 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	stages, _ := queries.GetStages(ctx, user.ID)
+
+	softwareEngineer, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:      user.ID,
+		CompanyName: "Evil Corp Inc.",
+		JobTitle:    "Software Engineer",
+		DateApplied: pgtype.Date{Time: time.Now().Add(time.Hour * -24 * 2), Valid: true},
+		MinSalary:   pgtype.Float8{Float64: 50_000.00, Valid: true},
+		MaxSalary:   pgtype.Float8{Float64: 70_000.00, Valid: true},
+	})
+	seniorSoftwareEngineer, _ := queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:      user.ID,
+		CompanyName: "Evil Corp Inc.",
+		JobTitle:    "software engineer",
+		DateApplied: pgtype.Date{Time: time.Now().Add(time.Hour * -24 * 4), Valid: true},
+		MaxSalary:   pgtype.Float8{Float64: 90_000.00, Valid: true},
+	})
+	queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:      user.ID,
+		CompanyName: "Apple",
+		JobTitle:    "iOS Developer",
+		DateApplied: pgtype.Date{Time: time.Date(2025, 3, 14, 12, 34, 56, 0, time.UTC), Valid: true},
+	})
+
+	queries.UpdateJobApplication(ctx, db.UpdateJobApplicationParams{
+		ID:        softwareEngineer.ID,
+		UserID:    user.ID,
+		IsReplied: pgtype.Bool{Bool: true, Valid: true},
+	})
+	queries.UpdateJobApplication(ctx, db.UpdateJobApplicationParams{
+		ID:      seniorSoftwareEngineer.ID,
+		UserID:  user.ID,
+		StageID: stages[2].ID,
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StatsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 3, resBodyRaw.Total)
+		assert.Equal(t, 1, resBodyRaw.Replied)
+		assert.Equal(t, 1, resBodyRaw.Accepted)
+		assert.Equal(t, 0, resBodyRaw.Rejected)
+		assert.InDelta(t, 1.0/3, resBodyRaw.ReplyRate, 0.0001)
+		assert.InDelta(t, 1.0/3, resBodyRaw.AcceptanceRate, 0.0001)
+
+		assert.NotNil(t, resBodyRaw.MedianDaysToResponse)
+		assert.InDelta(t, 3, *resBodyRaw.MedianDaysToResponse, 0.1)
+
+		assert.Len(t, resBodyRaw.Stages, 3)
+		assert.Equal(t, stages[0].ID.String(), resBodyRaw.Stages[0].ID)
+		assert.Equal(t, 2, resBodyRaw.Stages[0].Current)
+		assert.Equal(t, 3, resBodyRaw.Stages[0].Reached)
+		assert.Equal(t, 0, resBodyRaw.Stages[1].Current)
+		assert.Equal(t, 0, resBodyRaw.Stages[1].Reached)
+		assert.Equal(t, 1, resBodyRaw.Stages[2].Current)
+		assert.Equal(t, 1, resBodyRaw.Stages[2].Reached)
+
+		weekly := 0
+		for _, week := range resBodyRaw.Weeks {
+			weekly += week.Count
+		}
+		assert.Equal(t, 3, weekly)
+		assert.Equal(t, "2025-03-10", resBodyRaw.Weeks[0].Week)
+
+		assert.Equal(t, 2, resBodyRaw.Salary.Count)
+		assert.Equal(t, 60_000.00, *resBodyRaw.Salary.Min)
+		assert.Equal(t, 75_000.00, *resBodyRaw.Salary.Median)
+		assert.Equal(t, 90_000.00, *resBodyRaw.Salary.Max)
+
+		assert.Empty(t, resBodyRaw.Groups)
+	})
+
+	t.Run("valid request - date range", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats?from=2025-03-01&to=2025-03-14", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StatsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 1, resBodyRaw.Total)
+		assert.Equal(t, 0.0, resBodyRaw.ReplyRate)
+		assert.Nil(t, resBodyRaw.MedianDaysToResponse)
+		assert.Equal(t, 0, resBodyRaw.Salary.Count)
+		assert.Nil(t, resBodyRaw.Salary.Median)
+	})
+
+	t.Run("valid request - week starting on sunday", func(t *testing.T) {
+		queries.UpdateUserPreferences(ctx, db.UpdateUserPreferencesParams{
+			ID:        user.ID,
+			Timezone:  "Europe/Warsaw",
+			Locale:    "en-US",
+			WeekStart: db.WeekStartSUNDAY,
+			Currency:  "PLN",
+		})
+		defer queries.UpdateUserPreferences(ctx, db.UpdateUserPreferencesParams{
+			ID:        user.ID,
+			Timezone:  "Europe/Warsaw",
+			Locale:    "en-US",
+			WeekStart: db.WeekStartMONDAY,
+			Currency:  "PLN",
+		})
+
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats?from=2025-03-01&to=2025-03-14", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StatsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Weeks, 1)
+		assert.Equal(t, "2025-03-09", resBodyRaw.Weeks[0].Week)
+	})
+
+	t.Run("valid request - empty date range", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats?to=2000-01-01", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StatsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 0, resBodyRaw.Total)
+		assert.Equal(t, 0.0, resBodyRaw.AcceptanceRate)
+		assert.Len(t, resBodyRaw.Stages, 3)
+		assert.Empty(t, resBodyRaw.Weeks)
+	})
+
+	t.Run("valid request - group by company", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats?group_by=company", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StatsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 3, resBodyRaw.Total)
+		assert.Len(t, resBodyRaw.Groups, 2)
+		assert.Equal(t, softwareEngineer.CompanyID.String(), resBodyRaw.Groups[0].Key)
+		assert.Equal(t, "Evil Corp Inc.", resBodyRaw.Groups[0].Name)
+		assert.Equal(t, 2, resBodyRaw.Groups[0].Total)
+		assert.Equal(t, 0.5, resBodyRaw.Groups[0].ReplyRate)
+		assert.Equal(t, 1, resBodyRaw.Groups[0].Stages[2].Current)
+		assert.Equal(t, "Apple", resBodyRaw.Groups[1].Name)
+		assert.Equal(t, 1, resBodyRaw.Groups[1].Total)
+		assert.Len(t, resBodyRaw.Groups[1].Weeks, 1)
+	})
+
+	t.Run("valid request - group by job title", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats?group_by=job_title", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StatsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Groups, 2)
+		assert.Equal(t, "software engineer", resBodyRaw.Groups[0].Key)
+		assert.Equal(t, 2, resBodyRaw.Groups[0].Total)
+	})
+
+	t.Run("valid request - salary in another currency and period", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats?salary_currency=EUR&salary_period=YEAR", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StatsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "EUR", resBodyRaw.Salary.Currency)
+		assert.Equal(t, db.SalaryPeriodYEAR, resBodyRaw.Salary.Period)
+		assert.Equal(t, 2, resBodyRaw.Salary.Count)
+		assert.InDelta(t, 60_000.00/4.25*12, *resBodyRaw.Salary.Min, 0.01)
+		assert.InDelta(t, 90_000.00/4.25*12, *resBodyRaw.Salary.Max, 0.01)
+	})
+
+	t.Run("valid request - salary type", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats?salary_type=NET", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StatsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 3, resBodyRaw.Total)
+		assert.Equal(t, 0, resBodyRaw.Salary.Count)
+	})
+
+	t.Run("valid request - preferred currency without an exchange rate", func(t *testing.T) {
+		queries.UpdateUserPreferences(ctx, db.UpdateUserPreferencesParams{
+			ID:        user.ID,
+			Timezone:  "Europe/Warsaw",
+			Locale:    "en-US",
+			WeekStart: db.WeekStartMONDAY,
+			Currency:  "JPY",
+		})
+		defer queries.UpdateUserPreferences(ctx, db.UpdateUserPreferencesParams{
+			ID:        user.ID,
+			Timezone:  "Europe/Warsaw",
+			Locale:    "en-US",
+			WeekStart: db.WeekStartMONDAY,
+			Currency:  "PLN",
+		})
+
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StatsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "JPY", resBodyRaw.Salary.Currency)
+		assert.Equal(t, 0, resBodyRaw.Salary.Count)
+	})
+
+	t.Run("invalid query params - currency without an exchange rate", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats?salary_currency=JPY", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid query params - unknown grouping", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats?group_by=stage", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid query params - incorrect date", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/stats?from=14-03-2025", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("valid request - job application created without a salary", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewCreateJobApplicationReqBody("", "Initech", "Backend Developer", "2000-01-01", "", nil, nil, "", "", "", "", "", "")
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/job-applications", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		w = httptest.NewRecorder()
+
+		req, _ = http.NewRequest("GET", "/api/stats?to=2000-01-01", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.StatsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 1, resBodyRaw.Total)
+		assert.Equal(t, 0, resBodyRaw.Salary.Count)
+		assert.Nil(t, resBodyRaw.Salary.Min)
+	})
+}
@@ -0,0 +1,464 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func setUpTag(userId pgtype.UUID, name, color string) db.CreateTagRow {
+	tag, err := queries.CreateTag(ctx, db.CreateTagParams{
+		UserID: userId,
+		Name:   name,
+		Color:  pgtype.Text{String: color, Valid: color != ""},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return tag
+}
+
+func setUpJobApplicationTag(userId, jobApplicationId, tagId pgtype.UUID) {
+	if _, err := queries.TagJobApplication(ctx, db.TagJobApplicationParams{
+		JobApplicationID: jobApplicationId,
+		TagID:            tagId,
+		UserID:           userId,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func TestTags(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	setUpTag(user.ID, "remote", "#7c3aed")
+	setUpTag(user.ID, "dream job", "")
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/tags", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.TagsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 2)
+		assert.Equal(t, "dream job", resBodyRaw.Data[0].Name)
+		assert.Equal(t, "#64748b", resBodyRaw.Data[0].Color)
+		assert.Equal(t, "remote", resBodyRaw.Data[1].Name)
+		assert.Equal(t, "#7c3aed", resBodyRaw.Data[1].Color)
+	})
+}
+
+func TestCreateTag(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewCreateTagReqBody("remote", "#7c3aed")
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/tags", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateTagResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.ID)
+		assert.Equal(t, "remote", resBodyRaw.Name)
+		assert.Equal(t, "#7c3aed", resBodyRaw.Color)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewCreateTagReqBody("remote", "")
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/tags", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "a tag with this name already exists", resBodyRaw.Error)
+	})
+
+	t.Run("invalid color", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewCreateTagReqBody("startup", "purple")
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/tags", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, resBodyRaw.Error, "Color")
+	})
+}
+
+func TestUpdateTag(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	tag := setUpTag(user.ID, "remote", "#7c3aed")
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewUpdateTagReqBody("", "#16a34a")
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/tags/%v", tag.ID), strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpdateTagResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, tag.ID.String(), resBodyRaw.ID)
+		assert.Equal(t, "remote", resBodyRaw.Name)
+		assert.Equal(t, "#16a34a", resBodyRaw.Color)
+	})
+
+	t.Run("non-existing tag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewUpdateTagReqBody("hybrid", "")
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("PUT", "/api/tags/f4d15edc-e780-42b5-957d-c4352401d9ca", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteTag(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	tag := setUpTag(user.ID, "remote", "")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+
+	setUpJobApplicationTag(user.ID, jobApplication.ID, tag.ID)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/tags/%v", tag.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.DeleteTagResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, tag.ID.String(), resBodyRaw.ID)
+
+		tags, _ := queries.GetJobApplicationTags(ctx, db.GetJobApplicationTagsParams{
+			JobApplicationIds: []pgtype.UUID{jobApplication.ID},
+			UserID:            user.ID,
+		})
+
+		assert.Empty(t, tags, "tag should be removed from the job application")
+	})
+
+	t.Run("non-existing tag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/tags/%v", tag.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestJobApplicationTags(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	remote := setUpTag(user.ID, "remote", "#7c3aed")
+	dreamJob := setUpTag(user.ID, "dream job", "")
+
+	evilCorp := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	apple := setUpJobApplication(user.ID, "Apple", "Frontend Developer")
+	setUpJobApplication(user.ID, "Microsoft", "Backend Developer")
+
+	t.Run("valid request - tag", func(t *testing.T) {
+		for _, tag := range []db.CreateTagRow{remote, dreamJob} {
+			w := httptest.NewRecorder()
+
+			req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v/tags/%v", evilCorp.ID, tag.ID), nil)
+			req.Header.Add("Authorization", "Bearer "+token)
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+		}
+
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v/tags/%v", apple.ID, remote.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationTagsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 1)
+		assert.Equal(t, remote.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, "#7c3aed", resBodyRaw.Data[0].Color)
+	})
+
+	t.Run("valid request - tag twice", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v/tags/%v", apple.ID, remote.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationTagsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, resBodyRaw.Data, 1)
+	})
+
+	t.Run("valid request - tags in job application entries", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?sort=company_name", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 3, resBodyRaw.Total)
+		assert.Len(t, resBodyRaw.Data[0].Tags, 1)
+		assert.Len(t, resBodyRaw.Data[1].Tags, 2)
+		assert.Equal(t, "dream job", resBodyRaw.Data[1].Tags[0].Name)
+		assert.Equal(t, "remote", resBodyRaw.Data[1].Tags[1].Name)
+		assert.NotNil(t, resBodyRaw.Data[2].Tags)
+		assert.Empty(t, resBodyRaw.Data[2].Tags)
+	})
+
+	t.Run("valid request - tags in job application details", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications/%v", evilCorp.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, resBodyRaw.Tags, 2)
+	})
+
+	t.Run("valid request - filter by any tag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications?tags=%v&tags=%v", remote.ID, dreamJob.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 2, resBodyRaw.Total)
+	})
+
+	t.Run("valid request - filter by all tags", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications?tags=%v&tags=%v&tags_match=all", remote.ID, dreamJob.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, resBodyRaw.Total)
+		assert.Equal(t, evilCorp.ID.String(), resBodyRaw.Data[0].ID)
+	})
+
+	t.Run("valid request - filter by all tags with duplicates", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications?tags=%v&tags=%v&tags_match=all", remote.ID, remote.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 2, resBodyRaw.Total)
+	})
+
+	t.Run("invalid tag filter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?tags=remote", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid tag match", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/job-applications?tags=%v&tags_match=some", remote.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("valid request - untag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/job-applications/%v/tags/%v", evilCorp.ID, remote.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationTagsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, resBodyRaw.Data, 1)
+		assert.Equal(t, dreamJob.ID.String(), resBodyRaw.Data[0].ID)
+	})
+
+	t.Run("non-existing tag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/%v/tags/f4d15edc-e780-42b5-957d-c4352401d9ca", evilCorp.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("non-existing job application", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/job-applications/f4d15edc-e780-42b5-957d-c4352401d9ca/tags/%v", remote.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("non-existing tagging", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/job-applications/%v/tags/%v", evilCorp.ID, remote.ID), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,192 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/storage"
+	"github.com/jakub-szewczyk/career-compass-gin/trash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrash(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	evilCorp := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	setUpJobApplication(user.ID, "Apple", "Frontend Developer")
+
+	t.Run("deleting moves to the trash", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", "/api/job-applications/"+evilCorp.ID.String(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.DeleteJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.WithinDuration(t, time.Now(), resBodyRaw.DeletedAt, time.Minute)
+		assert.Equal(t, resBodyRaw.DeletedAt.AddDate(0, 0, 30), resBodyRaw.PurgeAt)
+
+		jobApplications, _ := queries.GetJobApplications(ctx, db.GetJobApplicationsParams{UserID: user.ID, Limit: 100})
+
+		assert.Len(t, jobApplications, 1)
+		assert.Equal(t, "Apple", jobApplications[0].CompanyName)
+	})
+
+	t.Run("trashed job application not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications/"+evilCorp.ID.String(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("deleting twice", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", "/api/job-applications/"+evilCorp.ID.String(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/trash", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.TrashResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 1)
+		assert.Equal(t, evilCorp.ID.String(), resBodyRaw.Data[0].ID)
+		assert.Equal(t, "Evil Corp Inc.", resBodyRaw.Data[0].CompanyName)
+		assert.Equal(t, resBodyRaw.Data[0].DeletedAt.AddDate(0, 0, 30), resBodyRaw.Data[0].PurgeAt)
+	})
+}
+
+func TestRestoreJobApplication(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	jobApplication := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	setUpReminder(user.ID, jobApplication.ID, time.Now().Add(time.Hour*24), "Ask about the offer", false)
+
+	queries.DeleteJobApplication(ctx, db.DeleteJobApplicationParams{ID: jobApplication.ID, UserID: user.ID})
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/job-applications/"+jobApplication.ID.String()+"/restore", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.RestoreJobApplicationResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, jobApplication.ID.String(), resBodyRaw.ID)
+		assert.Equal(t, "Evil Corp Inc.", resBodyRaw.CompanyName)
+
+		trashed, _ := queries.GetTrashedJobApplications(ctx, user.ID)
+
+		assert.Len(t, trashed, 0)
+
+		reminders, _ := queries.GetReminders(ctx, db.GetRemindersParams{UserID: user.ID})
+
+		assert.Len(t, reminders, 1, "reminders should be restored too")
+	})
+
+	t.Run("not in the trash", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("POST", "/api/job-applications/"+jobApplication.ID.String()+"/restore", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPurger(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	trashed := setUpJobApplication(user.ID, "Evil Corp Inc.", "Software Engineer")
+	kept := setUpJobApplication(user.ID, "Apple", "Frontend Developer")
+
+	attachment := setUpAttachment(user.ID, trashed.ID, "cv.pdf", pdf, db.AttachmentKindCV)
+	stored, _ := queries.GetAttachment(ctx, db.GetAttachmentParams{ID: attachment.ID, JobApplicationID: trashed.ID, UserID: user.ID})
+
+	queries.DeleteJobApplication(ctx, db.DeleteJobApplicationParams{ID: trashed.ID, UserID: user.ID})
+
+	t.Run("recently trashed kept", func(t *testing.T) {
+		err := trash.NewPurger(queries, files, trash.RetentionDays).Flush(ctx)
+
+		assert.NoError(t, err, "error purging trash")
+
+		jobApplications, _ := queries.GetTrashedJobApplications(ctx, user.ID)
+
+		assert.Len(t, jobApplications, 1)
+
+		_, err = files.Get(ctx, stored.StorageKey)
+
+		assert.NoError(t, err, "contents should be kept")
+	})
+
+	t.Run("trashed past the retention period purged", func(t *testing.T) {
+		err := trash.NewPurger(queries, files, 0).Flush(ctx)
+
+		assert.NoError(t, err, "error purging trash")
+
+		jobApplications, _ := queries.GetTrashedJobApplications(ctx, user.ID)
+
+		assert.Len(t, jobApplications, 0)
+
+		_, err = files.Get(ctx, stored.StorageKey)
+
+		assert.ErrorIs(t, err, storage.ErrNotFound, "contents should be deleted")
+
+		_, err = queries.GetJobApplication(ctx, db.GetJobApplicationParams{ID: kept.ID, UserID: user.ID})
+
+		assert.NoError(t, err, "job applications outside the trash should be kept")
+	})
+}
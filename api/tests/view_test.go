@@ -0,0 +1,367 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/api/models"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func setUpView(userId pgtype.UUID, name, query string, isDefault bool) db.CreateViewRow {
+	view, err := queries.CreateView(ctx, db.CreateViewParams{
+		UserID:    userId,
+		Name:      name,
+		Query:     query,
+		IsDefault: isDefault,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return view
+}
+
+func TestViews(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	setUpView(user.ID, "Recent", "applied_within_days=7", false)
+	setUpView(user.ID, "Best paid", "sort=-salary", true)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/views", nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.ViewsResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, resBodyRaw.Data, 2)
+		assert.Equal(t, "Best paid", resBodyRaw.Data[0].Name)
+		assert.True(t, resBodyRaw.Data[0].IsDefault)
+		assert.Equal(t, "Recent", resBodyRaw.Data[1].Name)
+		assert.Equal(t, "applied_within_days=7", resBodyRaw.Data[1].Query)
+		assert.False(t, resBodyRaw.Data[1].IsDefault)
+	})
+}
+
+func TestCreateView(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	previous := setUpView(user.ID, "Best paid", "sort=-salary", true)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewCreateViewReqBody("Waiting for a reply", "?outcome=NEUTRAL&is_replied=false&size=25", true)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/views", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.CreateViewResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		assert.NotEmpty(t, resBodyRaw.ID)
+		assert.Equal(t, "Waiting for a reply", resBodyRaw.Name)
+		assert.Equal(t, "is_replied=false&outcome=NEUTRAL&size=25", resBodyRaw.Query)
+		assert.True(t, resBodyRaw.IsDefault)
+
+		view, _ := queries.GetView(ctx, db.GetViewParams{ID: previous.ID, UserID: user.ID})
+		assert.False(t, view.IsDefault)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewCreateViewReqBody("Best paid", "", false)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("POST", "/api/views", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "a view with this name already exists", resBodyRaw.Error)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		for _, query := range []string{"page=2", "cursor=", "sort=-unknown", "size=-1", "stage_id=not-a-uuid", "unknown=1"} {
+			w := httptest.NewRecorder()
+
+			reqBody := models.NewCreateViewReqBody("Broken", query, false)
+			reqBodyRaw, _ := json.Marshal(reqBody)
+
+			req, _ := http.NewRequest("POST", "/api/views", strings.NewReader(string(reqBodyRaw)))
+			req.Header.Add("Authorization", "Bearer "+token)
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		}
+	})
+}
+
+func TestUpdateView(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	recent := setUpView(user.ID, "Recent", "applied_within_days=7", false)
+	bestPaid := setUpView(user.ID, "Best paid", "sort=-salary", true)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		query := "applied_within_days=14"
+		isDefault := true
+		reqBody := models.NewUpdateViewReqBody("", &query, &isDefault)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("PUT", "/api/views/"+recent.ID.String(), strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.UpdateViewResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "Recent", resBodyRaw.Name)
+		assert.Equal(t, "applied_within_days=14", resBodyRaw.Query)
+		assert.True(t, resBodyRaw.IsDefault)
+
+		view, _ := queries.GetView(ctx, db.GetViewParams{ID: bestPaid.ID, UserID: user.ID})
+		assert.False(t, view.IsDefault)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewUpdateViewReqBody("Best paid", nil, nil)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("PUT", "/api/views/"+recent.ID.String(), strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.Error
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "a view with this name already exists", resBodyRaw.Error)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		query := "sort=salary,-salary"
+		reqBody := models.NewUpdateViewReqBody("", &query, nil)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("PUT", "/api/views/"+recent.ID.String(), strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("non-existent view", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		reqBody := models.NewUpdateViewReqBody("Gone", nil, nil)
+		reqBodyRaw, _ := json.Marshal(reqBody)
+
+		req, _ := http.NewRequest("PUT", "/api/views/00000000-0000-0000-0000-000000000000", strings.NewReader(string(reqBodyRaw)))
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteView(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	view := setUpView(user.ID, "Recent", "applied_within_days=7", true)
+
+	t.Run("valid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", "/api/views/"+view.ID.String(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.DeleteViewResBody
+		err := json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		assert.NoError(t, err, "error unmarshaling response body")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, view.ID.String(), resBodyRaw.ID)
+
+		views, _ := queries.GetViews(ctx, user.ID)
+		assert.Empty(t, views)
+	})
+
+	t.Run("non-existent view", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("DELETE", "/api/views/"+view.ID.String(), nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestJobApplicationsView(t *testing.T) {
+	queries.Purge(ctx)
+
+	setUpUser(ctx)
+
+	user, _ := queries.GetUserByEmail(ctx, "jakub.szewczyk@test.com")
+
+	queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:      user.ID,
+		CompanyName: "Evil Corp Inc.",
+		JobTitle:    "Software Engineer",
+		DateApplied: pgtype.Date{Time: time.Now().Add(time.Hour * -24 * 30), Valid: true},
+		MinSalary:   pgtype.Float8{Float64: 50_000.00, Valid: true},
+	})
+	queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:      user.ID,
+		CompanyName: "Apple",
+		JobTitle:    "iOS Developer",
+		DateApplied: pgtype.Date{Time: time.Now().Add(time.Hour * -24 * 3), Valid: true},
+		MinSalary:   pgtype.Float8{Float64: 100_000.00, Valid: true},
+	})
+	queries.CreateJobApplication(ctx, db.CreateJobApplicationParams{
+		UserID:      user.ID,
+		CompanyName: "Google",
+		JobTitle:    "Angular Developer",
+		DateApplied: pgtype.Date{Time: time.Now(), Valid: true},
+		MinSalary:   pgtype.Float8{Float64: 70_000.00, Valid: true},
+	})
+
+	recent := setUpView(user.ID, "Recent", "applied_within_days=7&sort=-salary&size=1", false)
+
+	list := func(t *testing.T, query string) (int, models.JobApplicationsResBody) {
+		w := httptest.NewRecorder()
+
+		req, _ := http.NewRequest("GET", "/api/job-applications?"+query, nil)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		r.ServeHTTP(w, req)
+
+		var resBodyRaw models.JobApplicationsResBody
+		json.Unmarshal(w.Body.Bytes(), &resBodyRaw)
+
+		return w.Code, resBodyRaw
+	}
+
+	t.Run("applied within days", func(t *testing.T) {
+		code, resBodyRaw := list(t, "applied_within_days=7")
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 2, resBodyRaw.Total)
+	})
+
+	t.Run("saved view", func(t *testing.T) {
+		code, resBodyRaw := list(t, "view="+recent.ID.String())
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 1, resBodyRaw.Size)
+		assert.Equal(t, 2, resBodyRaw.Total)
+		assert.Len(t, resBodyRaw.Data, 1)
+		assert.Equal(t, "iOS Developer", resBodyRaw.Data[0].JobTitle)
+	})
+
+	t.Run("saved view with overrides", func(t *testing.T) {
+		code, resBodyRaw := list(t, "view="+recent.ID.String()+"&sort=salary&size=10")
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, resBodyRaw.Data, 2)
+		assert.Equal(t, "Angular Developer", resBodyRaw.Data[0].JobTitle)
+		assert.Equal(t, "iOS Developer", resBodyRaw.Data[1].JobTitle)
+	})
+
+	t.Run("default view", func(t *testing.T) {
+		code, resBodyRaw := list(t, "view=default")
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 3, resBodyRaw.Total)
+
+		queries.UpdateView(ctx, db.UpdateViewParams{
+			ID:        recent.ID,
+			UserID:    user.ID,
+			IsDefault: pgtype.Bool{Bool: true, Valid: true},
+		})
+
+		code, resBodyRaw = list(t, "view=default")
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 2, resBodyRaw.Total)
+		assert.Len(t, resBodyRaw.Data, 1)
+	})
+
+	t.Run("invalid view", func(t *testing.T) {
+		code, _ := list(t, "view=recent")
+
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("non-existent view", func(t *testing.T) {
+		code, _ := list(t, "view=00000000-0000-0000-0000-000000000000")
+
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
@@ -0,0 +1,45 @@
+package idempotency
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+const sweeperInterval = time.Hour
+
+// Sweeper deletes idempotency keys once they've expired. An expired key is already taken over by the next request using it,
+// so this only keeps the table from growing, away from the request path.
+type Sweeper struct {
+	queries *db.Queries
+}
+
+func NewSweeper(queries *db.Queries) *Sweeper {
+	return &Sweeper{
+		queries: queries,
+	}
+}
+
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(sweeperInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Flush(ctx); err != nil {
+			log.Println("error sweeping idempotency keys:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush deletes every expired idempotency key
+func (s *Sweeper) Flush(ctx context.Context) error {
+	return s.queries.DeleteExpiredIdempotencyKeys(ctx)
+}
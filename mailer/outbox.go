@@ -0,0 +1,117 @@
+package mailer
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+)
+
+const (
+	outboxInterval    = time.Second * 5
+	outboxBatchSize   = 20
+	outboxMaxAttempts = 8
+	outboxBaseBackoff = time.Second * 30
+	outboxMaxBackoff  = time.Hour * 6
+	outboxSendTimeout = time.Second * 30
+	// NOTE: Long enough for every email of a batch to time out in turn, with a margin for marking them as sent or failed
+	outboxLease = outboxBatchSize*outboxSendTimeout + time.Minute
+)
+
+// Outbox delivers emails queued in the email_outbox table. Failed deliveries are retried
+// with exponential backoff and dead-lettered after outboxMaxAttempts.
+type Outbox struct {
+	queries *db.Queries
+	mailer  Mailer
+}
+
+func NewOutbox(queries *db.Queries, mailer Mailer) *Outbox {
+	return &Outbox{
+		queries: queries,
+		mailer:  mailer,
+	}
+}
+
+func (o *Outbox) Run(ctx context.Context) {
+	ticker := time.NewTicker(outboxInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := o.Flush(ctx); err != nil {
+			log.Println("error flushing email outbox:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush sends every email that is currently due
+func (o *Outbox) Flush(ctx context.Context) error {
+	for {
+		emails, err := o.queries.ClaimEmails(ctx, db.ClaimEmailsParams{
+			LeaseSeconds: int32(outboxLease.Seconds()),
+			BatchSize:    outboxBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, email := range emails {
+			if err := o.deliver(ctx, email); err != nil {
+				return err
+			}
+		}
+
+		if len(emails) < outboxBatchSize {
+			return nil
+		}
+	}
+}
+
+func (o *Outbox) deliver(ctx context.Context, email db.ClaimEmailsRow) error {
+	// NOTE: A single slow delivery can't hold up the emails queued behind it
+	sendCtx, cancel := context.WithTimeout(ctx, outboxSendTimeout)
+	defer cancel()
+
+	err := o.mailer.Send(sendCtx, Message{
+		To:      email.Recipient,
+		Subject: email.Subject,
+		HTML:    email.Body,
+	})
+	if err == nil {
+		return o.queries.MarkEmailSent(ctx, email.ID)
+	}
+
+	attempts := int(email.Attempts) + 1
+
+	status := db.EmailStatusPENDING
+	if attempts >= outboxMaxAttempts {
+		status = db.EmailStatusDEAD
+		log.Printf("email %v dead-lettered after %v attempts: %v\n", email.ID.String(), attempts, err)
+	}
+
+	return o.queries.MarkEmailFailed(ctx, db.MarkEmailFailedParams{
+		ID:            email.ID,
+		Status:        status,
+		LastError:     pgtype.Text{String: err.Error(), Valid: true},
+		NextAttemptAt: pgtype.Timestamptz{Time: time.Now().Add(backoff(attempts)), Valid: true},
+	})
+}
+
+// backoff doubles the delay after every failed attempt, up to outboxMaxBackoff
+func backoff(attempts int) time.Duration {
+	delay := outboxBaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= outboxMaxBackoff {
+			return outboxMaxBackoff
+		}
+	}
+	return delay
+}
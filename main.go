@@ -4,11 +4,19 @@ import (
 	"context"
 	"log"
 	"os"
+	"strconv"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jakub-szewczyk/career-compass-gin/api/handlers"
 	"github.com/jakub-szewczyk/career-compass-gin/api/routes"
+	"github.com/jakub-szewczyk/career-compass-gin/idempotency"
+	"github.com/jakub-szewczyk/career-compass-gin/mailer"
+	"github.com/jakub-szewczyk/career-compass-gin/reminders"
 	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/storage"
+	"github.com/jakub-szewczyk/career-compass-gin/trash"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
 	"github.com/joho/godotenv"
 )
 
@@ -70,6 +78,80 @@ func main() {
 		log.Fatal("missing env var: RESET_PASSWORD_URL")
 	}
 
+	// NOTE: Optional, 0 turns follow-up suggestions off
+	followUpAfterDays := 7
+	if v := os.Getenv("FOLLOW_UP_AFTER_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days < 0 {
+			log.Fatal("invalid env var: FOLLOW_UP_AFTER_DAYS")
+		}
+		followUpAfterDays = days
+	}
+
+	// NOTE: Optional, currencies left out keep the rates already in the database
+	exchangeRates := map[string]float64{}
+	if v := os.Getenv("EXCHANGE_RATES"); v != "" {
+		rates, err := utils.ParseExchangeRates(v)
+		if err != nil {
+			log.Fatal("invalid env var: EXCHANGE_RATES")
+		}
+		exchangeRates = rates
+	}
+
+	var m mailer.Mailer
+	switch os.Getenv("MAILER") {
+	case "", "smtp":
+		m = mailer.NewSMTPMailer(smtpIdentity, smtpUsername, smtpPassword, smtpHost, smtpPort)
+	case "mbox":
+		mboxPath := os.Getenv("MAILER_MBOX_PATH")
+		if mboxPath == "" {
+			log.Fatal("missing env var: MAILER_MBOX_PATH")
+		}
+		m = mailer.NewFileMailer(mboxPath, smtpUsername)
+	case "memory":
+		m = mailer.NewMemoryMailer()
+	default:
+		log.Fatal("invalid env var: MAILER")
+	}
+
+	var s storage.Storage
+	switch os.Getenv("STORAGE") {
+	case "", "file":
+		storageDir := os.Getenv("STORAGE_DIR")
+		if storageDir == "" {
+			log.Fatal("missing env var: STORAGE_DIR")
+		}
+		s = storage.NewFileStorage(storageDir)
+	case "s3":
+		s3Endpoint := os.Getenv("S3_ENDPOINT")
+		if s3Endpoint == "" {
+			log.Fatal("missing env var: S3_ENDPOINT")
+		}
+		s3Region := os.Getenv("S3_REGION")
+		if s3Region == "" {
+			log.Fatal("missing env var: S3_REGION")
+		}
+		s3Bucket := os.Getenv("S3_BUCKET")
+		if s3Bucket == "" {
+			log.Fatal("missing env var: S3_BUCKET")
+		}
+		s3AccessKeyID := os.Getenv("S3_ACCESS_KEY_ID")
+		if s3AccessKeyID == "" {
+			log.Fatal("missing env var: S3_ACCESS_KEY_ID")
+		}
+		s3SecretAccessKey := os.Getenv("S3_SECRET_ACCESS_KEY")
+		if s3SecretAccessKey == "" {
+			log.Fatal("missing env var: S3_SECRET_ACCESS_KEY")
+		}
+		s3Storage, err := storage.NewS3Storage(s3Endpoint, s3Region, s3Bucket, s3AccessKeyID, s3SecretAccessKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		s = s3Storage
+	default:
+		log.Fatal("invalid env var: STORAGE")
+	}
+
 	ctx := context.Background()
 
 	pool, err := pgxpool.New(ctx, databaseURL)
@@ -80,7 +162,21 @@ func main() {
 
 	queries := db.New(pool)
 
-	r := routes.Setup(ctx, handlers.NewEnv(port, databaseURL, jwtSecret, smtpIdentity, smtpUsername, smtpPassword, smtpHost, smtpPort, frontendURL, emailVerificationURL, resetPasswordURL), queries)
+	for currency, rate := range exchangeRates {
+		if err := queries.UpsertExchangeRate(ctx, db.UpsertExchangeRateParams{
+			Currency: currency,
+			Rate:     pgtype.Float8{Float64: rate, Valid: true},
+		}); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	go mailer.NewOutbox(queries, m).Run(ctx)
+	go reminders.NewScheduler(pool, queries, followUpAfterDays, frontendURL).Run(ctx)
+	go trash.NewPurger(queries, s, trash.RetentionDays).Run(ctx)
+	go idempotency.NewSweeper(queries).Run(ctx)
+
+	r := routes.Setup(ctx, handlers.NewEnv(port, databaseURL, jwtSecret, frontendURL, emailVerificationURL, resetPasswordURL), pool, queries, s)
 
 	err = r.Run(":" + port)
 	if err != nil {
@@ -0,0 +1,153 @@
+package reminders
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jakub-szewczyk/career-compass-gin/mailer"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/utils"
+)
+
+const (
+	schedulerInterval  = time.Minute
+	schedulerBatchSize = 20
+)
+
+type Conn interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Scheduler suggests follow-ups for applications left without a reply and delivers reminders once they're due.
+// Days since applying are counted in the time zone of each user, and dates in emails are formatted for their locale.
+// Reminder emails are queued in the email outbox, in the same transaction that marks them as delivered (see mailer.Outbox).
+type Scheduler struct {
+	conn              Conn
+	queries           *db.Queries
+	followUpAfterDays int
+	frontendURL       string
+}
+
+// NOTE: Follow-ups aren't suggested at all when followUpAfterDays is 0
+func NewScheduler(conn Conn, queries *db.Queries, followUpAfterDays int, frontendURL string) *Scheduler {
+	return &Scheduler{
+		conn:              conn,
+		queries:           queries,
+		followUpAfterDays: followUpAfterDays,
+		frontendURL:       frontendURL,
+	}
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(schedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Flush(ctx); err != nil {
+			log.Println("error flushing reminders:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush suggests new follow-ups, then delivers every reminder that is currently due
+func (s *Scheduler) Flush(ctx context.Context) error {
+	if s.followUpAfterDays > 0 {
+		if _, err := s.queries.SuggestFollowUpReminders(ctx, int32(s.followUpAfterDays)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		n, err := s.deliverBatch(ctx)
+		if err != nil {
+			return err
+		}
+
+		if n < schedulerBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *Scheduler) deliverBatch(ctx context.Context) (int, error) {
+	tx, err := s.conn.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	queries := s.queries.WithTx(tx)
+
+	reminders, err := queries.ClaimDueReminders(ctx, schedulerBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, reminder := range reminders {
+		if reminder.UnlessReplied && reminder.IsReplied {
+			if err := queries.MarkReminderDismissed(ctx, reminder.ID); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if err := s.enqueue(ctx, queries, reminder); err != nil {
+			return 0, err
+		}
+
+		if err := queries.MarkReminderDelivered(ctx, reminder.ID); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(reminders), tx.Commit(ctx)
+}
+
+func (s *Scheduler) enqueue(ctx context.Context, queries *db.Queries, reminder db.ClaimDueRemindersRow) error {
+	subject := fmt.Sprintf("Reminder: %v at %v", reminder.JobTitle, reminder.CompanyName)
+	if reminder.IsSuggested {
+		subject = fmt.Sprintf("Time to follow up with %v?", reminder.CompanyName)
+	}
+
+	html, err := mailer.Render("reminder.html", struct {
+		Subject     string
+		FirstName   string
+		CompanyName string
+		JobTitle    string
+		DateApplied string
+		Note        string
+		IsSuggested bool
+		Link        string
+		Year        int
+	}{
+		Subject:     subject,
+		FirstName:   reminder.FirstName,
+		CompanyName: reminder.CompanyName,
+		JobTitle:    reminder.JobTitle,
+		DateApplied: utils.FormatDate(reminder.DateApplied.Time, reminder.Locale),
+		Note:        reminder.Note.String,
+		IsSuggested: reminder.IsSuggested,
+		Link:        s.frontendURL,
+		Year:        time.Now().Year(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = queries.EnqueueEmail(ctx, db.EnqueueEmailParams{
+		Recipient: reminder.Email,
+		Subject:   subject,
+		Body:      html,
+	})
+
+	return err
+}
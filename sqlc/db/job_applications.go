@@ -0,0 +1,305 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// NOTE: Hand-written, since sqlc can't generate a query whose ORDER BY depends on the request.
+// Column names and sort directions only ever come from the whitelists below, every value is passed as an argument.
+type JobApplicationsSortColumn string
+
+const (
+	JobApplicationsSortCompanyName JobApplicationsSortColumn = "company_name"
+	JobApplicationsSortJobTitle    JobApplicationsSortColumn = "job_title"
+	JobApplicationsSortDateApplied JobApplicationsSortColumn = "date_applied"
+	JobApplicationsSortStage       JobApplicationsSortColumn = "stage"
+	JobApplicationsSortSalary      JobApplicationsSortColumn = "salary"
+	JobApplicationsSortIsReplied   JobApplicationsSortColumn = "is_replied"
+	JobApplicationsSortRelevance   JobApplicationsSortColumn = "relevance"
+)
+
+var jobApplicationsSortExpressions = map[JobApplicationsSortColumn]string{
+	JobApplicationsSortCompanyName: "company_name",
+	JobApplicationsSortJobTitle:    "job_title",
+	JobApplicationsSortDateApplied: "date_applied",
+	JobApplicationsSortStage:       "stage_position",
+	JobApplicationsSortSalary:      "salary",
+	JobApplicationsSortIsReplied:   "is_replied",
+	JobApplicationsSortRelevance:   "rank",
+}
+
+type JobApplicationsSortKey struct {
+	Column JobApplicationsSortColumn
+	Desc   bool
+}
+
+type GetJobApplicationsParams struct {
+	Limit                 int32
+	Offset                int32
+	UserID                pgtype.UUID
+	Query                 pgtype.Text
+	WithTotal             bool
+	Sort                  []JobApplicationsSortKey // NOTE: The id always breaks ties
+	CompanyNameOrJobTitle string
+	DateApplied           pgtype.Date
+	DateAppliedFrom       pgtype.Date
+	DateAppliedTo         pgtype.Date
+	AppliedWithinDays     int32  // NOTE: Including today, 0 for no limit
+	Timezone              string // NOTE: Time zone today is taken in
+	StageIDs              []pgtype.UUID
+	StageOutcome          NullStageOutcome
+	MinSalary             pgtype.Float8 // NOTE: Matches salary ranges overlapping the given one
+	MaxSalary             pgtype.Float8
+	SalaryCurrency        string // NOTE: Salaries are converted to this currency and period before being filtered and sorted by
+	SalaryPeriod          SalaryPeriod
+	SalaryType            NullSalaryType // NOTE: Gross and net amounts aren't converted into one another, so this keeps them apart
+	IsReplied             pgtype.Bool
+	TagIds                []pgtype.UUID
+	TagsMatchAll          bool
+	After                 []any // NOTE: Sort key values of the row to continue after, one per sort key, nil for NULL
+	AfterID               pgtype.UUID
+	ForUpdate             bool // NOTE: Locks every matching row until the end of the transaction, so that it still matches when changed
+}
+
+type GetJobApplicationsRow struct {
+	ID              pgtype.UUID      `json:"id"`
+	CompanyID       pgtype.UUID      `json:"companyId"`
+	CompanyName     string           `json:"companyName"`
+	JobTitle        string           `json:"jobTitle"`
+	DateApplied     pgtype.Date      `json:"dateApplied"`
+	StageID         pgtype.UUID      `json:"stageId"`
+	StageName       string           `json:"stageName"`
+	StageColor      string           `json:"stageColor"`
+	StagePosition   int32            `json:"stagePosition"`
+	StageIsTerminal bool             `json:"stageIsTerminal"`
+	StageOutcome    StageOutcome     `json:"stageOutcome"`
+	IsReplied       bool             `json:"isReplied"`
+	MinSalary       pgtype.Float8    `json:"minSalary"`
+	MaxSalary       pgtype.Float8    `json:"maxSalary"`
+	SalaryCurrency  string           `json:"salaryCurrency"`
+	SalaryPeriod    SalaryPeriod     `json:"salaryPeriod"`
+	SalaryType      SalaryType       `json:"salaryType"`
+	ContractType    NullContractType `json:"contractType"`
+	Salary          pgtype.Float8    `json:"salary"` // NOTE: Upper end of the salary range in the requested currency and period
+	JobPostingUrl   pgtype.Text      `json:"jobPostingUrl"`
+	Notes           pgtype.Text      `json:"notes"`
+	Rank            float32          `json:"rank"`
+	Snippet         string           `json:"snippet"`
+	Total           int64            `json:"total"`
+}
+
+type queryArgs []any
+
+func (a *queryArgs) add(value any) string {
+	*a = append(*a, value)
+	return "$" + strconv.Itoa(len(*a))
+}
+
+// keysetCondition matches the rows sorted after the given one, where NULLs come last when ascending and first when descending
+func keysetCondition(args *queryArgs, sort []JobApplicationsSortKey, after []any, afterID pgtype.UUID) string {
+	alternatives := []string{}
+	ties := []string{}
+
+	for i, key := range sort {
+		expression := jobApplicationsSortExpressions[key.Column]
+
+		var value any
+		if i < len(after) {
+			value = after[i]
+		}
+
+		var condition string
+		switch {
+		case value == nil && key.Desc:
+			condition = expression + " IS NOT NULL"
+		case value == nil:
+			// NOTE: Nothing but ties sorts after a NULL in ascending order
+		case key.Desc:
+			condition = expression + " < " + args.add(value)
+		default:
+			condition = "(" + expression + " > " + args.add(value) + " OR " + expression + " IS NULL)"
+		}
+
+		if condition != "" {
+			alternatives = append(alternatives, "("+strings.Join(append(ties[:len(ties):len(ties)], condition), " AND ")+")")
+		}
+
+		if value == nil {
+			ties = append(ties, expression+" IS NULL")
+		} else {
+			ties = append(ties, expression+" = "+args.add(value))
+		}
+	}
+
+	alternatives = append(alternatives, "("+strings.Join(append(ties, "id > "+args.add(afterID)), " AND ")+")")
+
+	return "(" + strings.Join(alternatives, "\n    OR ") + ")"
+}
+
+func buildGetJobApplications(arg GetJobApplicationsParams) (string, []any) {
+	args := queryArgs{}
+
+	rank := "0::real"
+	// NOTE: Computed for the returned page only, since ts_headline has to re-parse the whole text
+	snippet := "''"
+	filters := []string{"j.user_id = " + args.add(arg.UserID), "j.deleted_at IS NULL"}
+
+	salaryCurrency, salaryPeriod := args.add(arg.SalaryCurrency), args.add(arg.SalaryPeriod)
+	normalizeSalary := func(amount string) string {
+		return "normalize_salary(" + amount + ", j.salary_currency, j.salary_period, " + salaryCurrency + "::text, " + salaryPeriod + "::salary_period)"
+	}
+
+	if arg.Query.Valid {
+		query := "to_tsquery('simple', " + args.add(arg.Query.String) + ")"
+		rank = "ts_rank(j.search_vector, " + query + ")"
+		snippet = "ts_headline('simple', concat_ws(' | ', company_name, job_title, notes, job_posting_url), " + query + `, 'StartSel=<mark>, StopSel=</mark>, MaxWords=20, MinWords=8, MaxFragments=2, FragmentDelimiter=" … "')`
+		filters = append(filters, "j.search_vector @@ "+query)
+	}
+	if arg.CompanyNameOrJobTitle != "" {
+		pattern := args.add(arg.CompanyNameOrJobTitle)
+		filters = append(filters, "(j.company_name ILIKE '%' || "+pattern+" || '%' OR j.job_title ILIKE '%' || "+pattern+" || '%')")
+	}
+	if arg.DateApplied.Valid {
+		filters = append(filters, "j.date_applied = "+args.add(arg.DateApplied)+"::date")
+	}
+	if arg.DateAppliedFrom.Valid {
+		filters = append(filters, "j.date_applied >= "+args.add(arg.DateAppliedFrom)+"::date")
+	}
+	if arg.DateAppliedTo.Valid {
+		filters = append(filters, "j.date_applied <= "+args.add(arg.DateAppliedTo)+"::date")
+	}
+	if arg.AppliedWithinDays > 0 {
+		filters = append(filters, "j.date_applied > (now() AT TIME ZONE "+args.add(arg.Timezone)+"::text)::date - "+args.add(arg.AppliedWithinDays)+"::int")
+	}
+	if len(arg.StageIDs) > 0 {
+		filters = append(filters, "j.stage_id = ANY("+args.add(arg.StageIDs)+"::uuid[])")
+	}
+	if arg.StageOutcome.Valid {
+		filters = append(filters, "s.outcome = "+args.add(arg.StageOutcome)+"::stage_outcome")
+	}
+	if arg.MinSalary.Valid {
+		filters = append(filters, normalizeSalary("greatest(j.min_salary, j.max_salary)")+" >= "+args.add(arg.MinSalary.Float64)+"::numeric")
+	}
+	if arg.MaxSalary.Valid {
+		filters = append(filters, normalizeSalary("least(j.min_salary, j.max_salary)")+" <= "+args.add(arg.MaxSalary.Float64)+"::numeric")
+	}
+	if arg.SalaryType.Valid {
+		filters = append(filters, "j.salary_type = "+args.add(arg.SalaryType.SalaryType)+"::salary_type")
+	}
+	if arg.IsReplied.Valid {
+		filters = append(filters, "j.is_replied = "+args.add(arg.IsReplied.Bool)+"::bool")
+	}
+	if len(arg.TagIds) > 0 {
+		count := "1"
+		if arg.TagsMatchAll {
+			count = strconv.Itoa(len(arg.TagIds))
+		}
+		filters = append(filters, "(SELECT count(*) FROM job_application_tags AS jt WHERE jt.job_application_id = j.id AND jt.tag_id = ANY("+args.add(arg.TagIds)+"::uuid[])) >= "+count)
+	}
+
+	orderBy := []string{}
+	for _, key := range arg.Sort {
+		direction := " ASC"
+		if key.Desc {
+			direction = " DESC"
+		}
+		orderBy = append(orderBy, jobApplicationsSortExpressions[key.Column]+direction)
+	}
+	orderBy = append(orderBy, "id")
+
+	keyset := "true"
+	if arg.AfterID.Valid {
+		keyset = keysetCondition(&args, arg.Sort, arg.After, arg.AfterID)
+	}
+
+	// NOTE: Counting every matching row is what makes deep pages slow, so it's skipped in the cursor mode
+	total := "0::bigint"
+	if arg.WithTotal {
+		total = "(SELECT count(*) FROM filtered_job_applications)"
+	}
+
+	lock := ""
+	if arg.ForUpdate {
+		lock = "\n  FOR UPDATE OF j"
+	}
+
+	query := `WITH filtered_job_applications AS (
+  SELECT
+    j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+    j.stage_id, s.name AS stage_name, s.color AS stage_color, s.position AS stage_position, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+    j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type,
+    ` + normalizeSalary("greatest(j.min_salary, j.max_salary)") + ` AS salary,
+    j.job_posting_url, j.notes,
+    ` + rank + ` AS rank
+  FROM job_applications AS j
+  JOIN stages AS s ON s.id = j.stage_id
+  WHERE ` + strings.Join(filters, "\n    AND ") + lock + `
+),
+page_job_applications AS (
+  SELECT *
+  FROM filtered_job_applications
+  WHERE ` + keyset + `
+  ORDER BY ` + strings.Join(orderBy, ", ") + `
+  LIMIT ` + args.add(arg.Limit) + ` OFFSET ` + args.add(arg.Offset) + `
+)
+SELECT
+  id, company_id, company_name, job_title, date_applied, stage_id, stage_name, stage_color, stage_position, stage_is_terminal, stage_outcome,
+  is_replied, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, salary, job_posting_url, notes, rank,
+  ` + snippet + `::text AS snippet,
+  ` + total + ` AS total
+FROM page_job_applications
+ORDER BY ` + strings.Join(orderBy, ", ")
+
+	return query, args
+}
+
+func (q *Queries) GetJobApplications(ctx context.Context, arg GetJobApplicationsParams) ([]GetJobApplicationsRow, error) {
+	query, args := buildGetJobApplications(arg)
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetJobApplicationsRow
+	for rows.Next() {
+		var i GetJobApplicationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CompanyID,
+			&i.CompanyName,
+			&i.JobTitle,
+			&i.DateApplied,
+			&i.StageID,
+			&i.StageName,
+			&i.StageColor,
+			&i.StagePosition,
+			&i.StageIsTerminal,
+			&i.StageOutcome,
+			&i.IsReplied,
+			&i.MinSalary,
+			&i.MaxSalary,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.SalaryType,
+			&i.ContractType,
+			&i.Salary,
+			&i.JobPostingUrl,
+			&i.Notes,
+			&i.Rank,
+			&i.Snippet,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
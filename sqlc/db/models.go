@@ -11,63 +11,578 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-type Status string
+type AttachmentKind string
 
 const (
-	StatusINPROGRESS Status = "IN_PROGRESS"
-	StatusREJECTED   Status = "REJECTED"
-	StatusACCEPTED   Status = "ACCEPTED"
+	AttachmentKindCV          AttachmentKind = "CV"
+	AttachmentKindCOVERLETTER AttachmentKind = "COVER_LETTER"
+	AttachmentKindOTHER       AttachmentKind = "OTHER"
 )
 
-func (e *Status) Scan(src interface{}) error {
+func (e *AttachmentKind) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = Status(s)
+		*e = AttachmentKind(s)
 	case string:
-		*e = Status(s)
+		*e = AttachmentKind(s)
 	default:
-		return fmt.Errorf("unsupported scan type for Status: %T", src)
+		return fmt.Errorf("unsupported scan type for AttachmentKind: %T", src)
 	}
 	return nil
 }
 
-type NullStatus struct {
-	Status Status `json:"status"`
-	Valid  bool   `json:"valid"` // Valid is true if Status is not NULL
+type NullAttachmentKind struct {
+	AttachmentKind AttachmentKind `json:"attachmentKind"`
+	Valid          bool           `json:"valid"` // Valid is true if AttachmentKind is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullStatus) Scan(value interface{}) error {
+func (ns *NullAttachmentKind) Scan(value interface{}) error {
 	if value == nil {
-		ns.Status, ns.Valid = "", false
+		ns.AttachmentKind, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.Status.Scan(value)
+	return ns.AttachmentKind.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullStatus) Value() (driver.Value, error) {
+func (ns NullAttachmentKind) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.Status), nil
+	return string(ns.AttachmentKind), nil
 }
 
-type JobApplication struct {
+type ContractType string
+
+const (
+	ContractTypeUOP   ContractType = "UOP"
+	ContractTypeB2B   ContractType = "B2B"
+	ContractTypeUZ    ContractType = "UZ"
+	ContractTypeUOD   ContractType = "UOD"
+	ContractTypeOTHER ContractType = "OTHER"
+)
+
+func (e *ContractType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ContractType(s)
+	case string:
+		*e = ContractType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ContractType: %T", src)
+	}
+	return nil
+}
+
+type NullContractType struct {
+	ContractType ContractType `json:"contractType"`
+	Valid        bool         `json:"valid"` // Valid is true if ContractType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullContractType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ContractType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ContractType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullContractType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ContractType), nil
+}
+
+type EmailStatus string
+
+const (
+	EmailStatusPENDING EmailStatus = "PENDING"
+	EmailStatusSENT    EmailStatus = "SENT"
+	EmailStatusDEAD    EmailStatus = "DEAD"
+)
+
+func (e *EmailStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EmailStatus(s)
+	case string:
+		*e = EmailStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EmailStatus: %T", src)
+	}
+	return nil
+}
+
+type NullEmailStatus struct {
+	EmailStatus EmailStatus `json:"emailStatus"`
+	Valid       bool        `json:"valid"` // Valid is true if EmailStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEmailStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.EmailStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EmailStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEmailStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EmailStatus), nil
+}
+
+type InterviewOutcome string
+
+const (
+	InterviewOutcomePENDING   InterviewOutcome = "PENDING"
+	InterviewOutcomePASSED    InterviewOutcome = "PASSED"
+	InterviewOutcomeFAILED    InterviewOutcome = "FAILED"
+	InterviewOutcomeCANCELLED InterviewOutcome = "CANCELLED"
+)
+
+func (e *InterviewOutcome) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InterviewOutcome(s)
+	case string:
+		*e = InterviewOutcome(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InterviewOutcome: %T", src)
+	}
+	return nil
+}
+
+type NullInterviewOutcome struct {
+	InterviewOutcome InterviewOutcome `json:"interviewOutcome"`
+	Valid            bool             `json:"valid"` // Valid is true if InterviewOutcome is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInterviewOutcome) Scan(value interface{}) error {
+	if value == nil {
+		ns.InterviewOutcome, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InterviewOutcome.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInterviewOutcome) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InterviewOutcome), nil
+}
+
+type InterviewType string
+
+const (
+	InterviewTypePHONE     InterviewType = "PHONE"
+	InterviewTypeONSITE    InterviewType = "ONSITE"
+	InterviewTypeTECHNICAL InterviewType = "TECHNICAL"
+	InterviewTypeHR        InterviewType = "HR"
+)
+
+func (e *InterviewType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InterviewType(s)
+	case string:
+		*e = InterviewType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InterviewType: %T", src)
+	}
+	return nil
+}
+
+type NullInterviewType struct {
+	InterviewType InterviewType `json:"interviewType"`
+	Valid         bool          `json:"valid"` // Valid is true if InterviewType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInterviewType) Scan(value interface{}) error {
+	if value == nil {
+		ns.InterviewType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InterviewType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInterviewType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InterviewType), nil
+}
+
+type ReminderStatus string
+
+const (
+	ReminderStatusPENDING   ReminderStatus = "PENDING"
+	ReminderStatusDELIVERED ReminderStatus = "DELIVERED"
+	ReminderStatusDISMISSED ReminderStatus = "DISMISSED"
+)
+
+func (e *ReminderStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReminderStatus(s)
+	case string:
+		*e = ReminderStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReminderStatus: %T", src)
+	}
+	return nil
+}
+
+type NullReminderStatus struct {
+	ReminderStatus ReminderStatus `json:"reminderStatus"`
+	Valid          bool           `json:"valid"` // Valid is true if ReminderStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReminderStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReminderStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReminderStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReminderStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReminderStatus), nil
+}
+
+type SalaryPeriod string
+
+const (
+	SalaryPeriodHOUR  SalaryPeriod = "HOUR"
+	SalaryPeriodMONTH SalaryPeriod = "MONTH"
+	SalaryPeriodYEAR  SalaryPeriod = "YEAR"
+)
+
+func (e *SalaryPeriod) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SalaryPeriod(s)
+	case string:
+		*e = SalaryPeriod(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SalaryPeriod: %T", src)
+	}
+	return nil
+}
+
+type NullSalaryPeriod struct {
+	SalaryPeriod SalaryPeriod `json:"salaryPeriod"`
+	Valid        bool         `json:"valid"` // Valid is true if SalaryPeriod is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSalaryPeriod) Scan(value interface{}) error {
+	if value == nil {
+		ns.SalaryPeriod, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SalaryPeriod.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSalaryPeriod) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SalaryPeriod), nil
+}
+
+type SalaryType string
+
+const (
+	SalaryTypeGROSS SalaryType = "GROSS"
+	SalaryTypeNET   SalaryType = "NET"
+)
+
+func (e *SalaryType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SalaryType(s)
+	case string:
+		*e = SalaryType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SalaryType: %T", src)
+	}
+	return nil
+}
+
+type NullSalaryType struct {
+	SalaryType SalaryType `json:"salaryType"`
+	Valid      bool       `json:"valid"` // Valid is true if SalaryType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSalaryType) Scan(value interface{}) error {
+	if value == nil {
+		ns.SalaryType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SalaryType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSalaryType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SalaryType), nil
+}
+
+type StageOutcome string
+
+const (
+	StageOutcomeNEUTRAL  StageOutcome = "NEUTRAL"
+	StageOutcomePOSITIVE StageOutcome = "POSITIVE"
+	StageOutcomeNEGATIVE StageOutcome = "NEGATIVE"
+)
+
+func (e *StageOutcome) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StageOutcome(s)
+	case string:
+		*e = StageOutcome(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StageOutcome: %T", src)
+	}
+	return nil
+}
+
+type NullStageOutcome struct {
+	StageOutcome StageOutcome `json:"stageOutcome"`
+	Valid        bool         `json:"valid"` // Valid is true if StageOutcome is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStageOutcome) Scan(value interface{}) error {
+	if value == nil {
+		ns.StageOutcome, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StageOutcome.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStageOutcome) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StageOutcome), nil
+}
+
+type WeekStart string
+
+const (
+	WeekStartMONDAY   WeekStart = "MONDAY"
+	WeekStartSATURDAY WeekStart = "SATURDAY"
+	WeekStartSUNDAY   WeekStart = "SUNDAY"
+)
+
+func (e *WeekStart) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WeekStart(s)
+	case string:
+		*e = WeekStart(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WeekStart: %T", src)
+	}
+	return nil
+}
+
+type NullWeekStart struct {
+	WeekStart WeekStart `json:"weekStart"`
+	Valid     bool      `json:"valid"` // Valid is true if WeekStart is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWeekStart) Scan(value interface{}) error {
+	if value == nil {
+		ns.WeekStart, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WeekStart.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWeekStart) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WeekStart), nil
+}
+
+type Attachment struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	Kind             AttachmentKind     `json:"kind"`
+	Filename         string             `json:"filename"`
+	ContentType      string             `json:"contentType"`
+	Size             int64              `json:"size"`
+	Checksum         string             `json:"checksum"`
+	StorageKey       string             `json:"storageKey"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+}
+
+type Company struct {
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"userId"`
+	Name           string             `json:"name"`
+	NormalizedName pgtype.Text        `json:"normalizedName"`
+	Website        pgtype.Text        `json:"website"`
+	Industry       pgtype.Text        `json:"industry"`
+	Size           pgtype.Text        `json:"size"`
+	Location       pgtype.Text        `json:"location"`
+	Notes          pgtype.Text        `json:"notes"`
+	CreatedAt      pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt      pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type Contact struct {
+	ID          pgtype.UUID        `json:"id"`
+	UserID      pgtype.UUID        `json:"userId"`
+	Name        string             `json:"name"`
+	Email       pgtype.Text        `json:"email"`
+	Phone       pgtype.Text        `json:"phone"`
+	LinkedinUrl pgtype.Text        `json:"linkedinUrl"`
+	Company     pgtype.Text        `json:"company"`
+	Role        pgtype.Text        `json:"role"`
+	Notes       pgtype.Text        `json:"notes"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type EmailOutbox struct {
 	ID            pgtype.UUID        `json:"id"`
-	CompanyName   string             `json:"companyName"`
-	JobTitle      string             `json:"jobTitle"`
-	DateApplied   pgtype.Timestamptz `json:"dateApplied"`
-	Status        Status             `json:"status"`
-	MinSalary     pgtype.Float8      `json:"minSalary"`
-	MaxSalary     pgtype.Float8      `json:"maxSalary"`
-	JobPostingUrl pgtype.Text        `json:"jobPostingUrl"`
-	Notes         pgtype.Text        `json:"notes"`
+	Recipient     string             `json:"recipient"`
+	Subject       string             `json:"subject"`
+	Body          string             `json:"body"`
+	Status        EmailStatus        `json:"status"`
+	Attempts      int32              `json:"attempts"`
+	NextAttemptAt pgtype.Timestamptz `json:"nextAttemptAt"`
+	LastError     pgtype.Text        `json:"lastError"`
+	SentAt        pgtype.Timestamptz `json:"sentAt"`
 	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
 	UpdatedAt     pgtype.Timestamptz `json:"updatedAt"`
-	UserID        pgtype.UUID        `json:"userId"`
-	IsReplied     bool               `json:"isReplied"`
+}
+
+type ExchangeRate struct {
+	Currency  string             `json:"currency"`
+	Rate      pgtype.Float8      `json:"rate"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type IdempotencyKey struct {
+	ID          pgtype.UUID        `json:"id"`
+	UserID      pgtype.UUID        `json:"userId"`
+	Key         string             `json:"key"`
+	RequestHash string             `json:"requestHash"`
+	Status      pgtype.Int4        `json:"status"`
+	Response    []byte             `json:"response"`
+	CreatedAt   pgtype.Timestamptz `json:"createdAt"`
+	ExpiresAt   pgtype.Timestamptz `json:"expiresAt"`
+}
+
+type Interview struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	ScheduledAt      pgtype.Timestamptz `json:"scheduledAt"`
+	Timezone         string             `json:"timezone"`
+	Duration         pgtype.Int4        `json:"duration"`
+	Type             InterviewType      `json:"type"`
+	Location         pgtype.Text        `json:"location"`
+	MeetingUrl       pgtype.Text        `json:"meetingUrl"`
+	Interviewers     []string           `json:"interviewers"`
+	PreparationNotes pgtype.Text        `json:"preparationNotes"`
+	Outcome          InterviewOutcome   `json:"outcome"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type JobApplication struct {
+	ID             pgtype.UUID        `json:"id"`
+	CompanyName    string             `json:"companyName"`
+	JobTitle       string             `json:"jobTitle"`
+	DateApplied    pgtype.Date        `json:"dateApplied"`
+	MinSalary      pgtype.Float8      `json:"minSalary"`
+	MaxSalary      pgtype.Float8      `json:"maxSalary"`
+	JobPostingUrl  pgtype.Text        `json:"jobPostingUrl"`
+	Notes          pgtype.Text        `json:"notes"`
+	CreatedAt      pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt      pgtype.Timestamptz `json:"updatedAt"`
+	UserID         pgtype.UUID        `json:"userId"`
+	IsReplied      bool               `json:"isReplied"`
+	StageID        pgtype.UUID        `json:"stageId"`
+	CompanyID      pgtype.UUID        `json:"companyId"`
+	SearchVector   interface{}        `json:"searchVector"`
+	DeletedAt      pgtype.Timestamptz `json:"deletedAt"`
+	Version        int32              `json:"version"`
+	SalaryCurrency string             `json:"salaryCurrency"`
+	SalaryPeriod   SalaryPeriod       `json:"salaryPeriod"`
+	SalaryType     SalaryType         `json:"salaryType"`
+	ContractType   NullContractType   `json:"contractType"`
+}
+
+type JobApplicationContact struct {
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	ContactID        pgtype.UUID        `json:"contactId"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+}
+
+type JobApplicationEvent struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	Field            string             `json:"field"`
+	OldValue         pgtype.Text        `json:"oldValue"`
+	NewValue         pgtype.Text        `json:"newValue"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+}
+
+type JobApplicationTag struct {
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	TagID            pgtype.UUID        `json:"tagId"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+}
+
+type MfaChallenge struct {
+	ID        pgtype.UUID        `json:"id"`
+	UserID    pgtype.UUID        `json:"userId"`
+	Attempts  int32              `json:"attempts"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
 }
 
 type PasswordResetToken struct {
@@ -79,15 +594,77 @@ type PasswordResetToken struct {
 	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
 }
 
+type RecoveryCode struct {
+	ID        pgtype.UUID        `json:"id"`
+	UserID    pgtype.UUID        `json:"userId"`
+	CodeHash  string             `json:"codeHash"`
+	UsedAt    pgtype.Timestamptz `json:"usedAt"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type Reminder struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	RemindAt         pgtype.Timestamptz `json:"remindAt"`
+	Note             pgtype.Text        `json:"note"`
+	UnlessReplied    bool               `json:"unlessReplied"`
+	IsSuggested      bool               `json:"isSuggested"`
+	Status           ReminderStatus     `json:"status"`
+	DeliveredAt      pgtype.Timestamptz `json:"deliveredAt"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type Session struct {
+	ID           pgtype.UUID        `json:"id"`
+	UserID       pgtype.UUID        `json:"userId"`
+	RefreshToken string             `json:"refreshToken"`
+	ExpiresAt    pgtype.Timestamptz `json:"expiresAt"`
+	RevokedAt    pgtype.Timestamptz `json:"revokedAt"`
+	CreatedAt    pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type Stage struct {
+	ID         pgtype.UUID        `json:"id"`
+	UserID     pgtype.UUID        `json:"userId"`
+	Name       string             `json:"name"`
+	Position   int32              `json:"position"`
+	Color      string             `json:"color"`
+	IsTerminal bool               `json:"isTerminal"`
+	Outcome    StageOutcome       `json:"outcome"`
+	CreatedAt  pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt  pgtype.Timestamptz `json:"updatedAt"`
+}
+
+type Tag struct {
+	ID        pgtype.UUID        `json:"id"`
+	UserID    pgtype.UUID        `json:"userId"`
+	Name      string             `json:"name"`
+	Color     string             `json:"color"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
+
 type User struct {
-	Email           string             `json:"email"`
-	Password        string             `json:"password"`
-	FirstName       string             `json:"firstName"`
-	LastName        string             `json:"lastName"`
-	ID              pgtype.UUID        `json:"id"`
-	CreatedAt       pgtype.Timestamptz `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamptz `json:"updatedAt"`
-	IsEmailVerified pgtype.Bool        `json:"isEmailVerified"`
+	Email            string             `json:"email"`
+	Password         string             `json:"password"`
+	FirstName        string             `json:"firstName"`
+	LastName         string             `json:"lastName"`
+	ID               pgtype.UUID        `json:"id"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamptz `json:"updatedAt"`
+	IsEmailVerified  pgtype.Bool        `json:"isEmailVerified"`
+	TotpSecret       pgtype.Text        `json:"totpSecret"`
+	IsTotpEnabled    bool               `json:"isTotpEnabled"`
+	Timezone         string             `json:"timezone"`
+	Locale           string             `json:"locale"`
+	WeekStart        WeekStart          `json:"weekStart"`
+	Currency         string             `json:"currency"`
+	TotpLastStep     pgtype.Int8        `json:"totpLastStep"`
+	MfaAttempts      int32              `json:"mfaAttempts"`
+	MfaAttemptsSince pgtype.Timestamptz `json:"mfaAttemptsSince"`
 }
 
 type VerificationToken struct {
@@ -98,3 +675,13 @@ type VerificationToken struct {
 	CreatedAt pgtype.Timestamptz `json:"createdAt"`
 	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
 }
+
+type View struct {
+	ID        pgtype.UUID        `json:"id"`
+	UserID    pgtype.UUID        `json:"userId"`
+	Name      string             `json:"name"`
+	Query     string             `json:"query"`
+	IsDefault bool               `json:"isDefault"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+	UpdatedAt pgtype.Timestamptz `json:"updatedAt"`
+}
@@ -11,65 +11,593 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const attemptMFA = `-- name: AttemptMFA :one
+UPDATE users SET
+  mfa_attempts = CASE WHEN mfa_attempts_since IS NULL OR mfa_attempts_since <= NOW() - make_interval(mins => $1::int) THEN 1 ELSE mfa_attempts + 1 END,
+  mfa_attempts_since = CASE WHEN mfa_attempts_since IS NULL OR mfa_attempts_since <= NOW() - make_interval(mins => $1::int) THEN NOW() ELSE mfa_attempts_since END
+WHERE id = $2 AND (mfa_attempts_since IS NULL OR mfa_attempts_since <= NOW() - make_interval(mins => $1::int) OR mfa_attempts < $3::int)
+RETURNING id
+`
+
+type AttemptMFAParams struct {
+	LockoutMinutes int32       `json:"lockoutMinutes"`
+	ID             pgtype.UUID `json:"id"`
+	MaxAttempts    int32       `json:"maxAttempts"`
+}
+
+// NOTE: Fails once the user has tried too many codes in the lockout window without one being accepted
+func (q *Queries) AttemptMFA(ctx context.Context, arg AttemptMFAParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, attemptMFA, arg.LockoutMinutes, arg.ID, arg.MaxAttempts)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const attemptMFAChallenge = `-- name: AttemptMFAChallenge :one
+UPDATE mfa_challenges SET attempts = attempts + 1
+WHERE id = $1 AND user_id = $2 AND expires_at > NOW() AND attempts < $3::int
+RETURNING id
+`
+
+type AttemptMFAChallengeParams struct {
+	ID          pgtype.UUID `json:"id"`
+	UserID      pgtype.UUID `json:"userId"`
+	MaxAttempts int32       `json:"maxAttempts"`
+}
+
+// NOTE: Fails for a challenge that is expired, completed or out of attempts
+func (q *Queries) AttemptMFAChallenge(ctx context.Context, arg AttemptMFAChallengeParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, attemptMFAChallenge, arg.ID, arg.UserID, arg.MaxAttempts)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const claimDueReminders = `-- name: ClaimDueReminders :many
+SELECT
+  r.id, r.remind_at, r.note, r.unless_replied, r.is_suggested,
+  j.id AS job_application_id, j.company_name, j.job_title, j.date_applied, j.is_replied,
+  u.email, u.first_name, u.locale
+FROM reminders AS r
+JOIN job_applications AS j ON j.id = r.job_application_id
+JOIN users AS u ON u.id = j.user_id
+WHERE r.status = 'PENDING' AND r.remind_at <= NOW() AND j.deleted_at IS NULL
+ORDER BY r.remind_at
+LIMIT $1
+FOR UPDATE OF r SKIP LOCKED
+`
+
+type ClaimDueRemindersRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	RemindAt         pgtype.Timestamptz `json:"remindAt"`
+	Note             pgtype.Text        `json:"note"`
+	UnlessReplied    bool               `json:"unlessReplied"`
+	IsSuggested      bool               `json:"isSuggested"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	CompanyName      string             `json:"companyName"`
+	JobTitle         string             `json:"jobTitle"`
+	DateApplied      pgtype.Date        `json:"dateApplied"`
+	IsReplied        bool               `json:"isReplied"`
+	Email            string             `json:"email"`
+	FirstName        string             `json:"firstName"`
+	Locale           string             `json:"locale"`
+}
+
+func (q *Queries) ClaimDueReminders(ctx context.Context, limit int32) ([]ClaimDueRemindersRow, error) {
+	rows, err := q.db.Query(ctx, claimDueReminders, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueRemindersRow
+	for rows.Next() {
+		var i ClaimDueRemindersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RemindAt,
+			&i.Note,
+			&i.UnlessReplied,
+			&i.IsSuggested,
+			&i.JobApplicationID,
+			&i.CompanyName,
+			&i.JobTitle,
+			&i.DateApplied,
+			&i.IsReplied,
+			&i.Email,
+			&i.FirstName,
+			&i.Locale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimEmails = `-- name: ClaimEmails :many
+UPDATE email_outbox SET next_attempt_at = NOW() + make_interval(secs => $1::int)
+WHERE id IN (
+  SELECT id FROM email_outbox
+  WHERE status = 'PENDING' AND next_attempt_at <= NOW()
+  ORDER BY next_attempt_at
+  LIMIT $2::int
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, recipient, subject, body, attempts
+`
+
+type ClaimEmailsParams struct {
+	LeaseSeconds int32 `json:"leaseSeconds"`
+	BatchSize    int32 `json:"batchSize"`
+}
+
+type ClaimEmailsRow struct {
+	ID        pgtype.UUID `json:"id"`
+	Recipient string      `json:"recipient"`
+	Subject   string      `json:"subject"`
+	Body      string      `json:"body"`
+	Attempts  int32       `json:"attempts"`
+}
+
+// NOTE: Leased until the whole batch could have been sent, so that no other worker picks up an email still waiting its turn
+func (q *Queries) ClaimEmails(ctx context.Context, arg ClaimEmailsParams) ([]ClaimEmailsRow, error) {
+	rows, err := q.db.Query(ctx, claimEmails, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimEmailsRow
+	for rows.Next() {
+		var i ClaimEmailsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Recipient,
+			&i.Subject,
+			&i.Body,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const clearDefaultView = `-- name: ClearDefaultView :exec
+UPDATE views SET is_default = false WHERE user_id = $1 AND is_default
+`
+
+func (q *Queries) ClearDefaultView(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, clearDefaultView, userID)
+	return err
+}
+
+const completeMFAChallenge = `-- name: CompleteMFAChallenge :one
+DELETE FROM mfa_challenges WHERE id = $1 AND user_id = $2 RETURNING id
+`
+
+type CompleteMFAChallengeParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+func (q *Queries) CompleteMFAChallenge(ctx context.Context, arg CompleteMFAChallengeParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, completeMFAChallenge, arg.ID, arg.UserID)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createAttachment = `-- name: CreateAttachment :one
+INSERT INTO attachments (job_application_id, kind, filename, content_type, size, checksum, storage_key)
+SELECT j.id, $1::attachment_kind, $2::text, $3::text, $4::bigint, $5::text, $6::text
+FROM job_applications AS j
+WHERE j.id = $7 AND j.user_id = $8 AND j.deleted_at IS NULL
+RETURNING id, job_application_id, kind, filename, content_type, size, checksum, created_at
+`
+
+type CreateAttachmentParams struct {
+	Kind             AttachmentKind `json:"kind"`
+	Filename         string         `json:"filename"`
+	ContentType      string         `json:"contentType"`
+	Size             int64          `json:"size"`
+	Checksum         string         `json:"checksum"`
+	StorageKey       string         `json:"storageKey"`
+	JobApplicationID pgtype.UUID    `json:"jobApplicationId"`
+	UserID           pgtype.UUID    `json:"userId"`
+}
+
+type CreateAttachmentRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	Kind             AttachmentKind     `json:"kind"`
+	Filename         string             `json:"filename"`
+	ContentType      string             `json:"contentType"`
+	Size             int64              `json:"size"`
+	Checksum         string             `json:"checksum"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+}
+
+func (q *Queries) CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (CreateAttachmentRow, error) {
+	row := q.db.QueryRow(ctx, createAttachment,
+		arg.Kind,
+		arg.Filename,
+		arg.ContentType,
+		arg.Size,
+		arg.Checksum,
+		arg.StorageKey,
+		arg.JobApplicationID,
+		arg.UserID,
+	)
+	var i CreateAttachmentRow
+	err := row.Scan(
+		&i.ID,
+		&i.JobApplicationID,
+		&i.Kind,
+		&i.Filename,
+		&i.ContentType,
+		&i.Size,
+		&i.Checksum,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createCompany = `-- name: CreateCompany :one
+INSERT INTO companies (user_id, name, website, industry, size, location, notes)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, website, industry, size, location, notes
+`
+
+type CreateCompanyParams struct {
+	UserID   pgtype.UUID `json:"userId"`
+	Name     string      `json:"name"`
+	Website  pgtype.Text `json:"website"`
+	Industry pgtype.Text `json:"industry"`
+	Size     pgtype.Text `json:"size"`
+	Location pgtype.Text `json:"location"`
+	Notes    pgtype.Text `json:"notes"`
+}
+
+type CreateCompanyRow struct {
+	ID       pgtype.UUID `json:"id"`
+	Name     string      `json:"name"`
+	Website  pgtype.Text `json:"website"`
+	Industry pgtype.Text `json:"industry"`
+	Size     pgtype.Text `json:"size"`
+	Location pgtype.Text `json:"location"`
+	Notes    pgtype.Text `json:"notes"`
+}
+
+func (q *Queries) CreateCompany(ctx context.Context, arg CreateCompanyParams) (CreateCompanyRow, error) {
+	row := q.db.QueryRow(ctx, createCompany,
+		arg.UserID,
+		arg.Name,
+		arg.Website,
+		arg.Industry,
+		arg.Size,
+		arg.Location,
+		arg.Notes,
+	)
+	var i CreateCompanyRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Website,
+		&i.Industry,
+		&i.Size,
+		&i.Location,
+		&i.Notes,
+	)
+	return i, err
+}
+
+const createContact = `-- name: CreateContact :one
+INSERT INTO contacts (user_id, name, email, phone, linkedin_url, company, role, notes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, name, email, phone, linkedin_url, company, role, notes
+`
+
+type CreateContactParams struct {
+	UserID      pgtype.UUID `json:"userId"`
+	Name        string      `json:"name"`
+	Email       pgtype.Text `json:"email"`
+	Phone       pgtype.Text `json:"phone"`
+	LinkedinUrl pgtype.Text `json:"linkedinUrl"`
+	Company     pgtype.Text `json:"company"`
+	Role        pgtype.Text `json:"role"`
+	Notes       pgtype.Text `json:"notes"`
+}
+
+type CreateContactRow struct {
+	ID          pgtype.UUID `json:"id"`
+	Name        string      `json:"name"`
+	Email       pgtype.Text `json:"email"`
+	Phone       pgtype.Text `json:"phone"`
+	LinkedinUrl pgtype.Text `json:"linkedinUrl"`
+	Company     pgtype.Text `json:"company"`
+	Role        pgtype.Text `json:"role"`
+	Notes       pgtype.Text `json:"notes"`
+}
+
+func (q *Queries) CreateContact(ctx context.Context, arg CreateContactParams) (CreateContactRow, error) {
+	row := q.db.QueryRow(ctx, createContact,
+		arg.UserID,
+		arg.Name,
+		arg.Email,
+		arg.Phone,
+		arg.LinkedinUrl,
+		arg.Company,
+		arg.Role,
+		arg.Notes,
+	)
+	var i CreateContactRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Phone,
+		&i.LinkedinUrl,
+		&i.Company,
+		&i.Role,
+		&i.Notes,
+	)
+	return i, err
+}
+
+const createIdempotencyKey = `-- name: CreateIdempotencyKey :execrows
+INSERT INTO idempotency_keys (user_id, key, request_hash, expires_at)
+VALUES ($1::uuid, $2::text, $3::text, $4::timestamptz)
+ON CONFLICT ON CONSTRAINT unique_idempotency_key DO UPDATE
+SET request_hash = EXCLUDED.request_hash, status = NULL, response = NULL, created_at = NOW(), expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW()
+`
+
+type CreateIdempotencyKeyParams struct {
+	UserID      pgtype.UUID        `json:"userId"`
+	Key         string             `json:"key"`
+	RequestHash string             `json:"requestHash"`
+	ExpiresAt   pgtype.Timestamptz `json:"expiresAt"`
+}
+
+// NOTE: An expired key is taken over, since the sweep deleting it may not have run yet
+func (q *Queries) CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createIdempotencyKey,
+		arg.UserID,
+		arg.Key,
+		arg.RequestHash,
+		arg.ExpiresAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createInterview = `-- name: CreateInterview :one
+INSERT INTO interviews (job_application_id, scheduled_at, timezone, duration, type, location, meeting_url, interviewers, preparation_notes, outcome)
+SELECT
+  j.id,
+  $1::timestamptz,
+  $2::text,
+  $3::integer,
+  $4::interview_type,
+  $5::text,
+  $6::text,
+  $7::text[],
+  $8::text,
+  $9::interview_outcome
+FROM job_applications AS j
+WHERE j.id = $10 AND j.user_id = $11 AND j.deleted_at IS NULL
+RETURNING id, scheduled_at, timezone, duration, type, location, meeting_url, interviewers, preparation_notes, outcome
+`
+
+type CreateInterviewParams struct {
+	ScheduledAt      pgtype.Timestamptz `json:"scheduledAt"`
+	Timezone         string             `json:"timezone"`
+	Duration         pgtype.Int4        `json:"duration"`
+	Type             InterviewType      `json:"type"`
+	Location         pgtype.Text        `json:"location"`
+	MeetingUrl       pgtype.Text        `json:"meetingUrl"`
+	Interviewers     []string           `json:"interviewers"`
+	PreparationNotes pgtype.Text        `json:"preparationNotes"`
+	Outcome          InterviewOutcome   `json:"outcome"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	UserID           pgtype.UUID        `json:"userId"`
+}
+
+type CreateInterviewRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	ScheduledAt      pgtype.Timestamptz `json:"scheduledAt"`
+	Timezone         string             `json:"timezone"`
+	Duration         pgtype.Int4        `json:"duration"`
+	Type             InterviewType      `json:"type"`
+	Location         pgtype.Text        `json:"location"`
+	MeetingUrl       pgtype.Text        `json:"meetingUrl"`
+	Interviewers     []string           `json:"interviewers"`
+	PreparationNotes pgtype.Text        `json:"preparationNotes"`
+	Outcome          InterviewOutcome   `json:"outcome"`
+}
+
+func (q *Queries) CreateInterview(ctx context.Context, arg CreateInterviewParams) (CreateInterviewRow, error) {
+	row := q.db.QueryRow(ctx, createInterview,
+		arg.ScheduledAt,
+		arg.Timezone,
+		arg.Duration,
+		arg.Type,
+		arg.Location,
+		arg.MeetingUrl,
+		arg.Interviewers,
+		arg.PreparationNotes,
+		arg.Outcome,
+		arg.JobApplicationID,
+		arg.UserID,
+	)
+	var i CreateInterviewRow
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Duration,
+		&i.Type,
+		&i.Location,
+		&i.MeetingUrl,
+		&i.Interviewers,
+		&i.PreparationNotes,
+		&i.Outcome,
+	)
+	return i, err
+}
+
 const createJobApplication = `-- name: CreateJobApplication :one
-INSERT INTO job_applications (user_id, company_name, job_title, date_applied, status, min_salary, max_salary, job_posting_url, notes)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-RETURNING id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, notes
+WITH new_company AS (
+  INSERT INTO companies (user_id, name)
+  SELECT $1::uuid, $2::text
+  WHERE $3::uuid IS NULL
+  ON CONFLICT ON CONSTRAINT unique_company_name DO UPDATE SET name = companies.name
+  RETURNING id, name
+),
+company AS (
+  SELECT id, name FROM new_company
+  UNION ALL
+  SELECT id, name FROM companies WHERE id = $3::uuid AND user_id = $1::uuid
+),
+new_job_application AS (
+  INSERT INTO job_applications (user_id, company_id, company_name, job_title, date_applied, stage_id, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, job_posting_url, notes)
+  VALUES (
+    $1::uuid,
+    coalesce((SELECT id FROM company), $3::uuid),
+    coalesce((SELECT name FROM company), $2::text),
+    $4::text,
+    $5::date,
+    coalesce($6::uuid, (SELECT id FROM stages WHERE user_id = $1::uuid ORDER BY position, created_at LIMIT 1)),
+    $7::numeric,
+    $8::numeric,
+    -- NOTE: The same defaults as the columns have
+    coalesce(nullif($9::text, ''), 'PLN'),
+    coalesce($10::salary_period, 'MONTH'),
+    coalesce($11::salary_type, 'GROSS'),
+    $12::contract_type,
+    $13::text,
+    $14::text
+  )
+  RETURNING id, company_id, company_name, job_title, date_applied, stage_id, is_replied, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, job_posting_url, notes
+)
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes
+FROM new_job_application AS j
+JOIN stages AS s ON s.id = j.stage_id
 `
 
 type CreateJobApplicationParams struct {
-	UserID        pgtype.UUID        `json:"userId"`
-	CompanyName   string             `json:"companyName"`
-	JobTitle      string             `json:"jobTitle"`
-	DateApplied   pgtype.Timestamptz `json:"dateApplied"`
-	Status        Status             `json:"status"`
-	MinSalary     pgtype.Float8      `json:"minSalary"`
-	MaxSalary     pgtype.Float8      `json:"maxSalary"`
-	JobPostingUrl pgtype.Text        `json:"jobPostingUrl"`
-	Notes         pgtype.Text        `json:"notes"`
+	UserID         pgtype.UUID      `json:"userId"`
+	CompanyName    string           `json:"companyName"`
+	CompanyID      pgtype.UUID      `json:"companyId"`
+	JobTitle       string           `json:"jobTitle"`
+	DateApplied    pgtype.Date      `json:"dateApplied"`
+	StageID        pgtype.UUID      `json:"stageId"`
+	MinSalary      pgtype.Float8    `json:"minSalary"`
+	MaxSalary      pgtype.Float8    `json:"maxSalary"`
+	SalaryCurrency pgtype.Text      `json:"salaryCurrency"`
+	SalaryPeriod   NullSalaryPeriod `json:"salaryPeriod"`
+	SalaryType     NullSalaryType   `json:"salaryType"`
+	ContractType   NullContractType `json:"contractType"`
+	JobPostingUrl  pgtype.Text      `json:"jobPostingUrl"`
+	Notes          pgtype.Text      `json:"notes"`
 }
 
 type CreateJobApplicationRow struct {
-	ID            pgtype.UUID        `json:"id"`
-	CompanyName   string             `json:"companyName"`
-	JobTitle      string             `json:"jobTitle"`
-	DateApplied   pgtype.Timestamptz `json:"dateApplied"`
-	Status        Status             `json:"status"`
-	IsReplied     bool               `json:"isReplied"`
-	MinSalary     pgtype.Float8      `json:"minSalary"`
-	MaxSalary     pgtype.Float8      `json:"maxSalary"`
-	JobPostingUrl pgtype.Text        `json:"jobPostingUrl"`
-	Notes         pgtype.Text        `json:"notes"`
+	ID              pgtype.UUID      `json:"id"`
+	CompanyID       pgtype.UUID      `json:"companyId"`
+	CompanyName     string           `json:"companyName"`
+	JobTitle        string           `json:"jobTitle"`
+	DateApplied     pgtype.Date      `json:"dateApplied"`
+	StageID         pgtype.UUID      `json:"stageId"`
+	StageName       string           `json:"stageName"`
+	StageColor      string           `json:"stageColor"`
+	StageIsTerminal bool             `json:"stageIsTerminal"`
+	StageOutcome    StageOutcome     `json:"stageOutcome"`
+	IsReplied       bool             `json:"isReplied"`
+	MinSalary       pgtype.Float8    `json:"minSalary"`
+	MaxSalary       pgtype.Float8    `json:"maxSalary"`
+	SalaryCurrency  string           `json:"salaryCurrency"`
+	SalaryPeriod    SalaryPeriod     `json:"salaryPeriod"`
+	SalaryType      SalaryType       `json:"salaryType"`
+	ContractType    NullContractType `json:"contractType"`
+	JobPostingUrl   pgtype.Text      `json:"jobPostingUrl"`
+	Notes           pgtype.Text      `json:"notes"`
 }
 
 func (q *Queries) CreateJobApplication(ctx context.Context, arg CreateJobApplicationParams) (CreateJobApplicationRow, error) {
 	row := q.db.QueryRow(ctx, createJobApplication,
 		arg.UserID,
 		arg.CompanyName,
+		arg.CompanyID,
 		arg.JobTitle,
 		arg.DateApplied,
-		arg.Status,
+		arg.StageID,
 		arg.MinSalary,
 		arg.MaxSalary,
+		arg.SalaryCurrency,
+		arg.SalaryPeriod,
+		arg.SalaryType,
+		arg.ContractType,
 		arg.JobPostingUrl,
 		arg.Notes,
 	)
 	var i CreateJobApplicationRow
 	err := row.Scan(
 		&i.ID,
+		&i.CompanyID,
 		&i.CompanyName,
 		&i.JobTitle,
 		&i.DateApplied,
-		&i.Status,
+		&i.StageID,
+		&i.StageName,
+		&i.StageColor,
+		&i.StageIsTerminal,
+		&i.StageOutcome,
 		&i.IsReplied,
 		&i.MinSalary,
 		&i.MaxSalary,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
+		&i.SalaryType,
+		&i.ContractType,
 		&i.JobPostingUrl,
 		&i.Notes,
 	)
 	return i, err
 }
 
+const createMFAChallenge = `-- name: CreateMFAChallenge :one
+WITH expired AS (
+  DELETE FROM mfa_challenges WHERE user_id = $1 AND expires_at <= NOW()
+)
+INSERT INTO mfa_challenges (user_id) VALUES ($1) RETURNING id, expires_at
+`
+
+type CreateMFAChallengeRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+}
+
+// NOTE: Expired challenges of the user are cleared on the way
+func (q *Queries) CreateMFAChallenge(ctx context.Context, userID pgtype.UUID) (CreateMFAChallengeRow, error) {
+	row := q.db.QueryRow(ctx, createMFAChallenge, userID)
+	var i CreateMFAChallengeRow
+	err := row.Scan(&i.ID, &i.ExpiresAt)
+	return i, err
+}
+
 const createPasswordResetToken = `-- name: CreatePasswordResetToken :one
 INSERT INTO password_reset_tokens (user_id)
 VALUES ($1)
@@ -85,6 +613,163 @@ func (q *Queries) CreatePasswordResetToken(ctx context.Context, userID pgtype.UU
 	return token, err
 }
 
+const createRecoveryCodes = `-- name: CreateRecoveryCodes :exec
+INSERT INTO recovery_codes (user_id, code_hash)
+SELECT $1, encode(digest(code, 'sha256'), 'hex') FROM unnest($2::text[]) AS code
+`
+
+type CreateRecoveryCodesParams struct {
+	UserID pgtype.UUID `json:"userId"`
+	Codes  []string    `json:"codes"`
+}
+
+func (q *Queries) CreateRecoveryCodes(ctx context.Context, arg CreateRecoveryCodesParams) error {
+	_, err := q.db.Exec(ctx, createRecoveryCodes, arg.UserID, arg.Codes)
+	return err
+}
+
+const createReminder = `-- name: CreateReminder :one
+INSERT INTO reminders (job_application_id, remind_at, note, unless_replied)
+SELECT j.id, $1::timestamptz, $2::text, $3::boolean
+FROM job_applications AS j
+WHERE j.id = $4 AND j.user_id = $5 AND j.deleted_at IS NULL
+RETURNING id, job_application_id, remind_at, note, unless_replied, is_suggested, status, delivered_at
+`
+
+type CreateReminderParams struct {
+	RemindAt         pgtype.Timestamptz `json:"remindAt"`
+	Note             pgtype.Text        `json:"note"`
+	UnlessReplied    bool               `json:"unlessReplied"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	UserID           pgtype.UUID        `json:"userId"`
+}
+
+type CreateReminderRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	RemindAt         pgtype.Timestamptz `json:"remindAt"`
+	Note             pgtype.Text        `json:"note"`
+	UnlessReplied    bool               `json:"unlessReplied"`
+	IsSuggested      bool               `json:"isSuggested"`
+	Status           ReminderStatus     `json:"status"`
+	DeliveredAt      pgtype.Timestamptz `json:"deliveredAt"`
+}
+
+func (q *Queries) CreateReminder(ctx context.Context, arg CreateReminderParams) (CreateReminderRow, error) {
+	row := q.db.QueryRow(ctx, createReminder,
+		arg.RemindAt,
+		arg.Note,
+		arg.UnlessReplied,
+		arg.JobApplicationID,
+		arg.UserID,
+	)
+	var i CreateReminderRow
+	err := row.Scan(
+		&i.ID,
+		&i.JobApplicationID,
+		&i.RemindAt,
+		&i.Note,
+		&i.UnlessReplied,
+		&i.IsSuggested,
+		&i.Status,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const createSession = `-- name: CreateSession :one
+INSERT INTO sessions (user_id) VALUES ($1) RETURNING id, refresh_token
+`
+
+type CreateSessionRow struct {
+	ID           pgtype.UUID `json:"id"`
+	RefreshToken string      `json:"refreshToken"`
+}
+
+func (q *Queries) CreateSession(ctx context.Context, userID pgtype.UUID) (CreateSessionRow, error) {
+	row := q.db.QueryRow(ctx, createSession, userID)
+	var i CreateSessionRow
+	err := row.Scan(&i.ID, &i.RefreshToken)
+	return i, err
+}
+
+const createStage = `-- name: CreateStage :one
+INSERT INTO stages (user_id, name, position, color, is_terminal, outcome)
+VALUES (
+  $1,
+  $2,
+  coalesce($3::integer, (SELECT coalesce(max(position) + 1, 0) FROM stages WHERE user_id = $1)),
+  coalesce(nullif($4::text, ''), '#64748b'),
+  $5,
+  $6
+)
+RETURNING id, name, position, color, is_terminal, outcome
+`
+
+type CreateStageParams struct {
+	UserID     pgtype.UUID  `json:"userId"`
+	Name       string       `json:"name"`
+	Position   pgtype.Int4  `json:"position"`
+	Color      pgtype.Text  `json:"color"`
+	IsTerminal bool         `json:"isTerminal"`
+	Outcome    StageOutcome `json:"outcome"`
+}
+
+type CreateStageRow struct {
+	ID         pgtype.UUID  `json:"id"`
+	Name       string       `json:"name"`
+	Position   int32        `json:"position"`
+	Color      string       `json:"color"`
+	IsTerminal bool         `json:"isTerminal"`
+	Outcome    StageOutcome `json:"outcome"`
+}
+
+func (q *Queries) CreateStage(ctx context.Context, arg CreateStageParams) (CreateStageRow, error) {
+	row := q.db.QueryRow(ctx, createStage,
+		arg.UserID,
+		arg.Name,
+		arg.Position,
+		arg.Color,
+		arg.IsTerminal,
+		arg.Outcome,
+	)
+	var i CreateStageRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Position,
+		&i.Color,
+		&i.IsTerminal,
+		&i.Outcome,
+	)
+	return i, err
+}
+
+const createTag = `-- name: CreateTag :one
+INSERT INTO tags (user_id, name, color)
+VALUES ($1, $2, coalesce(nullif($3::text, ''), '#64748b'))
+RETURNING id, name, color
+`
+
+type CreateTagParams struct {
+	UserID pgtype.UUID `json:"userId"`
+	Name   string      `json:"name"`
+	Color  pgtype.Text `json:"color"`
+}
+
+type CreateTagRow struct {
+	ID    pgtype.UUID `json:"id"`
+	Name  string      `json:"name"`
+	Color string      `json:"color"`
+}
+
+func (q *Queries) CreateTag(ctx context.Context, arg CreateTagParams) (CreateTagRow, error) {
+	row := q.db.QueryRow(ctx, createTag, arg.UserID, arg.Name, arg.Color)
+	var i CreateTagRow
+	err := row.Scan(&i.ID, &i.Name, &i.Color)
+	return i, err
+}
+
 const createUser = `-- name: CreateUser :one
 WITH new_user AS (
   INSERT INTO users (first_name, last_name, email, password)
@@ -144,414 +829,2996 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateU
 	return i, err
 }
 
-const deleteJobApplication = `-- name: DeleteJobApplication :one
-DELETE FROM job_applications WHERE id = $1 AND user_id = $2
-RETURNING id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, notes
+const createView = `-- name: CreateView :one
+INSERT INTO views (user_id, name, query, is_default)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, query, is_default
 `
 
-type DeleteJobApplicationParams struct {
-	ID     pgtype.UUID `json:"id"`
-	UserID pgtype.UUID `json:"userId"`
+type CreateViewParams struct {
+	UserID    pgtype.UUID `json:"userId"`
+	Name      string      `json:"name"`
+	Query     string      `json:"query"`
+	IsDefault bool        `json:"isDefault"`
 }
 
-type DeleteJobApplicationRow struct {
-	ID            pgtype.UUID        `json:"id"`
-	CompanyName   string             `json:"companyName"`
-	JobTitle      string             `json:"jobTitle"`
-	DateApplied   pgtype.Timestamptz `json:"dateApplied"`
-	Status        Status             `json:"status"`
-	IsReplied     bool               `json:"isReplied"`
-	MinSalary     pgtype.Float8      `json:"minSalary"`
-	MaxSalary     pgtype.Float8      `json:"maxSalary"`
-	JobPostingUrl pgtype.Text        `json:"jobPostingUrl"`
-	Notes         pgtype.Text        `json:"notes"`
+type CreateViewRow struct {
+	ID        pgtype.UUID `json:"id"`
+	Name      string      `json:"name"`
+	Query     string      `json:"query"`
+	IsDefault bool        `json:"isDefault"`
 }
 
-func (q *Queries) DeleteJobApplication(ctx context.Context, arg DeleteJobApplicationParams) (DeleteJobApplicationRow, error) {
-	row := q.db.QueryRow(ctx, deleteJobApplication, arg.ID, arg.UserID)
-	var i DeleteJobApplicationRow
+func (q *Queries) CreateView(ctx context.Context, arg CreateViewParams) (CreateViewRow, error) {
+	row := q.db.QueryRow(ctx, createView,
+		arg.UserID,
+		arg.Name,
+		arg.Query,
+		arg.IsDefault,
+	)
+	var i CreateViewRow
 	err := row.Scan(
 		&i.ID,
-		&i.CompanyName,
-		&i.JobTitle,
-		&i.DateApplied,
-		&i.Status,
-		&i.IsReplied,
-		&i.MinSalary,
-		&i.MaxSalary,
-		&i.JobPostingUrl,
-		&i.Notes,
+		&i.Name,
+		&i.Query,
+		&i.IsDefault,
 	)
 	return i, err
 }
 
-const deletePasswordResetToken = `-- name: DeletePasswordResetToken :exec
-DELETE FROM password_reset_tokens WHERE token = $1
+const deleteAttachment = `-- name: DeleteAttachment :one
+DELETE FROM attachments AS a
+USING job_applications AS j
+WHERE a.id = $1 AND a.job_application_id = $2 AND j.id = a.job_application_id AND j.user_id = $3 AND j.deleted_at IS NULL
+RETURNING a.id, a.job_application_id, a.kind, a.filename, a.content_type, a.size, a.checksum, a.storage_key, a.created_at
 `
 
-func (q *Queries) DeletePasswordResetToken(ctx context.Context, token string) error {
-	_, err := q.db.Exec(ctx, deletePasswordResetToken, token)
-	return err
+type DeleteAttachmentParams struct {
+	ID               pgtype.UUID `json:"id"`
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	UserID           pgtype.UUID `json:"userId"`
 }
 
-const expireVerificationToken = `-- name: ExpireVerificationToken :exec
-UPDATE verification_tokens SET expires_at = NOW() - INTERVAL '1 day' WHERE user_id = $1
-`
-
-func (q *Queries) ExpireVerificationToken(ctx context.Context, userID pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, expireVerificationToken, userID)
-	return err
+func (q *Queries) DeleteAttachment(ctx context.Context, arg DeleteAttachmentParams) (Attachment, error) {
+	row := q.db.QueryRow(ctx, deleteAttachment, arg.ID, arg.JobApplicationID, arg.UserID)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.JobApplicationID,
+		&i.Kind,
+		&i.Filename,
+		&i.ContentType,
+		&i.Size,
+		&i.Checksum,
+		&i.StorageKey,
+		&i.CreatedAt,
+	)
+	return i, err
 }
 
-const getJobApplication = `-- name: GetJobApplication :one
-SELECT id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, notes FROM job_applications WHERE id = $1 AND user_id = $2
+const deleteCompany = `-- name: DeleteCompany :one
+DELETE FROM companies WHERE id = $1 AND user_id = $2
+RETURNING id, name, website, industry, size, location, notes
 `
 
-type GetJobApplicationParams struct {
+type DeleteCompanyParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type DeleteCompanyRow struct {
+	ID       pgtype.UUID `json:"id"`
+	Name     string      `json:"name"`
+	Website  pgtype.Text `json:"website"`
+	Industry pgtype.Text `json:"industry"`
+	Size     pgtype.Text `json:"size"`
+	Location pgtype.Text `json:"location"`
+	Notes    pgtype.Text `json:"notes"`
+}
+
+func (q *Queries) DeleteCompany(ctx context.Context, arg DeleteCompanyParams) (DeleteCompanyRow, error) {
+	row := q.db.QueryRow(ctx, deleteCompany, arg.ID, arg.UserID)
+	var i DeleteCompanyRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Website,
+		&i.Industry,
+		&i.Size,
+		&i.Location,
+		&i.Notes,
+	)
+	return i, err
+}
+
+const deleteContact = `-- name: DeleteContact :one
+DELETE FROM contacts WHERE id = $1 AND user_id = $2
+RETURNING id, name, email, phone, linkedin_url, company, role, notes
+`
+
+type DeleteContactParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type DeleteContactRow struct {
+	ID          pgtype.UUID `json:"id"`
+	Name        string      `json:"name"`
+	Email       pgtype.Text `json:"email"`
+	Phone       pgtype.Text `json:"phone"`
+	LinkedinUrl pgtype.Text `json:"linkedinUrl"`
+	Company     pgtype.Text `json:"company"`
+	Role        pgtype.Text `json:"role"`
+	Notes       pgtype.Text `json:"notes"`
+}
+
+func (q *Queries) DeleteContact(ctx context.Context, arg DeleteContactParams) (DeleteContactRow, error) {
+	row := q.db.QueryRow(ctx, deleteContact, arg.ID, arg.UserID)
+	var i DeleteContactRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Phone,
+		&i.LinkedinUrl,
+		&i.Company,
+		&i.Role,
+		&i.Notes,
+	)
+	return i, err
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM idempotency_keys WHERE expires_at <= NOW()
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredIdempotencyKeys)
+	return err
+}
+
+const deleteIdempotencyKey = `-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE user_id IS NOT DISTINCT FROM $1::uuid AND key = $2::text
+`
+
+type DeleteIdempotencyKeyParams struct {
+	UserID pgtype.UUID `json:"userId"`
+	Key    string      `json:"key"`
+}
+
+func (q *Queries) DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, deleteIdempotencyKey, arg.UserID, arg.Key)
+	return err
+}
+
+const deleteInterview = `-- name: DeleteInterview :one
+DELETE FROM interviews AS i
+USING job_applications AS j
+WHERE i.id = $1 AND i.job_application_id = $2 AND j.id = i.job_application_id AND j.user_id = $3 AND j.deleted_at IS NULL
+RETURNING i.id, i.scheduled_at, i.timezone, i.duration, i.type, i.location, i.meeting_url, i.interviewers, i.preparation_notes, i.outcome
+`
+
+type DeleteInterviewParams struct {
+	ID               pgtype.UUID `json:"id"`
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	UserID           pgtype.UUID `json:"userId"`
+}
+
+type DeleteInterviewRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	ScheduledAt      pgtype.Timestamptz `json:"scheduledAt"`
+	Timezone         string             `json:"timezone"`
+	Duration         pgtype.Int4        `json:"duration"`
+	Type             InterviewType      `json:"type"`
+	Location         pgtype.Text        `json:"location"`
+	MeetingUrl       pgtype.Text        `json:"meetingUrl"`
+	Interviewers     []string           `json:"interviewers"`
+	PreparationNotes pgtype.Text        `json:"preparationNotes"`
+	Outcome          InterviewOutcome   `json:"outcome"`
+}
+
+func (q *Queries) DeleteInterview(ctx context.Context, arg DeleteInterviewParams) (DeleteInterviewRow, error) {
+	row := q.db.QueryRow(ctx, deleteInterview, arg.ID, arg.JobApplicationID, arg.UserID)
+	var i DeleteInterviewRow
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Duration,
+		&i.Type,
+		&i.Location,
+		&i.MeetingUrl,
+		&i.Interviewers,
+		&i.PreparationNotes,
+		&i.Outcome,
+	)
+	return i, err
+}
+
+const deleteJobApplication = `-- name: DeleteJobApplication :one
+WITH deleted_job_application AS (
+  UPDATE job_applications SET deleted_at = NOW()
+  WHERE id = $1::uuid AND user_id = $2::uuid AND deleted_at IS NULL
+  RETURNING id, company_id, company_name, job_title, date_applied, stage_id, is_replied, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, job_posting_url, notes, deleted_at
+)
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes, j.deleted_at
+FROM deleted_job_application AS j
+JOIN stages AS s ON s.id = j.stage_id
+`
+
+type DeleteJobApplicationParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type DeleteJobApplicationRow struct {
+	ID              pgtype.UUID        `json:"id"`
+	CompanyID       pgtype.UUID        `json:"companyId"`
+	CompanyName     string             `json:"companyName"`
+	JobTitle        string             `json:"jobTitle"`
+	DateApplied     pgtype.Date        `json:"dateApplied"`
+	StageID         pgtype.UUID        `json:"stageId"`
+	StageName       string             `json:"stageName"`
+	StageColor      string             `json:"stageColor"`
+	StageIsTerminal bool               `json:"stageIsTerminal"`
+	StageOutcome    StageOutcome       `json:"stageOutcome"`
+	IsReplied       bool               `json:"isReplied"`
+	MinSalary       pgtype.Float8      `json:"minSalary"`
+	MaxSalary       pgtype.Float8      `json:"maxSalary"`
+	SalaryCurrency  string             `json:"salaryCurrency"`
+	SalaryPeriod    SalaryPeriod       `json:"salaryPeriod"`
+	SalaryType      SalaryType         `json:"salaryType"`
+	ContractType    NullContractType   `json:"contractType"`
+	JobPostingUrl   pgtype.Text        `json:"jobPostingUrl"`
+	Notes           pgtype.Text        `json:"notes"`
+	DeletedAt       pgtype.Timestamptz `json:"deletedAt"`
+}
+
+func (q *Queries) DeleteJobApplication(ctx context.Context, arg DeleteJobApplicationParams) (DeleteJobApplicationRow, error) {
+	row := q.db.QueryRow(ctx, deleteJobApplication, arg.ID, arg.UserID)
+	var i DeleteJobApplicationRow
+	err := row.Scan(
+		&i.ID,
+		&i.CompanyID,
+		&i.CompanyName,
+		&i.JobTitle,
+		&i.DateApplied,
+		&i.StageID,
+		&i.StageName,
+		&i.StageColor,
+		&i.StageIsTerminal,
+		&i.StageOutcome,
+		&i.IsReplied,
+		&i.MinSalary,
+		&i.MaxSalary,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
+		&i.SalaryType,
+		&i.ContractType,
+		&i.JobPostingUrl,
+		&i.Notes,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const deletePasswordResetToken = `-- name: DeletePasswordResetToken :one
+DELETE FROM password_reset_tokens WHERE token = $1 RETURNING token, expires_at, user_id
+`
+
+type DeletePasswordResetTokenRow struct {
+	Token     string             `json:"token"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+	UserID    pgtype.UUID        `json:"userId"`
+}
+
+func (q *Queries) DeletePasswordResetToken(ctx context.Context, token string) (DeletePasswordResetTokenRow, error) {
+	row := q.db.QueryRow(ctx, deletePasswordResetToken, token)
+	var i DeletePasswordResetTokenRow
+	err := row.Scan(&i.Token, &i.ExpiresAt, &i.UserID)
+	return i, err
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE FROM recovery_codes WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRecoveryCodes(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRecoveryCodes, userID)
+	return err
+}
+
+const deleteStage = `-- name: DeleteStage :one
+DELETE FROM stages WHERE id = $1 AND user_id = $2
+RETURNING id, name, position, color, is_terminal, outcome
+`
+
+type DeleteStageParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type DeleteStageRow struct {
+	ID         pgtype.UUID  `json:"id"`
+	Name       string       `json:"name"`
+	Position   int32        `json:"position"`
+	Color      string       `json:"color"`
+	IsTerminal bool         `json:"isTerminal"`
+	Outcome    StageOutcome `json:"outcome"`
+}
+
+func (q *Queries) DeleteStage(ctx context.Context, arg DeleteStageParams) (DeleteStageRow, error) {
+	row := q.db.QueryRow(ctx, deleteStage, arg.ID, arg.UserID)
+	var i DeleteStageRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Position,
+		&i.Color,
+		&i.IsTerminal,
+		&i.Outcome,
+	)
+	return i, err
+}
+
+const deleteTag = `-- name: DeleteTag :one
+DELETE FROM tags WHERE id = $1 AND user_id = $2
+RETURNING id, name, color
+`
+
+type DeleteTagParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type DeleteTagRow struct {
+	ID    pgtype.UUID `json:"id"`
+	Name  string      `json:"name"`
+	Color string      `json:"color"`
+}
+
+func (q *Queries) DeleteTag(ctx context.Context, arg DeleteTagParams) (DeleteTagRow, error) {
+	row := q.db.QueryRow(ctx, deleteTag, arg.ID, arg.UserID)
+	var i DeleteTagRow
+	err := row.Scan(&i.ID, &i.Name, &i.Color)
+	return i, err
+}
+
+const deleteView = `-- name: DeleteView :one
+DELETE FROM views WHERE id = $1 AND user_id = $2
+RETURNING id, name, query, is_default
+`
+
+type DeleteViewParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type DeleteViewRow struct {
+	ID        pgtype.UUID `json:"id"`
+	Name      string      `json:"name"`
+	Query     string      `json:"query"`
+	IsDefault bool        `json:"isDefault"`
+}
+
+func (q *Queries) DeleteView(ctx context.Context, arg DeleteViewParams) (DeleteViewRow, error) {
+	row := q.db.QueryRow(ctx, deleteView, arg.ID, arg.UserID)
+	var i DeleteViewRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Query,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const disableTOTP = `-- name: DisableTOTP :exec
+UPDATE users SET totp_secret = NULL, is_totp_enabled = false, totp_last_step = NULL WHERE id = $1
+`
+
+func (q *Queries) DisableTOTP(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, disableTOTP, id)
+	return err
+}
+
+const dismissReminder = `-- name: DismissReminder :one
+UPDATE reminders AS r
+SET status = 'DISMISSED'
+FROM job_applications AS j
+WHERE r.id = $1 AND j.id = r.job_application_id AND j.user_id = $2 AND j.deleted_at IS NULL
+RETURNING r.id, r.job_application_id, r.remind_at, r.note, r.unless_replied, r.is_suggested, r.status, r.delivered_at
+`
+
+type DismissReminderParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type DismissReminderRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	RemindAt         pgtype.Timestamptz `json:"remindAt"`
+	Note             pgtype.Text        `json:"note"`
+	UnlessReplied    bool               `json:"unlessReplied"`
+	IsSuggested      bool               `json:"isSuggested"`
+	Status           ReminderStatus     `json:"status"`
+	DeliveredAt      pgtype.Timestamptz `json:"deliveredAt"`
+}
+
+func (q *Queries) DismissReminder(ctx context.Context, arg DismissReminderParams) (DismissReminderRow, error) {
+	row := q.db.QueryRow(ctx, dismissReminder, arg.ID, arg.UserID)
+	var i DismissReminderRow
+	err := row.Scan(
+		&i.ID,
+		&i.JobApplicationID,
+		&i.RemindAt,
+		&i.Note,
+		&i.UnlessReplied,
+		&i.IsSuggested,
+		&i.Status,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const enableTOTP = `-- name: EnableTOTP :exec
+UPDATE users SET is_totp_enabled = true WHERE id = $1 AND totp_secret IS NOT NULL
+`
+
+func (q *Queries) EnableTOTP(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, enableTOTP, id)
+	return err
+}
+
+const enqueueEmail = `-- name: EnqueueEmail :one
+INSERT INTO email_outbox (recipient, subject, body) VALUES ($1, $2, $3) RETURNING id
+`
+
+type EnqueueEmailParams struct {
+	Recipient string `json:"recipient"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body"`
+}
+
+func (q *Queries) EnqueueEmail(ctx context.Context, arg EnqueueEmailParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, enqueueEmail, arg.Recipient, arg.Subject, arg.Body)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const expireVerificationToken = `-- name: ExpireVerificationToken :exec
+UPDATE verification_tokens SET expires_at = NOW() - INTERVAL '1 day' WHERE user_id = $1
+`
+
+func (q *Queries) ExpireVerificationToken(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, expireVerificationToken, userID)
+	return err
+}
+
+const getActiveSession = `-- name: GetActiveSession :one
+SELECT id, user_id FROM sessions WHERE id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+`
+
+type GetActiveSessionRow struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+func (q *Queries) GetActiveSession(ctx context.Context, id pgtype.UUID) (GetActiveSessionRow, error) {
+	row := q.db.QueryRow(ctx, getActiveSession, id)
+	var i GetActiveSessionRow
+	err := row.Scan(&i.ID, &i.UserID)
+	return i, err
+}
+
+const getAttachment = `-- name: GetAttachment :one
+SELECT a.id, a.job_application_id, a.kind, a.filename, a.content_type, a.size, a.checksum, a.storage_key, a.created_at
+FROM attachments AS a
+JOIN job_applications AS j ON j.id = a.job_application_id
+WHERE a.id = $1 AND a.job_application_id = $2 AND j.user_id = $3 AND j.deleted_at IS NULL
+`
+
+type GetAttachmentParams struct {
+	ID               pgtype.UUID `json:"id"`
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	UserID           pgtype.UUID `json:"userId"`
+}
+
+func (q *Queries) GetAttachment(ctx context.Context, arg GetAttachmentParams) (Attachment, error) {
+	row := q.db.QueryRow(ctx, getAttachment, arg.ID, arg.JobApplicationID, arg.UserID)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.JobApplicationID,
+		&i.Kind,
+		&i.Filename,
+		&i.ContentType,
+		&i.Size,
+		&i.Checksum,
+		&i.StorageKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAttachments = `-- name: GetAttachments :many
+SELECT a.id, a.job_application_id, j.company_name, j.job_title, a.kind, a.filename, a.content_type, a.size, a.checksum, a.created_at
+FROM attachments AS a
+JOIN job_applications AS j ON j.id = a.job_application_id
+WHERE
+  j.user_id = $1
+  AND j.deleted_at IS NULL
+  AND (a.job_application_id = $2::uuid OR $2::uuid IS NULL)
+  AND (a.kind = $3::attachment_kind OR $3::attachment_kind IS NULL)
+  AND (a.checksum = $4::text OR $4::text IS NULL)
+ORDER BY a.created_at DESC, a.id
+`
+
+type GetAttachmentsParams struct {
+	UserID           pgtype.UUID        `json:"userId"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	Kind             NullAttachmentKind `json:"kind"`
+	Checksum         pgtype.Text        `json:"checksum"`
+}
+
+type GetAttachmentsRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	CompanyName      string             `json:"companyName"`
+	JobTitle         string             `json:"jobTitle"`
+	Kind             AttachmentKind     `json:"kind"`
+	Filename         string             `json:"filename"`
+	ContentType      string             `json:"contentType"`
+	Size             int64              `json:"size"`
+	Checksum         string             `json:"checksum"`
+	CreatedAt        pgtype.Timestamptz `json:"createdAt"`
+}
+
+func (q *Queries) GetAttachments(ctx context.Context, arg GetAttachmentsParams) ([]GetAttachmentsRow, error) {
+	rows, err := q.db.Query(ctx, getAttachments,
+		arg.UserID,
+		arg.JobApplicationID,
+		arg.Kind,
+		arg.Checksum,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAttachmentsRow
+	for rows.Next() {
+		var i GetAttachmentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobApplicationID,
+			&i.CompanyName,
+			&i.JobTitle,
+			&i.Kind,
+			&i.Filename,
+			&i.ContentType,
+			&i.Size,
+			&i.Checksum,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAttachmentsUsage = `-- name: GetAttachmentsUsage :one
+SELECT coalesce(sum(a.size), 0)::bigint AS usage
+FROM attachments AS a
+JOIN job_applications AS j ON j.id = a.job_application_id
+WHERE j.user_id = $1
+`
+
+// NOTE: Attachments of trashed applications still take up space until they are purged
+func (q *Queries) GetAttachmentsUsage(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, getAttachmentsUsage, userID)
+	var usage int64
+	err := row.Scan(&usage)
+	return usage, err
+}
+
+const getCompanies = `-- name: GetCompanies :many
+SELECT c.id, c.name, c.website, c.industry, c.size, c.location, count(j.id) AS job_application_count, COUNT(*) OVER() AS total
+FROM companies AS c
+LEFT JOIN job_applications AS j ON j.company_id = c.id AND j.deleted_at IS NULL
+WHERE
+  c.user_id = $3
+  -- NOTE: A search normalising to nothing, e.g. punctuation alone, is only matched against the name, rather than against every company
+  AND (
+    c.name ILIKE '%' || $4::text || '%'
+    OR (normalize_company_name($4::text) <> '' AND c.normalized_name LIKE '%' || normalize_company_name($4::text) || '%')
+  )
+GROUP BY c.id
+ORDER BY c.name, c.created_at
+LIMIT $1 OFFSET $2
+`
+
+type GetCompaniesParams struct {
+	Limit  int32       `json:"limit"`
+	Offset int32       `json:"offset"`
+	UserID pgtype.UUID `json:"userId"`
+	Search string      `json:"search"`
+}
+
+type GetCompaniesRow struct {
+	ID                  pgtype.UUID `json:"id"`
+	Name                string      `json:"name"`
+	Website             pgtype.Text `json:"website"`
+	Industry            pgtype.Text `json:"industry"`
+	Size                pgtype.Text `json:"size"`
+	Location            pgtype.Text `json:"location"`
+	JobApplicationCount int64       `json:"jobApplicationCount"`
+	Total               int64       `json:"total"`
+}
+
+func (q *Queries) GetCompanies(ctx context.Context, arg GetCompaniesParams) ([]GetCompaniesRow, error) {
+	rows, err := q.db.Query(ctx, getCompanies,
+		arg.Limit,
+		arg.Offset,
+		arg.UserID,
+		arg.Search,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCompaniesRow
+	for rows.Next() {
+		var i GetCompaniesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Website,
+			&i.Industry,
+			&i.Size,
+			&i.Location,
+			&i.JobApplicationCount,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompaniesByIds = `-- name: GetCompaniesByIds :many
+SELECT id FROM companies WHERE user_id = $1 AND id = ANY($2::uuid[])
+`
+
+type GetCompaniesByIdsParams struct {
+	UserID pgtype.UUID   `json:"userId"`
+	Ids    []pgtype.UUID `json:"ids"`
+}
+
+func (q *Queries) GetCompaniesByIds(ctx context.Context, arg GetCompaniesByIdsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, getCompaniesByIds, arg.UserID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompany = `-- name: GetCompany :one
+SELECT id, name, website, industry, size, location, notes FROM companies WHERE id = $1 AND user_id = $2
+`
+
+type GetCompanyParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type GetCompanyRow struct {
+	ID       pgtype.UUID `json:"id"`
+	Name     string      `json:"name"`
+	Website  pgtype.Text `json:"website"`
+	Industry pgtype.Text `json:"industry"`
+	Size     pgtype.Text `json:"size"`
+	Location pgtype.Text `json:"location"`
+	Notes    pgtype.Text `json:"notes"`
+}
+
+func (q *Queries) GetCompany(ctx context.Context, arg GetCompanyParams) (GetCompanyRow, error) {
+	row := q.db.QueryRow(ctx, getCompany, arg.ID, arg.UserID)
+	var i GetCompanyRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Website,
+		&i.Industry,
+		&i.Size,
+		&i.Location,
+		&i.Notes,
+	)
+	return i, err
+}
+
+const getCompanyJobApplications = `-- name: GetCompanyJobApplications :many
+SELECT
+  j.id, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome
+FROM job_applications AS j
+JOIN stages AS s ON s.id = j.stage_id
+WHERE j.company_id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL
+ORDER BY j.date_applied DESC
+`
+
+type GetCompanyJobApplicationsParams struct {
+	CompanyID pgtype.UUID `json:"companyId"`
+	UserID    pgtype.UUID `json:"userId"`
+}
+
+type GetCompanyJobApplicationsRow struct {
+	ID              pgtype.UUID  `json:"id"`
+	JobTitle        string       `json:"jobTitle"`
+	DateApplied     pgtype.Date  `json:"dateApplied"`
+	StageID         pgtype.UUID  `json:"stageId"`
+	StageName       string       `json:"stageName"`
+	StageColor      string       `json:"stageColor"`
+	StageIsTerminal bool         `json:"stageIsTerminal"`
+	StageOutcome    StageOutcome `json:"stageOutcome"`
+}
+
+func (q *Queries) GetCompanyJobApplications(ctx context.Context, arg GetCompanyJobApplicationsParams) ([]GetCompanyJobApplicationsRow, error) {
+	rows, err := q.db.Query(ctx, getCompanyJobApplications, arg.CompanyID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCompanyJobApplicationsRow
+	for rows.Next() {
+		var i GetCompanyJobApplicationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobTitle,
+			&i.DateApplied,
+			&i.StageID,
+			&i.StageName,
+			&i.StageColor,
+			&i.StageIsTerminal,
+			&i.StageOutcome,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getContact = `-- name: GetContact :one
+SELECT id, name, email, phone, linkedin_url, company, role, notes FROM contacts WHERE id = $1 AND user_id = $2
+`
+
+type GetContactParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type GetContactRow struct {
+	ID          pgtype.UUID `json:"id"`
+	Name        string      `json:"name"`
+	Email       pgtype.Text `json:"email"`
+	Phone       pgtype.Text `json:"phone"`
+	LinkedinUrl pgtype.Text `json:"linkedinUrl"`
+	Company     pgtype.Text `json:"company"`
+	Role        pgtype.Text `json:"role"`
+	Notes       pgtype.Text `json:"notes"`
+}
+
+func (q *Queries) GetContact(ctx context.Context, arg GetContactParams) (GetContactRow, error) {
+	row := q.db.QueryRow(ctx, getContact, arg.ID, arg.UserID)
+	var i GetContactRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Phone,
+		&i.LinkedinUrl,
+		&i.Company,
+		&i.Role,
+		&i.Notes,
+	)
+	return i, err
+}
+
+const getContactJobApplications = `-- name: GetContactJobApplications :many
+SELECT j.id, j.company_name, j.job_title, j.date_applied
+FROM job_application_contacts AS jc
+JOIN job_applications AS j ON j.id = jc.job_application_id
+WHERE jc.contact_id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL
+ORDER BY j.date_applied DESC
+`
+
+type GetContactJobApplicationsParams struct {
+	ContactID pgtype.UUID `json:"contactId"`
+	UserID    pgtype.UUID `json:"userId"`
+}
+
+type GetContactJobApplicationsRow struct {
+	ID          pgtype.UUID `json:"id"`
+	CompanyName string      `json:"companyName"`
+	JobTitle    string      `json:"jobTitle"`
+	DateApplied pgtype.Date `json:"dateApplied"`
+}
+
+func (q *Queries) GetContactJobApplications(ctx context.Context, arg GetContactJobApplicationsParams) ([]GetContactJobApplicationsRow, error) {
+	rows, err := q.db.Query(ctx, getContactJobApplications, arg.ContactID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetContactJobApplicationsRow
+	for rows.Next() {
+		var i GetContactJobApplicationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CompanyName,
+			&i.JobTitle,
+			&i.DateApplied,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getContacts = `-- name: GetContacts :many
+SELECT id, name, email, phone, linkedin_url, company, role, COUNT(*) OVER() AS total
+FROM contacts
+WHERE
+  user_id = $3
+  AND (
+    $4::text IS NULL
+    OR name ILIKE '%' || $4::text || '%'
+    OR email ILIKE '%' || $4::text || '%'
+    OR company ILIKE '%' || $4::text || '%'
+    OR role ILIKE '%' || $4::text || '%'
+  )
+ORDER BY name, created_at
+LIMIT $1 OFFSET $2
+`
+
+type GetContactsParams struct {
+	Limit  int32       `json:"limit"`
+	Offset int32       `json:"offset"`
+	UserID pgtype.UUID `json:"userId"`
+	Search string      `json:"search"`
+}
+
+type GetContactsRow struct {
+	ID          pgtype.UUID `json:"id"`
+	Name        string      `json:"name"`
+	Email       pgtype.Text `json:"email"`
+	Phone       pgtype.Text `json:"phone"`
+	LinkedinUrl pgtype.Text `json:"linkedinUrl"`
+	Company     pgtype.Text `json:"company"`
+	Role        pgtype.Text `json:"role"`
+	Total       int64       `json:"total"`
+}
+
+func (q *Queries) GetContacts(ctx context.Context, arg GetContactsParams) ([]GetContactsRow, error) {
+	rows, err := q.db.Query(ctx, getContacts,
+		arg.Limit,
+		arg.Offset,
+		arg.UserID,
+		arg.Search,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetContactsRow
+	for rows.Next() {
+		var i GetContactsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.Phone,
+			&i.LinkedinUrl,
+			&i.Company,
+			&i.Role,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDefaultView = `-- name: GetDefaultView :one
+SELECT id, name, query, is_default FROM views WHERE user_id = $1 AND is_default
+`
+
+type GetDefaultViewRow struct {
+	ID        pgtype.UUID `json:"id"`
+	Name      string      `json:"name"`
+	Query     string      `json:"query"`
+	IsDefault bool        `json:"isDefault"`
+}
+
+func (q *Queries) GetDefaultView(ctx context.Context, userID pgtype.UUID) (GetDefaultViewRow, error) {
+	row := q.db.QueryRow(ctx, getDefaultView, userID)
+	var i GetDefaultViewRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Query,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const getEmails = `-- name: GetEmails :many
+SELECT id, recipient, subject, status, attempts, next_attempt_at, last_error, sent_at FROM email_outbox ORDER BY created_at
+`
+
+type GetEmailsRow struct {
+	ID            pgtype.UUID        `json:"id"`
+	Recipient     string             `json:"recipient"`
+	Subject       string             `json:"subject"`
+	Status        EmailStatus        `json:"status"`
+	Attempts      int32              `json:"attempts"`
+	NextAttemptAt pgtype.Timestamptz `json:"nextAttemptAt"`
+	LastError     pgtype.Text        `json:"lastError"`
+	SentAt        pgtype.Timestamptz `json:"sentAt"`
+}
+
+func (q *Queries) GetEmails(ctx context.Context) ([]GetEmailsRow, error) {
+	rows, err := q.db.Query(ctx, getEmails)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEmailsRow
+	for rows.Next() {
+		var i GetEmailsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Recipient,
+			&i.Subject,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExchangeRate = `-- name: GetExchangeRate :one
+SELECT currency, rate, updated_at FROM exchange_rates WHERE currency = $1
+`
+
+func (q *Queries) GetExchangeRate(ctx context.Context, currency string) (ExchangeRate, error) {
+	row := q.db.QueryRow(ctx, getExchangeRate, currency)
+	var i ExchangeRate
+	err := row.Scan(&i.Currency, &i.Rate, &i.UpdatedAt)
+	return i, err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT request_hash, status, response
+FROM idempotency_keys
+WHERE user_id IS NOT DISTINCT FROM $1::uuid AND key = $2::text
+`
+
+type GetIdempotencyKeyParams struct {
+	UserID pgtype.UUID `json:"userId"`
+	Key    string      `json:"key"`
+}
+
+type GetIdempotencyKeyRow struct {
+	RequestHash string      `json:"requestHash"`
+	Status      pgtype.Int4 `json:"status"`
+	Response    []byte      `json:"response"`
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (GetIdempotencyKeyRow, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.UserID, arg.Key)
+	var i GetIdempotencyKeyRow
+	err := row.Scan(&i.RequestHash, &i.Status, &i.Response)
+	return i, err
+}
+
+const getInterview = `-- name: GetInterview :one
+SELECT i.id, i.scheduled_at, i.timezone, i.duration, i.type, i.location, i.meeting_url, i.interviewers, i.preparation_notes, i.outcome
+FROM interviews AS i
+JOIN job_applications AS j ON j.id = i.job_application_id
+WHERE i.id = $1 AND i.job_application_id = $2 AND j.user_id = $3 AND j.deleted_at IS NULL
+`
+
+type GetInterviewParams struct {
+	ID               pgtype.UUID `json:"id"`
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	UserID           pgtype.UUID `json:"userId"`
+}
+
+type GetInterviewRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	ScheduledAt      pgtype.Timestamptz `json:"scheduledAt"`
+	Timezone         string             `json:"timezone"`
+	Duration         pgtype.Int4        `json:"duration"`
+	Type             InterviewType      `json:"type"`
+	Location         pgtype.Text        `json:"location"`
+	MeetingUrl       pgtype.Text        `json:"meetingUrl"`
+	Interviewers     []string           `json:"interviewers"`
+	PreparationNotes pgtype.Text        `json:"preparationNotes"`
+	Outcome          InterviewOutcome   `json:"outcome"`
+}
+
+func (q *Queries) GetInterview(ctx context.Context, arg GetInterviewParams) (GetInterviewRow, error) {
+	row := q.db.QueryRow(ctx, getInterview, arg.ID, arg.JobApplicationID, arg.UserID)
+	var i GetInterviewRow
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Duration,
+		&i.Type,
+		&i.Location,
+		&i.MeetingUrl,
+		&i.Interviewers,
+		&i.PreparationNotes,
+		&i.Outcome,
+	)
+	return i, err
+}
+
+const getInterviews = `-- name: GetInterviews :many
+SELECT i.id, i.scheduled_at, i.timezone, i.duration, i.type, i.location, i.meeting_url, i.interviewers, i.preparation_notes, i.outcome
+FROM interviews AS i
+JOIN job_applications AS j ON j.id = i.job_application_id
+WHERE i.job_application_id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL
+ORDER BY i.scheduled_at
+`
+
+type GetInterviewsParams struct {
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	UserID           pgtype.UUID `json:"userId"`
+}
+
+type GetInterviewsRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	ScheduledAt      pgtype.Timestamptz `json:"scheduledAt"`
+	Timezone         string             `json:"timezone"`
+	Duration         pgtype.Int4        `json:"duration"`
+	Type             InterviewType      `json:"type"`
+	Location         pgtype.Text        `json:"location"`
+	MeetingUrl       pgtype.Text        `json:"meetingUrl"`
+	Interviewers     []string           `json:"interviewers"`
+	PreparationNotes pgtype.Text        `json:"preparationNotes"`
+	Outcome          InterviewOutcome   `json:"outcome"`
+}
+
+func (q *Queries) GetInterviews(ctx context.Context, arg GetInterviewsParams) ([]GetInterviewsRow, error) {
+	rows, err := q.db.Query(ctx, getInterviews, arg.JobApplicationID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetInterviewsRow
+	for rows.Next() {
+		var i GetInterviewsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduledAt,
+			&i.Timezone,
+			&i.Duration,
+			&i.Type,
+			&i.Location,
+			&i.MeetingUrl,
+			&i.Interviewers,
+			&i.PreparationNotes,
+			&i.Outcome,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJobApplication = `-- name: GetJobApplication :one
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes, j.version
+FROM job_applications AS j
+JOIN stages AS s ON s.id = j.stage_id
+WHERE j.id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL
+`
+
+type GetJobApplicationParams struct {
 	ID     pgtype.UUID `json:"id"`
 	UserID pgtype.UUID `json:"userId"`
 }
 
 type GetJobApplicationRow struct {
+	ID              pgtype.UUID      `json:"id"`
+	CompanyID       pgtype.UUID      `json:"companyId"`
+	CompanyName     string           `json:"companyName"`
+	JobTitle        string           `json:"jobTitle"`
+	DateApplied     pgtype.Date      `json:"dateApplied"`
+	StageID         pgtype.UUID      `json:"stageId"`
+	StageName       string           `json:"stageName"`
+	StageColor      string           `json:"stageColor"`
+	StageIsTerminal bool             `json:"stageIsTerminal"`
+	StageOutcome    StageOutcome     `json:"stageOutcome"`
+	IsReplied       bool             `json:"isReplied"`
+	MinSalary       pgtype.Float8    `json:"minSalary"`
+	MaxSalary       pgtype.Float8    `json:"maxSalary"`
+	SalaryCurrency  string           `json:"salaryCurrency"`
+	SalaryPeriod    SalaryPeriod     `json:"salaryPeriod"`
+	SalaryType      SalaryType       `json:"salaryType"`
+	ContractType    NullContractType `json:"contractType"`
+	JobPostingUrl   pgtype.Text      `json:"jobPostingUrl"`
+	Notes           pgtype.Text      `json:"notes"`
+	Version         int32            `json:"version"`
+}
+
+func (q *Queries) GetJobApplication(ctx context.Context, arg GetJobApplicationParams) (GetJobApplicationRow, error) {
+	row := q.db.QueryRow(ctx, getJobApplication, arg.ID, arg.UserID)
+	var i GetJobApplicationRow
+	err := row.Scan(
+		&i.ID,
+		&i.CompanyID,
+		&i.CompanyName,
+		&i.JobTitle,
+		&i.DateApplied,
+		&i.StageID,
+		&i.StageName,
+		&i.StageColor,
+		&i.StageIsTerminal,
+		&i.StageOutcome,
+		&i.IsReplied,
+		&i.MinSalary,
+		&i.MaxSalary,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
+		&i.SalaryType,
+		&i.ContractType,
+		&i.JobPostingUrl,
+		&i.Notes,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getJobApplicationContacts = `-- name: GetJobApplicationContacts :many
+SELECT c.id, c.name, c.email, c.phone, c.linkedin_url, c.company, c.role
+FROM job_application_contacts AS jc
+JOIN contacts AS c ON c.id = jc.contact_id
+WHERE jc.job_application_id = $1 AND c.user_id = $2
+ORDER BY c.name, jc.created_at
+`
+
+type GetJobApplicationContactsParams struct {
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	UserID           pgtype.UUID `json:"userId"`
+}
+
+type GetJobApplicationContactsRow struct {
+	ID          pgtype.UUID `json:"id"`
+	Name        string      `json:"name"`
+	Email       pgtype.Text `json:"email"`
+	Phone       pgtype.Text `json:"phone"`
+	LinkedinUrl pgtype.Text `json:"linkedinUrl"`
+	Company     pgtype.Text `json:"company"`
+	Role        pgtype.Text `json:"role"`
+}
+
+func (q *Queries) GetJobApplicationContacts(ctx context.Context, arg GetJobApplicationContactsParams) ([]GetJobApplicationContactsRow, error) {
+	rows, err := q.db.Query(ctx, getJobApplicationContacts, arg.JobApplicationID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetJobApplicationContactsRow
+	for rows.Next() {
+		var i GetJobApplicationContactsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.Phone,
+			&i.LinkedinUrl,
+			&i.Company,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJobApplicationEvents = `-- name: GetJobApplicationEvents :many
+SELECT e.id, e.field, e.old_value, e.new_value, s.name AS stage_name, e.created_at
+FROM job_application_events AS e
+JOIN job_applications AS j ON j.id = e.job_application_id
+LEFT JOIN stages AS s ON e.field = 'stage' AND s.id::text = e.new_value
+WHERE e.job_application_id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL
+ORDER BY e.created_at, e.id
+`
+
+type GetJobApplicationEventsParams struct {
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	UserID           pgtype.UUID `json:"userId"`
+}
+
+type GetJobApplicationEventsRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	Field     string             `json:"field"`
+	OldValue  pgtype.Text        `json:"oldValue"`
+	NewValue  pgtype.Text        `json:"newValue"`
+	StageName pgtype.Text        `json:"stageName"`
+	CreatedAt pgtype.Timestamptz `json:"createdAt"`
+}
+
+func (q *Queries) GetJobApplicationEvents(ctx context.Context, arg GetJobApplicationEventsParams) ([]GetJobApplicationEventsRow, error) {
+	rows, err := q.db.Query(ctx, getJobApplicationEvents, arg.JobApplicationID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetJobApplicationEventsRow
+	for rows.Next() {
+		var i GetJobApplicationEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Field,
+			&i.OldValue,
+			&i.NewValue,
+			&i.StageName,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJobApplicationStageStats = `-- name: GetJobApplicationStageStats :many
+WITH filtered_job_applications AS (
+  SELECT
+    j.id, j.stage_id,
+    CASE $1::text WHEN 'company' THEN j.company_id::text WHEN 'job_title' THEN lower(j.job_title) END AS group_key
+  FROM job_applications AS j
+  WHERE
+    j.user_id = $2
+    AND j.deleted_at IS NULL
+    AND (j.date_applied >= $3::date OR $3::date IS NULL)
+    AND (j.date_applied <= $4::date OR $4::date IS NULL)
+),
+reached_stages AS (
+  SELECT f.id, f.group_key, e.new_value::uuid AS stage_id, false AS is_current
+  FROM filtered_job_applications AS f
+  JOIN job_application_events AS e ON e.job_application_id = f.id AND e.field = 'stage'
+  UNION ALL
+  SELECT f.id, f.group_key, f.stage_id, true AS is_current
+  FROM filtered_job_applications AS f
+)
+SELECT
+  (GROUPING(group_key) = 1)::bool AS is_overall,
+  coalesce(group_key, '')::text AS group_key,
+  stage_id,
+  count(DISTINCT id) FILTER (WHERE is_current) AS current,
+  count(DISTINCT id) AS reached
+FROM reached_stages
+GROUP BY GROUPING SETS ((stage_id), (group_key, stage_id))
+HAVING GROUPING(group_key) = 1 OR $1::text <> ''
+`
+
+type GetJobApplicationStageStatsParams struct {
+	GroupBy string      `json:"groupBy"`
+	UserID  pgtype.UUID `json:"userId"`
+	From    pgtype.Date `json:"from"`
+	To      pgtype.Date `json:"to"`
+}
+
+type GetJobApplicationStageStatsRow struct {
+	IsOverall bool        `json:"isOverall"`
+	GroupKey  string      `json:"groupKey"`
+	StageID   pgtype.UUID `json:"stageId"`
+	Current   int64       `json:"current"`
+	Reached   int64       `json:"reached"`
+}
+
+// NOTE: Every stage an application has ever been in, according to its history, plus the current one
+func (q *Queries) GetJobApplicationStageStats(ctx context.Context, arg GetJobApplicationStageStatsParams) ([]GetJobApplicationStageStatsRow, error) {
+	rows, err := q.db.Query(ctx, getJobApplicationStageStats,
+		arg.GroupBy,
+		arg.UserID,
+		arg.From,
+		arg.To,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetJobApplicationStageStatsRow
+	for rows.Next() {
+		var i GetJobApplicationStageStatsRow
+		if err := rows.Scan(
+			&i.IsOverall,
+			&i.GroupKey,
+			&i.StageID,
+			&i.Current,
+			&i.Reached,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJobApplicationStats = `-- name: GetJobApplicationStats :many
+WITH filtered_job_applications AS (
+  SELECT
+    j.id, j.date_applied, j.is_replied, s.outcome AS stage_outcome,
+    -- NOTE: Midpoint of the salary range, or whichever end of it was given, in the requested currency and period,
+    -- left out for a salary of another type than the requested one, if any
+    CASE WHEN $3::salary_type IS NULL OR j.salary_type = $3::salary_type THEN
+      normalize_salary(
+        CASE WHEN j.min_salary IS NOT NULL AND j.max_salary IS NOT NULL THEN (j.min_salary + j.max_salary) / 2 ELSE coalesce(j.min_salary, j.max_salary) END,
+        j.salary_currency, j.salary_period, $4::text, $5::salary_period
+      )
+    END::float8 AS salary,
+    CASE $2::text WHEN 'company' THEN j.company_id::text WHEN 'job_title' THEN lower(j.job_title) END AS group_key,
+    CASE $2::text WHEN 'company' THEN j.company_name WHEN 'job_title' THEN j.job_title END AS group_name
+  FROM job_applications AS j
+  JOIN stages AS s ON s.id = j.stage_id
+  WHERE
+    j.user_id = $6
+    AND j.deleted_at IS NULL
+    AND (j.date_applied >= $7::date OR $7::date IS NULL)
+    AND (j.date_applied <= $8::date OR $8::date IS NULL)
+),
+first_responses AS (
+  SELECT e.job_application_id, min(e.created_at) AS responded_at
+  FROM job_application_events AS e
+  JOIN filtered_job_applications AS f ON f.id = e.job_application_id
+  LEFT JOIN stages AS s ON s.id = CASE WHEN e.field = 'stage' THEN e.new_value::uuid END
+  WHERE (e.field = 'is_replied' AND e.new_value = 'true') OR s.is_terminal OR s.outcome <> 'NEUTRAL'
+  GROUP BY e.job_application_id
+)
+SELECT
+  (GROUPING(f.group_key) = 1)::bool AS is_overall,
+  coalesce(f.group_key, '')::text AS group_key,
+  coalesce(min(f.group_name), '')::text AS group_name,
+  count(*) AS total,
+  count(*) FILTER (WHERE f.is_replied) AS replied,
+  count(*) FILTER (WHERE f.stage_outcome = 'POSITIVE') AS accepted,
+  count(*) FILTER (WHERE f.stage_outcome = 'NEGATIVE') AS rejected,
+  coalesce((count(*) FILTER (WHERE f.is_replied))::float8 / nullif(count(*), 0), 0)::float8 AS reply_rate,
+  coalesce((count(*) FILTER (WHERE f.stage_outcome = 'POSITIVE'))::float8 / nullif(count(*), 0), 0)::float8 AS acceptance_rate,
+  -- NOTE: Zero whenever there's nothing to aggregate, which the counts tell apart
+  count(r.responded_at) AS responded,
+  -- NOTE: Counted from the start of the day applied, in the time zone of the user
+  coalesce(percentile_cont(0.5) WITHIN GROUP (ORDER BY greatest(extract(epoch FROM r.responded_at - (f.date_applied::timestamp AT TIME ZONE $1::text)), 0) / 86400), 0)::float8 AS median_days_to_response,
+  count(f.salary) AS salary_count,
+  coalesce(min(f.salary), 0)::float8 AS min_salary,
+  coalesce(percentile_cont(0.25) WITHIN GROUP (ORDER BY f.salary), 0)::float8 AS salary_p25,
+  coalesce(percentile_cont(0.5) WITHIN GROUP (ORDER BY f.salary), 0)::float8 AS median_salary,
+  coalesce(percentile_cont(0.75) WITHIN GROUP (ORDER BY f.salary), 0)::float8 AS salary_p75,
+  coalesce(max(f.salary), 0)::float8 AS max_salary
+FROM filtered_job_applications AS f
+LEFT JOIN first_responses AS r ON r.job_application_id = f.id
+GROUP BY GROUPING SETS ((), (f.group_key))
+HAVING GROUPING(f.group_key) = 1 OR $2::text <> ''
+ORDER BY is_overall DESC, total DESC, group_name
+`
+
+type GetJobApplicationStatsParams struct {
+	Timezone       string         `json:"timezone"`
+	GroupBy        string         `json:"groupBy"`
+	SalaryType     NullSalaryType `json:"salaryType"`
+	SalaryCurrency string         `json:"salaryCurrency"`
+	SalaryPeriod   SalaryPeriod   `json:"salaryPeriod"`
+	UserID         pgtype.UUID    `json:"userId"`
+	From           pgtype.Date    `json:"from"`
+	To             pgtype.Date    `json:"to"`
+}
+
+type GetJobApplicationStatsRow struct {
+	IsOverall            bool    `json:"isOverall"`
+	GroupKey             string  `json:"groupKey"`
+	GroupName            string  `json:"groupName"`
+	Total                int64   `json:"total"`
+	Replied              int64   `json:"replied"`
+	Accepted             int64   `json:"accepted"`
+	Rejected             int64   `json:"rejected"`
+	ReplyRate            float64 `json:"replyRate"`
+	AcceptanceRate       float64 `json:"acceptanceRate"`
+	Responded            int64   `json:"responded"`
+	MedianDaysToResponse float64 `json:"medianDaysToResponse"`
+	SalaryCount          int64   `json:"salaryCount"`
+	MinSalary            float64 `json:"minSalary"`
+	SalaryP25            float64 `json:"salaryP25"`
+	MedianSalary         float64 `json:"medianSalary"`
+	SalaryP75            float64 `json:"salaryP75"`
+	MaxSalary            float64 `json:"maxSalary"`
+}
+
+// NOTE: The first time an application got a reply, or moved to a stage that is terminal or has an outcome
+// NOTE: Without grouping every group key is NULL, which would just repeat the overall row
+func (q *Queries) GetJobApplicationStats(ctx context.Context, arg GetJobApplicationStatsParams) ([]GetJobApplicationStatsRow, error) {
+	rows, err := q.db.Query(ctx, getJobApplicationStats,
+		arg.Timezone,
+		arg.GroupBy,
+		arg.SalaryType,
+		arg.SalaryCurrency,
+		arg.SalaryPeriod,
+		arg.UserID,
+		arg.From,
+		arg.To,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetJobApplicationStatsRow
+	for rows.Next() {
+		var i GetJobApplicationStatsRow
+		if err := rows.Scan(
+			&i.IsOverall,
+			&i.GroupKey,
+			&i.GroupName,
+			&i.Total,
+			&i.Replied,
+			&i.Accepted,
+			&i.Rejected,
+			&i.ReplyRate,
+			&i.AcceptanceRate,
+			&i.Responded,
+			&i.MedianDaysToResponse,
+			&i.SalaryCount,
+			&i.MinSalary,
+			&i.SalaryP25,
+			&i.MedianSalary,
+			&i.SalaryP75,
+			&i.MaxSalary,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJobApplicationTags = `-- name: GetJobApplicationTags :many
+SELECT jt.job_application_id, t.id, t.name, t.color
+FROM job_application_tags AS jt
+JOIN tags AS t ON t.id = jt.tag_id
+WHERE jt.job_application_id = ANY($1::uuid[]) AND t.user_id = $2
+ORDER BY t.name
+`
+
+type GetJobApplicationTagsParams struct {
+	JobApplicationIds []pgtype.UUID `json:"jobApplicationIds"`
+	UserID            pgtype.UUID   `json:"userId"`
+}
+
+type GetJobApplicationTagsRow struct {
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	ID               pgtype.UUID `json:"id"`
+	Name             string      `json:"name"`
+	Color            string      `json:"color"`
+}
+
+func (q *Queries) GetJobApplicationTags(ctx context.Context, arg GetJobApplicationTagsParams) ([]GetJobApplicationTagsRow, error) {
+	rows, err := q.db.Query(ctx, getJobApplicationTags, arg.JobApplicationIds, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetJobApplicationTagsRow
+	for rows.Next() {
+		var i GetJobApplicationTagsRow
+		if err := rows.Scan(
+			&i.JobApplicationID,
+			&i.ID,
+			&i.Name,
+			&i.Color,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJobApplicationWeeklyStats = `-- name: GetJobApplicationWeeklyStats :many
+WITH filtered_job_applications AS (
+  SELECT
+    week_start_date(j.date_applied, $2::week_start) AS week,
+    CASE $1::text WHEN 'company' THEN j.company_id::text WHEN 'job_title' THEN lower(j.job_title) END AS group_key
+  FROM job_applications AS j
+  WHERE
+    j.user_id = $3
+    AND j.deleted_at IS NULL
+    AND (j.date_applied >= $4::date OR $4::date IS NULL)
+    AND (j.date_applied <= $5::date OR $5::date IS NULL)
+)
+SELECT
+  (GROUPING(group_key) = 1)::bool AS is_overall,
+  coalesce(group_key, '')::text AS group_key,
+  week,
+  count(*) AS count
+FROM filtered_job_applications
+GROUP BY GROUPING SETS ((week), (group_key, week))
+HAVING GROUPING(group_key) = 1 OR $1::text <> ''
+ORDER BY week
+`
+
+type GetJobApplicationWeeklyStatsParams struct {
+	GroupBy   string      `json:"groupBy"`
+	WeekStart WeekStart   `json:"weekStart"`
+	UserID    pgtype.UUID `json:"userId"`
+	From      pgtype.Date `json:"from"`
+	To        pgtype.Date `json:"to"`
+}
+
+type GetJobApplicationWeeklyStatsRow struct {
+	IsOverall bool        `json:"isOverall"`
+	GroupKey  string      `json:"groupKey"`
+	Week      pgtype.Date `json:"week"`
+	Count     int64       `json:"count"`
+}
+
+func (q *Queries) GetJobApplicationWeeklyStats(ctx context.Context, arg GetJobApplicationWeeklyStatsParams) ([]GetJobApplicationWeeklyStatsRow, error) {
+	rows, err := q.db.Query(ctx, getJobApplicationWeeklyStats,
+		arg.GroupBy,
+		arg.WeekStart,
+		arg.UserID,
+		arg.From,
+		arg.To,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetJobApplicationWeeklyStatsRow
+	for rows.Next() {
+		var i GetJobApplicationWeeklyStatsRow
+		if err := rows.Scan(
+			&i.IsOverall,
+			&i.GroupKey,
+			&i.Week,
+			&i.Count,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPasswordResetToken = `-- name: GetPasswordResetToken :one
+SELECT token, expires_at, user_id FROM password_reset_tokens WHERE token = $1
+`
+
+type GetPasswordResetTokenRow struct {
+	Token     string             `json:"token"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+	UserID    pgtype.UUID        `json:"userId"`
+}
+
+func (q *Queries) GetPasswordResetToken(ctx context.Context, token string) (GetPasswordResetTokenRow, error) {
+	row := q.db.QueryRow(ctx, getPasswordResetToken, token)
+	var i GetPasswordResetTokenRow
+	err := row.Scan(&i.Token, &i.ExpiresAt, &i.UserID)
+	return i, err
+}
+
+const getReminders = `-- name: GetReminders :many
+SELECT r.id, r.job_application_id, j.company_name, j.job_title, r.remind_at, r.note, r.unless_replied, r.is_suggested, r.status, r.delivered_at
+FROM reminders AS r
+JOIN job_applications AS j ON j.id = r.job_application_id
+WHERE
+  j.user_id = $1
+  AND j.deleted_at IS NULL
+  AND (r.status = $2::reminder_status OR $2::reminder_status IS NULL)
+  AND (r.job_application_id = $3::uuid OR $3::uuid IS NULL)
+ORDER BY r.remind_at, r.id
+`
+
+type GetRemindersParams struct {
+	UserID           pgtype.UUID        `json:"userId"`
+	Status           NullReminderStatus `json:"status"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+}
+
+type GetRemindersRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	CompanyName      string             `json:"companyName"`
+	JobTitle         string             `json:"jobTitle"`
+	RemindAt         pgtype.Timestamptz `json:"remindAt"`
+	Note             pgtype.Text        `json:"note"`
+	UnlessReplied    bool               `json:"unlessReplied"`
+	IsSuggested      bool               `json:"isSuggested"`
+	Status           ReminderStatus     `json:"status"`
+	DeliveredAt      pgtype.Timestamptz `json:"deliveredAt"`
+}
+
+func (q *Queries) GetReminders(ctx context.Context, arg GetRemindersParams) ([]GetRemindersRow, error) {
+	rows, err := q.db.Query(ctx, getReminders, arg.UserID, arg.Status, arg.JobApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRemindersRow
+	for rows.Next() {
+		var i GetRemindersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobApplicationID,
+			&i.CompanyName,
+			&i.JobTitle,
+			&i.RemindAt,
+			&i.Note,
+			&i.UnlessReplied,
+			&i.IsSuggested,
+			&i.Status,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStage = `-- name: GetStage :one
+SELECT id, name, position, color, is_terminal, outcome FROM stages WHERE id = $1 AND user_id = $2
+`
+
+type GetStageParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type GetStageRow struct {
+	ID         pgtype.UUID  `json:"id"`
+	Name       string       `json:"name"`
+	Position   int32        `json:"position"`
+	Color      string       `json:"color"`
+	IsTerminal bool         `json:"isTerminal"`
+	Outcome    StageOutcome `json:"outcome"`
+}
+
+func (q *Queries) GetStage(ctx context.Context, arg GetStageParams) (GetStageRow, error) {
+	row := q.db.QueryRow(ctx, getStage, arg.ID, arg.UserID)
+	var i GetStageRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Position,
+		&i.Color,
+		&i.IsTerminal,
+		&i.Outcome,
+	)
+	return i, err
+}
+
+const getStages = `-- name: GetStages :many
+SELECT id, name, position, color, is_terminal, outcome FROM stages WHERE user_id = $1 ORDER BY position, created_at
+`
+
+type GetStagesRow struct {
+	ID         pgtype.UUID  `json:"id"`
+	Name       string       `json:"name"`
+	Position   int32        `json:"position"`
+	Color      string       `json:"color"`
+	IsTerminal bool         `json:"isTerminal"`
+	Outcome    StageOutcome `json:"outcome"`
+}
+
+func (q *Queries) GetStages(ctx context.Context, userID pgtype.UUID) ([]GetStagesRow, error) {
+	rows, err := q.db.Query(ctx, getStages, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStagesRow
+	for rows.Next() {
+		var i GetStagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Position,
+			&i.Color,
+			&i.IsTerminal,
+			&i.Outcome,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTOTP = `-- name: GetTOTP :one
+SELECT totp_secret, is_totp_enabled FROM users WHERE id = $1
+`
+
+type GetTOTPRow struct {
+	TotpSecret    pgtype.Text `json:"totpSecret"`
+	IsTotpEnabled bool        `json:"isTotpEnabled"`
+}
+
+func (q *Queries) GetTOTP(ctx context.Context, id pgtype.UUID) (GetTOTPRow, error) {
+	row := q.db.QueryRow(ctx, getTOTP, id)
+	var i GetTOTPRow
+	err := row.Scan(&i.TotpSecret, &i.IsTotpEnabled)
+	return i, err
+}
+
+const getTag = `-- name: GetTag :one
+SELECT id, name, color FROM tags WHERE id = $1 AND user_id = $2
+`
+
+type GetTagParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type GetTagRow struct {
+	ID    pgtype.UUID `json:"id"`
+	Name  string      `json:"name"`
+	Color string      `json:"color"`
+}
+
+func (q *Queries) GetTag(ctx context.Context, arg GetTagParams) (GetTagRow, error) {
+	row := q.db.QueryRow(ctx, getTag, arg.ID, arg.UserID)
+	var i GetTagRow
+	err := row.Scan(&i.ID, &i.Name, &i.Color)
+	return i, err
+}
+
+const getTags = `-- name: GetTags :many
+SELECT id, name, color FROM tags WHERE user_id = $1 ORDER BY name
+`
+
+type GetTagsRow struct {
+	ID    pgtype.UUID `json:"id"`
+	Name  string      `json:"name"`
+	Color string      `json:"color"`
+}
+
+func (q *Queries) GetTags(ctx context.Context, userID pgtype.UUID) ([]GetTagsRow, error) {
+	rows, err := q.db.Query(ctx, getTags, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTagsRow
+	for rows.Next() {
+		var i GetTagsRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Color); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrashedJobApplications = `-- name: GetTrashedJobApplications :many
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes, j.deleted_at
+FROM job_applications AS j
+JOIN stages AS s ON s.id = j.stage_id
+WHERE j.user_id = $1 AND j.deleted_at IS NOT NULL
+ORDER BY j.deleted_at DESC, j.id
+`
+
+type GetTrashedJobApplicationsRow struct {
+	ID              pgtype.UUID        `json:"id"`
+	CompanyID       pgtype.UUID        `json:"companyId"`
+	CompanyName     string             `json:"companyName"`
+	JobTitle        string             `json:"jobTitle"`
+	DateApplied     pgtype.Date        `json:"dateApplied"`
+	StageID         pgtype.UUID        `json:"stageId"`
+	StageName       string             `json:"stageName"`
+	StageColor      string             `json:"stageColor"`
+	StageIsTerminal bool               `json:"stageIsTerminal"`
+	StageOutcome    StageOutcome       `json:"stageOutcome"`
+	IsReplied       bool               `json:"isReplied"`
+	MinSalary       pgtype.Float8      `json:"minSalary"`
+	MaxSalary       pgtype.Float8      `json:"maxSalary"`
+	SalaryCurrency  string             `json:"salaryCurrency"`
+	SalaryPeriod    SalaryPeriod       `json:"salaryPeriod"`
+	SalaryType      SalaryType         `json:"salaryType"`
+	ContractType    NullContractType   `json:"contractType"`
+	JobPostingUrl   pgtype.Text        `json:"jobPostingUrl"`
+	Notes           pgtype.Text        `json:"notes"`
+	DeletedAt       pgtype.Timestamptz `json:"deletedAt"`
+}
+
+func (q *Queries) GetTrashedJobApplications(ctx context.Context, userID pgtype.UUID) ([]GetTrashedJobApplicationsRow, error) {
+	rows, err := q.db.Query(ctx, getTrashedJobApplications, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTrashedJobApplicationsRow
+	for rows.Next() {
+		var i GetTrashedJobApplicationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CompanyID,
+			&i.CompanyName,
+			&i.JobTitle,
+			&i.DateApplied,
+			&i.StageID,
+			&i.StageName,
+			&i.StageColor,
+			&i.StageIsTerminal,
+			&i.StageOutcome,
+			&i.IsReplied,
+			&i.MinSalary,
+			&i.MaxSalary,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.SalaryType,
+			&i.ContractType,
+			&i.JobPostingUrl,
+			&i.Notes,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUpcomingInterviews = `-- name: GetUpcomingInterviews :many
+SELECT
+  i.id, i.job_application_id, j.company_name, j.job_title,
+  i.scheduled_at, i.timezone, i.duration, i.type, i.location, i.meeting_url, i.interviewers, i.preparation_notes, i.outcome
+FROM interviews AS i
+JOIN job_applications AS j ON j.id = i.job_application_id
+WHERE
+  j.user_id = $1
+  AND j.deleted_at IS NULL
+  AND i.scheduled_at >= $2::timestamptz
+  AND (i.scheduled_at < $3::timestamptz OR $3::timestamptz IS NULL)
+ORDER BY i.scheduled_at
+`
+
+type GetUpcomingInterviewsParams struct {
+	UserID        pgtype.UUID        `json:"userId"`
+	ScheduledFrom pgtype.Timestamptz `json:"scheduledFrom"`
+	ScheduledTo   pgtype.Timestamptz `json:"scheduledTo"`
+}
+
+type GetUpcomingInterviewsRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	CompanyName      string             `json:"companyName"`
+	JobTitle         string             `json:"jobTitle"`
+	ScheduledAt      pgtype.Timestamptz `json:"scheduledAt"`
+	Timezone         string             `json:"timezone"`
+	Duration         pgtype.Int4        `json:"duration"`
+	Type             InterviewType      `json:"type"`
+	Location         pgtype.Text        `json:"location"`
+	MeetingUrl       pgtype.Text        `json:"meetingUrl"`
+	Interviewers     []string           `json:"interviewers"`
+	PreparationNotes pgtype.Text        `json:"preparationNotes"`
+	Outcome          InterviewOutcome   `json:"outcome"`
+}
+
+func (q *Queries) GetUpcomingInterviews(ctx context.Context, arg GetUpcomingInterviewsParams) ([]GetUpcomingInterviewsRow, error) {
+	rows, err := q.db.Query(ctx, getUpcomingInterviews, arg.UserID, arg.ScheduledFrom, arg.ScheduledTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUpcomingInterviewsRow
+	for rows.Next() {
+		var i GetUpcomingInterviewsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobApplicationID,
+			&i.CompanyName,
+			&i.JobTitle,
+			&i.ScheduledAt,
+			&i.Timezone,
+			&i.Duration,
+			&i.Type,
+			&i.Location,
+			&i.MeetingUrl,
+			&i.Interviewers,
+			&i.PreparationNotes,
+			&i.Outcome,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT u.id, u.first_name, u.last_name, u.email, u.is_email_verified, v.token as verification_token
+FROM users AS u
+JOIN verification_tokens as v ON u.id = v.user_id
+WHERE u.email = $1
+`
+
+type GetUserByEmailRow struct {
+	ID                pgtype.UUID `json:"id"`
+	FirstName         string      `json:"firstName"`
+	LastName          string      `json:"lastName"`
+	Email             string      `json:"email"`
+	IsEmailVerified   pgtype.Bool `json:"isEmailVerified"`
+	VerificationToken string      `json:"verificationToken"`
+}
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i GetUserByEmailRow
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.IsEmailVerified,
+		&i.VerificationToken,
+	)
+	return i, err
+}
+
+const getUserById = `-- name: GetUserById :one
+SELECT id, first_name, last_name, email, is_email_verified FROM users WHERE id = $1
+`
+
+type GetUserByIdRow struct {
+	ID              pgtype.UUID `json:"id"`
+	FirstName       string      `json:"firstName"`
+	LastName        string      `json:"lastName"`
+	Email           string      `json:"email"`
+	IsEmailVerified pgtype.Bool `json:"isEmailVerified"`
+}
+
+func (q *Queries) GetUserById(ctx context.Context, id pgtype.UUID) (GetUserByIdRow, error) {
+	row := q.db.QueryRow(ctx, getUserById, id)
+	var i GetUserByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.IsEmailVerified,
+	)
+	return i, err
+}
+
+const getUserOnSignIn = `-- name: GetUserOnSignIn :one
+SELECT id, first_name, last_name, email, password, is_email_verified, is_totp_enabled FROM users WHERE email = $1
+`
+
+type GetUserOnSignInRow struct {
+	ID              pgtype.UUID `json:"id"`
+	FirstName       string      `json:"firstName"`
+	LastName        string      `json:"lastName"`
+	Email           string      `json:"email"`
+	Password        string      `json:"password"`
+	IsEmailVerified pgtype.Bool `json:"isEmailVerified"`
+	IsTotpEnabled   bool        `json:"isTotpEnabled"`
+}
+
+func (q *Queries) GetUserOnSignIn(ctx context.Context, email string) (GetUserOnSignInRow, error) {
+	row := q.db.QueryRow(ctx, getUserOnSignIn, email)
+	var i GetUserOnSignInRow
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.Password,
+		&i.IsEmailVerified,
+		&i.IsTotpEnabled,
+	)
+	return i, err
+}
+
+const getUserPreferences = `-- name: GetUserPreferences :one
+SELECT timezone, locale, week_start, currency FROM users WHERE id = $1
+`
+
+type GetUserPreferencesRow struct {
+	Timezone  string    `json:"timezone"`
+	Locale    string    `json:"locale"`
+	WeekStart WeekStart `json:"weekStart"`
+	Currency  string    `json:"currency"`
+}
+
+func (q *Queries) GetUserPreferences(ctx context.Context, id pgtype.UUID) (GetUserPreferencesRow, error) {
+	row := q.db.QueryRow(ctx, getUserPreferences, id)
+	var i GetUserPreferencesRow
+	err := row.Scan(
+		&i.Timezone,
+		&i.Locale,
+		&i.WeekStart,
+		&i.Currency,
+	)
+	return i, err
+}
+
+const getVerificationToken = `-- name: GetVerificationToken :one
+SELECT token, expires_at FROM verification_tokens WHERE user_id = $1
+`
+
+type GetVerificationTokenRow struct {
+	Token     string             `json:"token"`
+	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+}
+
+func (q *Queries) GetVerificationToken(ctx context.Context, userID pgtype.UUID) (GetVerificationTokenRow, error) {
+	row := q.db.QueryRow(ctx, getVerificationToken, userID)
+	var i GetVerificationTokenRow
+	err := row.Scan(&i.Token, &i.ExpiresAt)
+	return i, err
+}
+
+const getView = `-- name: GetView :one
+SELECT id, name, query, is_default FROM views WHERE id = $1 AND user_id = $2
+`
+
+type GetViewParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type GetViewRow struct {
+	ID        pgtype.UUID `json:"id"`
+	Name      string      `json:"name"`
+	Query     string      `json:"query"`
+	IsDefault bool        `json:"isDefault"`
+}
+
+func (q *Queries) GetView(ctx context.Context, arg GetViewParams) (GetViewRow, error) {
+	row := q.db.QueryRow(ctx, getView, arg.ID, arg.UserID)
+	var i GetViewRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Query,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const getViews = `-- name: GetViews :many
+SELECT id, name, query, is_default FROM views WHERE user_id = $1 ORDER BY is_default DESC, name
+`
+
+type GetViewsRow struct {
+	ID        pgtype.UUID `json:"id"`
+	Name      string      `json:"name"`
+	Query     string      `json:"query"`
+	IsDefault bool        `json:"isDefault"`
+}
+
+func (q *Queries) GetViews(ctx context.Context, userID pgtype.UUID) ([]GetViewsRow, error) {
+	rows, err := q.db.Query(ctx, getViews, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetViewsRow
+	for rows.Next() {
+		var i GetViewsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Query,
+			&i.IsDefault,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const linkJobApplicationContact = `-- name: LinkJobApplicationContact :execrows
+INSERT INTO job_application_contacts (job_application_id, contact_id)
+SELECT j.id, c.id
+FROM job_applications AS j, contacts AS c
+WHERE j.id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL AND c.id = $3 AND c.user_id = $2
+ON CONFLICT (job_application_id, contact_id) DO NOTHING
+`
+
+type LinkJobApplicationContactParams struct {
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	UserID           pgtype.UUID `json:"userId"`
+	ContactID        pgtype.UUID `json:"contactId"`
+}
+
+func (q *Queries) LinkJobApplicationContact(ctx context.Context, arg LinkJobApplicationContactParams) (int64, error) {
+	result, err := q.db.Exec(ctx, linkJobApplicationContact, arg.JobApplicationID, arg.UserID, arg.ContactID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const lockJobApplication = `-- name: LockJobApplication :one
+SELECT version FROM job_applications WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL FOR UPDATE
+`
+
+type LockJobApplicationParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+func (q *Queries) LockJobApplication(ctx context.Context, arg LockJobApplicationParams) (int32, error) {
+	row := q.db.QueryRow(ctx, lockJobApplication, arg.ID, arg.UserID)
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
+
+const lockUser = `-- name: LockUser :exec
+SELECT id FROM users WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) LockUser(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, lockUser, id)
+	return err
+}
+
+const markEmailFailed = `-- name: MarkEmailFailed :exec
+UPDATE email_outbox SET status = $2, attempts = attempts + 1, last_error = $3, next_attempt_at = $4 WHERE id = $1
+`
+
+type MarkEmailFailedParams struct {
 	ID            pgtype.UUID        `json:"id"`
-	CompanyName   string             `json:"companyName"`
-	JobTitle      string             `json:"jobTitle"`
-	DateApplied   pgtype.Timestamptz `json:"dateApplied"`
-	Status        Status             `json:"status"`
-	IsReplied     bool               `json:"isReplied"`
-	MinSalary     pgtype.Float8      `json:"minSalary"`
-	MaxSalary     pgtype.Float8      `json:"maxSalary"`
-	JobPostingUrl pgtype.Text        `json:"jobPostingUrl"`
-	Notes         pgtype.Text        `json:"notes"`
+	Status        EmailStatus        `json:"status"`
+	LastError     pgtype.Text        `json:"lastError"`
+	NextAttemptAt pgtype.Timestamptz `json:"nextAttemptAt"`
+}
+
+func (q *Queries) MarkEmailFailed(ctx context.Context, arg MarkEmailFailedParams) error {
+	_, err := q.db.Exec(ctx, markEmailFailed,
+		arg.ID,
+		arg.Status,
+		arg.LastError,
+		arg.NextAttemptAt,
+	)
+	return err
+}
+
+const markEmailSent = `-- name: MarkEmailSent :exec
+UPDATE email_outbox SET status = 'SENT', attempts = attempts + 1, last_error = NULL, sent_at = NOW() WHERE id = $1
+`
+
+func (q *Queries) MarkEmailSent(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markEmailSent, id)
+	return err
+}
+
+const markReminderDelivered = `-- name: MarkReminderDelivered :exec
+UPDATE reminders SET status = 'DELIVERED', delivered_at = NOW() WHERE id = $1
+`
+
+func (q *Queries) MarkReminderDelivered(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markReminderDelivered, id)
+	return err
+}
+
+const markReminderDismissed = `-- name: MarkReminderDismissed :exec
+UPDATE reminders SET status = 'DISMISSED' WHERE id = $1
+`
+
+func (q *Queries) MarkReminderDismissed(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markReminderDismissed, id)
+	return err
+}
+
+const mergeCompanies = `-- name: MergeCompanies :one
+WITH target AS (
+  SELECT id, name FROM companies WHERE id = $1::uuid AND user_id = $2::uuid
+),
+sources AS (
+  SELECT c.id, c.website, c.industry, c.size, c.location, c.notes
+  FROM companies AS c, target AS t
+  WHERE c.user_id = $2::uuid AND c.id = ANY($3::uuid[]) AND c.id <> t.id
+),
+moved_job_applications AS (
+  UPDATE job_applications AS j
+  SET company_id = t.id, company_name = t.name
+  FROM target AS t
+  WHERE j.user_id = $2::uuid AND j.company_id IN (SELECT id FROM sources)
+  RETURNING j.id
+),
+merged_company AS (
+  UPDATE companies AS c
+  SET
+    website = coalesce(c.website, (SELECT website FROM sources WHERE website IS NOT NULL LIMIT 1)),
+    industry = coalesce(c.industry, (SELECT industry FROM sources WHERE industry IS NOT NULL LIMIT 1)),
+    size = coalesce(c.size, (SELECT size FROM sources WHERE size IS NOT NULL LIMIT 1)),
+    location = coalesce(c.location, (SELECT location FROM sources WHERE location IS NOT NULL LIMIT 1)),
+    notes = coalesce(c.notes, (SELECT notes FROM sources WHERE notes IS NOT NULL LIMIT 1))
+  FROM target AS t
+  WHERE c.id = t.id
+  RETURNING c.id
+),
+deleted_companies AS (
+  DELETE FROM companies WHERE id IN (SELECT id FROM sources)
+  RETURNING id
+)
+SELECT
+  (SELECT count(*) FROM merged_company) AS merged,
+  (SELECT count(*) FROM deleted_companies) AS deleted,
+  (SELECT count(*) FROM moved_job_applications) AS moved
+`
+
+type MergeCompaniesParams struct {
+	ID        pgtype.UUID   `json:"id"`
+	UserID    pgtype.UUID   `json:"userId"`
+	SourceIds []pgtype.UUID `json:"sourceIds"`
+}
+
+type MergeCompaniesRow struct {
+	Merged  int64 `json:"merged"`
+	Deleted int64 `json:"deleted"`
+	Moved   int64 `json:"moved"`
+}
+
+// NOTE: Trashed applications are moved too, so that they still point at an existing company once restored
+func (q *Queries) MergeCompanies(ctx context.Context, arg MergeCompaniesParams) (MergeCompaniesRow, error) {
+	row := q.db.QueryRow(ctx, mergeCompanies, arg.ID, arg.UserID, arg.SourceIds)
+	var i MergeCompaniesRow
+	err := row.Scan(&i.Merged, &i.Deleted, &i.Moved)
+	return i, err
+}
+
+const purge = `-- name: Purge :exec
+TRUNCATE TABLE users, verification_tokens, password_reset_tokens, sessions, recovery_codes, mfa_challenges, email_outbox, stages, job_applications, job_application_events, interviews, contacts, job_application_contacts, companies, tags, job_application_tags, views, reminders, attachments, idempotency_keys
+`
+
+func (q *Queries) Purge(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, purge)
+	return err
+}
+
+const purgeJobApplications = `-- name: PurgeJobApplications :many
+WITH purged_job_applications AS (
+  DELETE FROM job_applications WHERE deleted_at <= $1::timestamptz
+  RETURNING id
+)
+SELECT a.storage_key
+FROM attachments AS a
+JOIN purged_job_applications AS p ON p.id = a.job_application_id
+`
+
+// NOTE: This statement still sees the attachments removed by the cascade, so their storage keys can be returned
+func (q *Queries) PurgeJobApplications(ctx context.Context, deletedBefore pgtype.Timestamptz) ([]string, error) {
+	rows, err := q.db.Query(ctx, purgeJobApplications, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var storage_key string
+		if err := rows.Scan(&storage_key); err != nil {
+			return nil, err
+		}
+		items = append(items, storage_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resetMFAAttempts = `-- name: ResetMFAAttempts :exec
+UPDATE users SET mfa_attempts = 0, mfa_attempts_since = NULL WHERE id = $1
+`
+
+func (q *Queries) ResetMFAAttempts(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, resetMFAAttempts, id)
+	return err
+}
+
+const restoreJobApplication = `-- name: RestoreJobApplication :one
+WITH restored_job_application AS (
+  UPDATE job_applications SET deleted_at = NULL
+  WHERE id = $1::uuid AND user_id = $2::uuid AND deleted_at IS NOT NULL
+  RETURNING id, company_id, company_name, job_title, date_applied, stage_id, is_replied, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, job_posting_url, notes
+)
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes
+FROM restored_job_application AS j
+JOIN stages AS s ON s.id = j.stage_id
+`
+
+type RestoreJobApplicationParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type RestoreJobApplicationRow struct {
+	ID              pgtype.UUID      `json:"id"`
+	CompanyID       pgtype.UUID      `json:"companyId"`
+	CompanyName     string           `json:"companyName"`
+	JobTitle        string           `json:"jobTitle"`
+	DateApplied     pgtype.Date      `json:"dateApplied"`
+	StageID         pgtype.UUID      `json:"stageId"`
+	StageName       string           `json:"stageName"`
+	StageColor      string           `json:"stageColor"`
+	StageIsTerminal bool             `json:"stageIsTerminal"`
+	StageOutcome    StageOutcome     `json:"stageOutcome"`
+	IsReplied       bool             `json:"isReplied"`
+	MinSalary       pgtype.Float8    `json:"minSalary"`
+	MaxSalary       pgtype.Float8    `json:"maxSalary"`
+	SalaryCurrency  string           `json:"salaryCurrency"`
+	SalaryPeriod    SalaryPeriod     `json:"salaryPeriod"`
+	SalaryType      SalaryType       `json:"salaryType"`
+	ContractType    NullContractType `json:"contractType"`
+	JobPostingUrl   pgtype.Text      `json:"jobPostingUrl"`
+	Notes           pgtype.Text      `json:"notes"`
+}
+
+func (q *Queries) RestoreJobApplication(ctx context.Context, arg RestoreJobApplicationParams) (RestoreJobApplicationRow, error) {
+	row := q.db.QueryRow(ctx, restoreJobApplication, arg.ID, arg.UserID)
+	var i RestoreJobApplicationRow
+	err := row.Scan(
+		&i.ID,
+		&i.CompanyID,
+		&i.CompanyName,
+		&i.JobTitle,
+		&i.DateApplied,
+		&i.StageID,
+		&i.StageName,
+		&i.StageColor,
+		&i.StageIsTerminal,
+		&i.StageOutcome,
+		&i.IsReplied,
+		&i.MinSalary,
+		&i.MaxSalary,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
+		&i.SalaryType,
+		&i.ContractType,
+		&i.JobPostingUrl,
+		&i.Notes,
+	)
+	return i, err
+}
+
+const revokeSession = `-- name: RevokeSession :exec
+UPDATE sessions SET revoked_at = NOW() WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+`
+
+type RevokeSessionParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+func (q *Queries) RevokeSession(ctx context.Context, arg RevokeSessionParams) error {
+	_, err := q.db.Exec(ctx, revokeSession, arg.ID, arg.UserID)
+	return err
+}
+
+const revokeSessions = `-- name: RevokeSessions :exec
+UPDATE sessions SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeSessions(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, revokeSessions, userID)
+	return err
+}
+
+const rotateRefreshToken = `-- name: RotateRefreshToken :one
+UPDATE sessions AS s SET
+  refresh_token = encode(gen_random_bytes(32), 'hex'),
+  expires_at = NOW() + INTERVAL '30 days'
+FROM users AS u
+WHERE s.refresh_token = $1 AND s.revoked_at IS NULL AND s.expires_at > NOW() AND u.id = s.user_id
+RETURNING s.id, s.user_id, u.email, s.refresh_token
+`
+
+type RotateRefreshTokenRow struct {
+	ID           pgtype.UUID `json:"id"`
+	UserID       pgtype.UUID `json:"userId"`
+	Email        string      `json:"email"`
+	RefreshToken string      `json:"refreshToken"`
+}
+
+func (q *Queries) RotateRefreshToken(ctx context.Context, refreshToken string) (RotateRefreshTokenRow, error) {
+	row := q.db.QueryRow(ctx, rotateRefreshToken, refreshToken)
+	var i RotateRefreshTokenRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Email,
+		&i.RefreshToken,
+	)
+	return i, err
+}
+
+const saveIdempotencyKeyResponse = `-- name: SaveIdempotencyKeyResponse :exec
+UPDATE idempotency_keys
+SET status = $1::integer, response = $2::bytea
+WHERE user_id IS NOT DISTINCT FROM $3::uuid AND key = $4::text
+`
+
+type SaveIdempotencyKeyResponseParams struct {
+	Status   int32       `json:"status"`
+	Response []byte      `json:"response"`
+	UserID   pgtype.UUID `json:"userId"`
+	Key      string      `json:"key"`
+}
+
+func (q *Queries) SaveIdempotencyKeyResponse(ctx context.Context, arg SaveIdempotencyKeyResponseParams) error {
+	_, err := q.db.Exec(ctx, saveIdempotencyKeyResponse,
+		arg.Status,
+		arg.Response,
+		arg.UserID,
+		arg.Key,
+	)
+	return err
+}
+
+const snoozeReminder = `-- name: SnoozeReminder :one
+UPDATE reminders AS r
+SET remind_at = $1::timestamptz, status = 'PENDING', delivered_at = NULL
+FROM job_applications AS j
+WHERE r.id = $2 AND j.id = r.job_application_id AND j.user_id = $3 AND j.deleted_at IS NULL
+RETURNING r.id, r.job_application_id, r.remind_at, r.note, r.unless_replied, r.is_suggested, r.status, r.delivered_at
+`
+
+type SnoozeReminderParams struct {
+	RemindAt pgtype.Timestamptz `json:"remindAt"`
+	ID       pgtype.UUID        `json:"id"`
+	UserID   pgtype.UUID        `json:"userId"`
 }
 
-func (q *Queries) GetJobApplication(ctx context.Context, arg GetJobApplicationParams) (GetJobApplicationRow, error) {
-	row := q.db.QueryRow(ctx, getJobApplication, arg.ID, arg.UserID)
-	var i GetJobApplicationRow
+type SnoozeReminderRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	JobApplicationID pgtype.UUID        `json:"jobApplicationId"`
+	RemindAt         pgtype.Timestamptz `json:"remindAt"`
+	Note             pgtype.Text        `json:"note"`
+	UnlessReplied    bool               `json:"unlessReplied"`
+	IsSuggested      bool               `json:"isSuggested"`
+	Status           ReminderStatus     `json:"status"`
+	DeliveredAt      pgtype.Timestamptz `json:"deliveredAt"`
+}
+
+func (q *Queries) SnoozeReminder(ctx context.Context, arg SnoozeReminderParams) (SnoozeReminderRow, error) {
+	row := q.db.QueryRow(ctx, snoozeReminder, arg.RemindAt, arg.ID, arg.UserID)
+	var i SnoozeReminderRow
 	err := row.Scan(
 		&i.ID,
-		&i.CompanyName,
-		&i.JobTitle,
-		&i.DateApplied,
+		&i.JobApplicationID,
+		&i.RemindAt,
+		&i.Note,
+		&i.UnlessReplied,
+		&i.IsSuggested,
 		&i.Status,
-		&i.IsReplied,
-		&i.MinSalary,
-		&i.MaxSalary,
-		&i.JobPostingUrl,
-		&i.Notes,
+		&i.DeliveredAt,
 	)
 	return i, err
 }
 
-const getJobApplications = `-- name: GetJobApplications :many
-WITH user_job_applications AS (
-  SELECT id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url
-  FROM job_applications
-  WHERE user_id = $3
-), 
-filtered_job_applications AS (
-  SELECT id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, COUNT(*) OVER() AS total
-  FROM user_job_applications
-  WHERE 
-    (company_name ILIKE '%' || $4::text || '%' OR job_title ILIKE '%' || $4::text || '%' OR $4::text IS NULL)
-    AND (((date_applied AT TIME ZONE 'Europe/Warsaw')::date = (($5) AT TIME ZONE 'Europe/Warsaw')::date) OR $5 IS NULL)
-    AND (status = nullif($6, '')::status OR nullif($6, '') IS NULL)
-  ORDER BY
-    CASE WHEN $7::bool THEN company_name END ASC,
-    CASE WHEN $8::bool THEN company_name END DESC,
-    CASE WHEN $9::bool THEN job_title END ASC,
-    CASE WHEN $10::bool THEN job_title END DESC,
-    CASE WHEN $11::bool THEN date_applied END ASC,
-    CASE WHEN $12::bool THEN date_applied END DESC,
-    CASE WHEN $13::bool THEN status END ASC,
-    CASE WHEN $14::bool THEN status END DESC,
-    CASE WHEN $15::bool THEN greatest(min_salary, max_salary) END ASC,
-    CASE WHEN $16::bool THEN greatest(min_salary, max_salary) END DESC,
-    CASE WHEN $17::bool THEN is_replied END ASC,
-    CASE WHEN $18::bool THEN is_replied END DESC
-)
-SELECT id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, total
-FROM filtered_job_applications
-LIMIT $1 OFFSET $2
+const suggestFollowUpReminders = `-- name: SuggestFollowUpReminders :execrows
+INSERT INTO reminders (job_application_id, remind_at, unless_replied, is_suggested)
+SELECT j.id, NOW(), true, true
+FROM job_applications AS j
+JOIN stages AS s ON s.id = j.stage_id
+JOIN users AS u ON u.id = j.user_id
+WHERE
+  j.deleted_at IS NULL
+  AND NOT j.is_replied
+  AND NOT s.is_terminal
+  -- NOTE: Days are counted from today in the time zone of the user
+  AND j.date_applied <= (NOW() AT TIME ZONE u.timezone)::date - $1::integer
+  -- NOTE: Applications long past the threshold, e.g. imported ones, are left alone, so that turning suggestions on doesn't flood anyone's inbox
+  AND j.date_applied > (NOW() AT TIME ZONE u.timezone)::date - 2 * $1::integer
+ON CONFLICT (job_application_id) WHERE is_suggested DO NOTHING
 `
 
-type GetJobApplicationsParams struct {
-	Limit                 int32       `json:"limit"`
-	Offset                int32       `json:"offset"`
-	UserID                pgtype.UUID `json:"userId"`
-	CompanyNameOrJobTitle string      `json:"companyNameOrJobTitle"`
-	DateApplied           interface{} `json:"dateApplied"`
-	Status                interface{} `json:"status"`
-	CompanyNameAsc        bool        `json:"companyNameAsc"`
-	CompanyNameDesc       bool        `json:"companyNameDesc"`
-	JobTitleAsc           bool        `json:"jobTitleAsc"`
-	JobTitleDesc          bool        `json:"jobTitleDesc"`
-	DateAppliedAsc        bool        `json:"dateAppliedAsc"`
-	DateAppliedDesc       bool        `json:"dateAppliedDesc"`
-	StatusAsc             bool        `json:"statusAsc"`
-	StatusDesc            bool        `json:"statusDesc"`
-	SalaryAsc             bool        `json:"salaryAsc"`
-	SalaryDesc            bool        `json:"salaryDesc"`
-	IsRepliedAsc          bool        `json:"isRepliedAsc"`
-	IsRepliedDesc         bool        `json:"isRepliedDesc"`
-}
-
-type GetJobApplicationsRow struct {
-	ID            pgtype.UUID        `json:"id"`
-	CompanyName   string             `json:"companyName"`
-	JobTitle      string             `json:"jobTitle"`
-	DateApplied   pgtype.Timestamptz `json:"dateApplied"`
-	Status        Status             `json:"status"`
-	IsReplied     bool               `json:"isReplied"`
-	MinSalary     pgtype.Float8      `json:"minSalary"`
-	MaxSalary     pgtype.Float8      `json:"maxSalary"`
-	JobPostingUrl pgtype.Text        `json:"jobPostingUrl"`
-	Total         int64              `json:"total"`
-}
-
-func (q *Queries) GetJobApplications(ctx context.Context, arg GetJobApplicationsParams) ([]GetJobApplicationsRow, error) {
-	rows, err := q.db.Query(ctx, getJobApplications,
-		arg.Limit,
-		arg.Offset,
-		arg.UserID,
-		arg.CompanyNameOrJobTitle,
-		arg.DateApplied,
-		arg.Status,
-		arg.CompanyNameAsc,
-		arg.CompanyNameDesc,
-		arg.JobTitleAsc,
-		arg.JobTitleDesc,
-		arg.DateAppliedAsc,
-		arg.DateAppliedDesc,
-		arg.StatusAsc,
-		arg.StatusDesc,
-		arg.SalaryAsc,
-		arg.SalaryDesc,
-		arg.IsRepliedAsc,
-		arg.IsRepliedDesc,
-	)
+func (q *Queries) SuggestFollowUpReminders(ctx context.Context, afterDays int32) (int64, error) {
+	result, err := q.db.Exec(ctx, suggestFollowUpReminders, afterDays)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetJobApplicationsRow
-	for rows.Next() {
-		var i GetJobApplicationsRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.CompanyName,
-			&i.JobTitle,
-			&i.DateApplied,
-			&i.Status,
-			&i.IsReplied,
-			&i.MinSalary,
-			&i.MaxSalary,
-			&i.JobPostingUrl,
-			&i.Total,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+	return result.RowsAffected(), nil
+}
+
+const tagJobApplication = `-- name: TagJobApplication :execrows
+INSERT INTO job_application_tags (job_application_id, tag_id)
+SELECT j.id, t.id
+FROM job_applications AS j, tags AS t
+WHERE j.id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL AND t.id = $3 AND t.user_id = $2
+ON CONFLICT (job_application_id, tag_id) DO NOTHING
+`
+
+type TagJobApplicationParams struct {
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	UserID           pgtype.UUID `json:"userId"`
+	TagID            pgtype.UUID `json:"tagId"`
+}
+
+func (q *Queries) TagJobApplication(ctx context.Context, arg TagJobApplicationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, tagJobApplication, arg.JobApplicationID, arg.UserID, arg.TagID)
+	if err != nil {
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getPasswordResetToken = `-- name: GetPasswordResetToken :one
-SELECT token, expires_at, user_id FROM password_reset_tokens WHERE token = $1
+const unlinkJobApplicationContact = `-- name: UnlinkJobApplicationContact :one
+DELETE FROM job_application_contacts AS jc
+USING job_applications AS j
+WHERE jc.job_application_id = $1 AND jc.contact_id = $2 AND j.id = jc.job_application_id AND j.user_id = $3 AND j.deleted_at IS NULL
+RETURNING jc.contact_id
 `
 
-type GetPasswordResetTokenRow struct {
-	Token     string             `json:"token"`
-	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
-	UserID    pgtype.UUID        `json:"userId"`
+type UnlinkJobApplicationContactParams struct {
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	ContactID        pgtype.UUID `json:"contactId"`
+	UserID           pgtype.UUID `json:"userId"`
 }
 
-func (q *Queries) GetPasswordResetToken(ctx context.Context, token string) (GetPasswordResetTokenRow, error) {
-	row := q.db.QueryRow(ctx, getPasswordResetToken, token)
-	var i GetPasswordResetTokenRow
-	err := row.Scan(&i.Token, &i.ExpiresAt, &i.UserID)
-	return i, err
+func (q *Queries) UnlinkJobApplicationContact(ctx context.Context, arg UnlinkJobApplicationContactParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, unlinkJobApplicationContact, arg.JobApplicationID, arg.ContactID, arg.UserID)
+	var contact_id pgtype.UUID
+	err := row.Scan(&contact_id)
+	return contact_id, err
 }
 
-const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT u.id, u.first_name, u.last_name, u.email, u.is_email_verified, v.token as verification_token
-FROM users AS u
-JOIN verification_tokens as v ON u.id = v.user_id
-WHERE u.email = $1
+const untagJobApplication = `-- name: UntagJobApplication :one
+DELETE FROM job_application_tags AS jt
+USING job_applications AS j
+WHERE jt.job_application_id = $1 AND jt.tag_id = $2 AND j.id = jt.job_application_id AND j.user_id = $3 AND j.deleted_at IS NULL
+RETURNING jt.tag_id
 `
 
-type GetUserByEmailRow struct {
-	ID                pgtype.UUID `json:"id"`
-	FirstName         string      `json:"firstName"`
-	LastName          string      `json:"lastName"`
-	Email             string      `json:"email"`
-	IsEmailVerified   pgtype.Bool `json:"isEmailVerified"`
-	VerificationToken string      `json:"verificationToken"`
+type UntagJobApplicationParams struct {
+	JobApplicationID pgtype.UUID `json:"jobApplicationId"`
+	TagID            pgtype.UUID `json:"tagId"`
+	UserID           pgtype.UUID `json:"userId"`
 }
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
-	row := q.db.QueryRow(ctx, getUserByEmail, email)
-	var i GetUserByEmailRow
-	err := row.Scan(
-		&i.ID,
-		&i.FirstName,
-		&i.LastName,
-		&i.Email,
-		&i.IsEmailVerified,
-		&i.VerificationToken,
-	)
-	return i, err
+func (q *Queries) UntagJobApplication(ctx context.Context, arg UntagJobApplicationParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, untagJobApplication, arg.JobApplicationID, arg.TagID, arg.UserID)
+	var tag_id pgtype.UUID
+	err := row.Scan(&tag_id)
+	return tag_id, err
 }
 
-const getUserById = `-- name: GetUserById :one
-SELECT id, first_name, last_name, email, is_email_verified FROM users WHERE id = $1
+const updateCompany = `-- name: UpdateCompany :one
+UPDATE companies
+SET
+  name = coalesce(nullif($1::text, ''), name),
+  website = coalesce(nullif($2::text, ''), website),
+  industry = coalesce(nullif($3::text, ''), industry),
+  size = coalesce(nullif($4::text, ''), size),
+  location = coalesce(nullif($5::text, ''), location),
+  notes = coalesce(nullif($6::text, ''), notes)
+WHERE id = $7 AND user_id = $8
+RETURNING id, name, website, industry, size, location, notes
 `
 
-type GetUserByIdRow struct {
-	ID              pgtype.UUID `json:"id"`
-	FirstName       string      `json:"firstName"`
-	LastName        string      `json:"lastName"`
-	Email           string      `json:"email"`
-	IsEmailVerified pgtype.Bool `json:"isEmailVerified"`
+type UpdateCompanyParams struct {
+	Name     pgtype.Text `json:"name"`
+	Website  pgtype.Text `json:"website"`
+	Industry pgtype.Text `json:"industry"`
+	Size     pgtype.Text `json:"size"`
+	Location pgtype.Text `json:"location"`
+	Notes    pgtype.Text `json:"notes"`
+	ID       pgtype.UUID `json:"id"`
+	UserID   pgtype.UUID `json:"userId"`
 }
 
-func (q *Queries) GetUserById(ctx context.Context, id pgtype.UUID) (GetUserByIdRow, error) {
-	row := q.db.QueryRow(ctx, getUserById, id)
-	var i GetUserByIdRow
+type UpdateCompanyRow struct {
+	ID       pgtype.UUID `json:"id"`
+	Name     string      `json:"name"`
+	Website  pgtype.Text `json:"website"`
+	Industry pgtype.Text `json:"industry"`
+	Size     pgtype.Text `json:"size"`
+	Location pgtype.Text `json:"location"`
+	Notes    pgtype.Text `json:"notes"`
+}
+
+func (q *Queries) UpdateCompany(ctx context.Context, arg UpdateCompanyParams) (UpdateCompanyRow, error) {
+	row := q.db.QueryRow(ctx, updateCompany,
+		arg.Name,
+		arg.Website,
+		arg.Industry,
+		arg.Size,
+		arg.Location,
+		arg.Notes,
+		arg.ID,
+		arg.UserID,
+	)
+	var i UpdateCompanyRow
 	err := row.Scan(
 		&i.ID,
-		&i.FirstName,
-		&i.LastName,
-		&i.Email,
-		&i.IsEmailVerified,
+		&i.Name,
+		&i.Website,
+		&i.Industry,
+		&i.Size,
+		&i.Location,
+		&i.Notes,
 	)
 	return i, err
 }
 
-const getUserOnSignIn = `-- name: GetUserOnSignIn :one
-SELECT id, first_name, last_name, email, password, is_email_verified FROM users WHERE email = $1
+const updateContact = `-- name: UpdateContact :one
+UPDATE contacts
+SET
+  name = coalesce(nullif($1::text, ''), name),
+  email = coalesce(nullif($2::text, ''), email),
+  phone = coalesce(nullif($3::text, ''), phone),
+  linkedin_url = coalesce(nullif($4::text, ''), linkedin_url),
+  company = coalesce(nullif($5::text, ''), company),
+  role = coalesce(nullif($6::text, ''), role),
+  notes = coalesce(nullif($7::text, ''), notes)
+WHERE id = $8 AND user_id = $9
+RETURNING id, name, email, phone, linkedin_url, company, role, notes
 `
 
-type GetUserOnSignInRow struct {
-	ID              pgtype.UUID `json:"id"`
-	FirstName       string      `json:"firstName"`
-	LastName        string      `json:"lastName"`
-	Email           string      `json:"email"`
-	Password        string      `json:"password"`
-	IsEmailVerified pgtype.Bool `json:"isEmailVerified"`
+type UpdateContactParams struct {
+	Name        pgtype.Text `json:"name"`
+	Email       pgtype.Text `json:"email"`
+	Phone       pgtype.Text `json:"phone"`
+	LinkedinUrl pgtype.Text `json:"linkedinUrl"`
+	Company     pgtype.Text `json:"company"`
+	Role        pgtype.Text `json:"role"`
+	Notes       pgtype.Text `json:"notes"`
+	ID          pgtype.UUID `json:"id"`
+	UserID      pgtype.UUID `json:"userId"`
 }
 
-func (q *Queries) GetUserOnSignIn(ctx context.Context, email string) (GetUserOnSignInRow, error) {
-	row := q.db.QueryRow(ctx, getUserOnSignIn, email)
-	var i GetUserOnSignInRow
+type UpdateContactRow struct {
+	ID          pgtype.UUID `json:"id"`
+	Name        string      `json:"name"`
+	Email       pgtype.Text `json:"email"`
+	Phone       pgtype.Text `json:"phone"`
+	LinkedinUrl pgtype.Text `json:"linkedinUrl"`
+	Company     pgtype.Text `json:"company"`
+	Role        pgtype.Text `json:"role"`
+	Notes       pgtype.Text `json:"notes"`
+}
+
+func (q *Queries) UpdateContact(ctx context.Context, arg UpdateContactParams) (UpdateContactRow, error) {
+	row := q.db.QueryRow(ctx, updateContact,
+		arg.Name,
+		arg.Email,
+		arg.Phone,
+		arg.LinkedinUrl,
+		arg.Company,
+		arg.Role,
+		arg.Notes,
+		arg.ID,
+		arg.UserID,
+	)
+	var i UpdateContactRow
 	err := row.Scan(
 		&i.ID,
-		&i.FirstName,
-		&i.LastName,
+		&i.Name,
 		&i.Email,
-		&i.Password,
-		&i.IsEmailVerified,
+		&i.Phone,
+		&i.LinkedinUrl,
+		&i.Company,
+		&i.Role,
+		&i.Notes,
 	)
 	return i, err
 }
 
-const getVerificationToken = `-- name: GetVerificationToken :one
-SELECT token, expires_at FROM verification_tokens WHERE user_id = $1
+const updateInterview = `-- name: UpdateInterview :one
+UPDATE interviews AS i
+SET
+  scheduled_at = coalesce($1, i.scheduled_at),
+  timezone = coalesce(nullif($2::text, ''), i.timezone),
+  duration = coalesce($3, i.duration),
+  type = coalesce($4, i.type),
+  location = coalesce(nullif($5::text, ''), i.location),
+  meeting_url = coalesce(nullif($6::text, ''), i.meeting_url),
+  interviewers = coalesce($7, i.interviewers),
+  preparation_notes = coalesce(nullif($8::text, ''), i.preparation_notes),
+  outcome = coalesce($9, i.outcome)
+FROM job_applications AS j
+WHERE i.id = $10 AND i.job_application_id = $11 AND j.id = i.job_application_id AND j.user_id = $12 AND j.deleted_at IS NULL
+RETURNING i.id, i.scheduled_at, i.timezone, i.duration, i.type, i.location, i.meeting_url, i.interviewers, i.preparation_notes, i.outcome
 `
 
-type GetVerificationTokenRow struct {
-	Token     string             `json:"token"`
-	ExpiresAt pgtype.Timestamptz `json:"expiresAt"`
+type UpdateInterviewParams struct {
+	ScheduledAt      pgtype.Timestamptz   `json:"scheduledAt"`
+	Timezone         pgtype.Text          `json:"timezone"`
+	Duration         pgtype.Int4          `json:"duration"`
+	Type             NullInterviewType    `json:"type"`
+	Location         pgtype.Text          `json:"location"`
+	MeetingUrl       pgtype.Text          `json:"meetingUrl"`
+	Interviewers     []string             `json:"interviewers"`
+	PreparationNotes pgtype.Text          `json:"preparationNotes"`
+	Outcome          NullInterviewOutcome `json:"outcome"`
+	ID               pgtype.UUID          `json:"id"`
+	JobApplicationID pgtype.UUID          `json:"jobApplicationId"`
+	UserID           pgtype.UUID          `json:"userId"`
 }
 
-func (q *Queries) GetVerificationToken(ctx context.Context, userID pgtype.UUID) (GetVerificationTokenRow, error) {
-	row := q.db.QueryRow(ctx, getVerificationToken, userID)
-	var i GetVerificationTokenRow
-	err := row.Scan(&i.Token, &i.ExpiresAt)
-	return i, err
+type UpdateInterviewRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	ScheduledAt      pgtype.Timestamptz `json:"scheduledAt"`
+	Timezone         string             `json:"timezone"`
+	Duration         pgtype.Int4        `json:"duration"`
+	Type             InterviewType      `json:"type"`
+	Location         pgtype.Text        `json:"location"`
+	MeetingUrl       pgtype.Text        `json:"meetingUrl"`
+	Interviewers     []string           `json:"interviewers"`
+	PreparationNotes pgtype.Text        `json:"preparationNotes"`
+	Outcome          InterviewOutcome   `json:"outcome"`
 }
 
-const purge = `-- name: Purge :exec
-TRUNCATE TABLE users, verification_tokens, password_reset_tokens, job_applications
-`
-
-func (q *Queries) Purge(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, purge)
-	return err
+func (q *Queries) UpdateInterview(ctx context.Context, arg UpdateInterviewParams) (UpdateInterviewRow, error) {
+	row := q.db.QueryRow(ctx, updateInterview,
+		arg.ScheduledAt,
+		arg.Timezone,
+		arg.Duration,
+		arg.Type,
+		arg.Location,
+		arg.MeetingUrl,
+		arg.Interviewers,
+		arg.PreparationNotes,
+		arg.Outcome,
+		arg.ID,
+		arg.JobApplicationID,
+		arg.UserID,
+	)
+	var i UpdateInterviewRow
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Duration,
+		&i.Type,
+		&i.Location,
+		&i.MeetingUrl,
+		&i.Interviewers,
+		&i.PreparationNotes,
+		&i.Outcome,
+	)
+	return i, err
 }
 
 const updateJobApplication = `-- name: UpdateJobApplication :one
-UPDATE job_applications
-SET 
-  company_name = coalesce(nullif($3, ''), company_name),
-  job_title = coalesce(nullif($4, ''), job_title),
-  date_applied = coalesce($5, date_applied),
-  status = coalesce($6, status),
-  is_replied = coalesce($7, is_replied),
-  min_salary = coalesce($8, min_salary),
-  max_salary = coalesce($9, max_salary),
-  job_posting_url = coalesce(nullif($10, ''), job_posting_url),
-  notes = coalesce(nullif($11, ''), notes)
-WHERE id = $1 AND user_id = $2
-RETURNING id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, notes
+WITH new_company AS (
+  INSERT INTO companies (user_id, name)
+  SELECT $1::uuid, $2::text
+  WHERE nullif($2::text, '') IS NOT NULL AND $3::uuid IS NULL
+  ON CONFLICT ON CONSTRAINT unique_company_name DO UPDATE SET name = companies.name
+  RETURNING id, name
+),
+company AS (
+  SELECT id, name FROM new_company
+  UNION ALL
+  SELECT id, name FROM companies WHERE id = $3::uuid AND user_id = $1::uuid
+),
+updated_job_application AS (
+  UPDATE job_applications
+  SET 
+    company_id = coalesce((SELECT id FROM company), $3::uuid, company_id),
+    company_name = coalesce((SELECT name FROM company), company_name),
+    job_title = coalesce(nullif($4::text, ''), job_title),
+    date_applied = coalesce($5::date, date_applied),
+    stage_id = coalesce($6::uuid, stage_id),
+    is_replied = coalesce($7::boolean, is_replied),
+    min_salary = CASE WHEN $8::boolean THEN NULL ELSE coalesce($9::numeric, min_salary) END,
+    max_salary = CASE WHEN $10::boolean THEN NULL ELSE coalesce($11::numeric, max_salary) END,
+    salary_currency = coalesce(nullif($12::text, ''), salary_currency),
+    salary_period = coalesce($13::salary_period, salary_period),
+    salary_type = coalesce($14::salary_type, salary_type),
+    contract_type = CASE WHEN $15::boolean THEN NULL ELSE coalesce($16::contract_type, contract_type) END,
+    job_posting_url = CASE WHEN $17::boolean THEN NULL ELSE coalesce(nullif($18::text, ''), job_posting_url) END,
+    notes = CASE WHEN $19::boolean THEN NULL ELSE coalesce(nullif($20::text, ''), notes) END
+  WHERE id = $21::uuid AND user_id = $1::uuid AND deleted_at IS NULL
+  RETURNING id, company_id, company_name, job_title, date_applied, stage_id, is_replied, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, job_posting_url, notes, version
+)
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes, j.version
+FROM updated_job_application AS j
+JOIN stages AS s ON s.id = j.stage_id
 `
 
 type UpdateJobApplicationParams struct {
-	ID            pgtype.UUID        `json:"id"`
-	UserID        pgtype.UUID        `json:"userId"`
-	CompanyName   interface{}        `json:"companyName"`
-	JobTitle      interface{}        `json:"jobTitle"`
-	DateApplied   pgtype.Timestamptz `json:"dateApplied"`
-	Status        NullStatus         `json:"status"`
-	IsReplied     pgtype.Bool        `json:"isReplied"`
-	MinSalary     pgtype.Float8      `json:"minSalary"`
-	MaxSalary     pgtype.Float8      `json:"maxSalary"`
-	JobPostingUrl interface{}        `json:"jobPostingUrl"`
-	Notes         interface{}        `json:"notes"`
+	UserID             pgtype.UUID      `json:"userId"`
+	CompanyName        pgtype.Text      `json:"companyName"`
+	CompanyID          pgtype.UUID      `json:"companyId"`
+	JobTitle           pgtype.Text      `json:"jobTitle"`
+	DateApplied        pgtype.Date      `json:"dateApplied"`
+	StageID            pgtype.UUID      `json:"stageId"`
+	IsReplied          pgtype.Bool      `json:"isReplied"`
+	ClearMinSalary     bool             `json:"clearMinSalary"`
+	MinSalary          pgtype.Float8    `json:"minSalary"`
+	ClearMaxSalary     bool             `json:"clearMaxSalary"`
+	MaxSalary          pgtype.Float8    `json:"maxSalary"`
+	SalaryCurrency     pgtype.Text      `json:"salaryCurrency"`
+	SalaryPeriod       NullSalaryPeriod `json:"salaryPeriod"`
+	SalaryType         NullSalaryType   `json:"salaryType"`
+	ClearContractType  bool             `json:"clearContractType"`
+	ContractType       NullContractType `json:"contractType"`
+	ClearJobPostingUrl bool             `json:"clearJobPostingUrl"`
+	JobPostingUrl      pgtype.Text      `json:"jobPostingUrl"`
+	ClearNotes         bool             `json:"clearNotes"`
+	Notes              pgtype.Text      `json:"notes"`
+	ID                 pgtype.UUID      `json:"id"`
 }
 
 type UpdateJobApplicationRow struct {
-	ID            pgtype.UUID        `json:"id"`
-	CompanyName   string             `json:"companyName"`
-	JobTitle      string             `json:"jobTitle"`
-	DateApplied   pgtype.Timestamptz `json:"dateApplied"`
-	Status        Status             `json:"status"`
-	IsReplied     bool               `json:"isReplied"`
-	MinSalary     pgtype.Float8      `json:"minSalary"`
-	MaxSalary     pgtype.Float8      `json:"maxSalary"`
-	JobPostingUrl pgtype.Text        `json:"jobPostingUrl"`
-	Notes         pgtype.Text        `json:"notes"`
+	ID              pgtype.UUID      `json:"id"`
+	CompanyID       pgtype.UUID      `json:"companyId"`
+	CompanyName     string           `json:"companyName"`
+	JobTitle        string           `json:"jobTitle"`
+	DateApplied     pgtype.Date      `json:"dateApplied"`
+	StageID         pgtype.UUID      `json:"stageId"`
+	StageName       string           `json:"stageName"`
+	StageColor      string           `json:"stageColor"`
+	StageIsTerminal bool             `json:"stageIsTerminal"`
+	StageOutcome    StageOutcome     `json:"stageOutcome"`
+	IsReplied       bool             `json:"isReplied"`
+	MinSalary       pgtype.Float8    `json:"minSalary"`
+	MaxSalary       pgtype.Float8    `json:"maxSalary"`
+	SalaryCurrency  string           `json:"salaryCurrency"`
+	SalaryPeriod    SalaryPeriod     `json:"salaryPeriod"`
+	SalaryType      SalaryType       `json:"salaryType"`
+	ContractType    NullContractType `json:"contractType"`
+	JobPostingUrl   pgtype.Text      `json:"jobPostingUrl"`
+	Notes           pgtype.Text      `json:"notes"`
+	Version         int32            `json:"version"`
 }
 
 func (q *Queries) UpdateJobApplication(ctx context.Context, arg UpdateJobApplicationParams) (UpdateJobApplicationRow, error) {
 	row := q.db.QueryRow(ctx, updateJobApplication,
-		arg.ID,
 		arg.UserID,
 		arg.CompanyName,
+		arg.CompanyID,
 		arg.JobTitle,
 		arg.DateApplied,
-		arg.Status,
+		arg.StageID,
 		arg.IsReplied,
+		arg.ClearMinSalary,
 		arg.MinSalary,
+		arg.ClearMaxSalary,
 		arg.MaxSalary,
+		arg.SalaryCurrency,
+		arg.SalaryPeriod,
+		arg.SalaryType,
+		arg.ClearContractType,
+		arg.ContractType,
+		arg.ClearJobPostingUrl,
 		arg.JobPostingUrl,
+		arg.ClearNotes,
 		arg.Notes,
+		arg.ID,
 	)
 	var i UpdateJobApplicationRow
 	err := row.Scan(
 		&i.ID,
+		&i.CompanyID,
 		&i.CompanyName,
 		&i.JobTitle,
 		&i.DateApplied,
-		&i.Status,
+		&i.StageID,
+		&i.StageName,
+		&i.StageColor,
+		&i.StageIsTerminal,
+		&i.StageOutcome,
 		&i.IsReplied,
 		&i.MinSalary,
 		&i.MaxSalary,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
+		&i.SalaryType,
+		&i.ContractType,
 		&i.JobPostingUrl,
 		&i.Notes,
+		&i.Version,
 	)
 	return i, err
 }
@@ -570,6 +3837,147 @@ func (q *Queries) UpdatePassword(ctx context.Context, arg UpdatePasswordParams)
 	return err
 }
 
+const updateStage = `-- name: UpdateStage :one
+UPDATE stages
+SET
+  name = coalesce(nullif($3::text, ''), name),
+  position = coalesce($4, position),
+  color = coalesce(nullif($5::text, ''), color),
+  is_terminal = coalesce($6, is_terminal),
+  outcome = coalesce($7, outcome)
+WHERE id = $1 AND user_id = $2
+RETURNING id, name, position, color, is_terminal, outcome
+`
+
+type UpdateStageParams struct {
+	ID         pgtype.UUID      `json:"id"`
+	UserID     pgtype.UUID      `json:"userId"`
+	Name       pgtype.Text      `json:"name"`
+	Position   pgtype.Int4      `json:"position"`
+	Color      pgtype.Text      `json:"color"`
+	IsTerminal pgtype.Bool      `json:"isTerminal"`
+	Outcome    NullStageOutcome `json:"outcome"`
+}
+
+type UpdateStageRow struct {
+	ID         pgtype.UUID  `json:"id"`
+	Name       string       `json:"name"`
+	Position   int32        `json:"position"`
+	Color      string       `json:"color"`
+	IsTerminal bool         `json:"isTerminal"`
+	Outcome    StageOutcome `json:"outcome"`
+}
+
+func (q *Queries) UpdateStage(ctx context.Context, arg UpdateStageParams) (UpdateStageRow, error) {
+	row := q.db.QueryRow(ctx, updateStage,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Position,
+		arg.Color,
+		arg.IsTerminal,
+		arg.Outcome,
+	)
+	var i UpdateStageRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Position,
+		&i.Color,
+		&i.IsTerminal,
+		&i.Outcome,
+	)
+	return i, err
+}
+
+const updateTOTPSecret = `-- name: UpdateTOTPSecret :exec
+UPDATE users SET totp_secret = $2, totp_last_step = NULL WHERE id = $1 AND is_totp_enabled = false
+`
+
+type UpdateTOTPSecretParams struct {
+	ID         pgtype.UUID `json:"id"`
+	TotpSecret pgtype.Text `json:"totpSecret"`
+}
+
+func (q *Queries) UpdateTOTPSecret(ctx context.Context, arg UpdateTOTPSecretParams) error {
+	_, err := q.db.Exec(ctx, updateTOTPSecret, arg.ID, arg.TotpSecret)
+	return err
+}
+
+const updateTag = `-- name: UpdateTag :one
+UPDATE tags
+SET
+  name = coalesce(nullif($1::text, ''), name),
+  color = coalesce(nullif($2::text, ''), color)
+WHERE id = $3 AND user_id = $4
+RETURNING id, name, color
+`
+
+type UpdateTagParams struct {
+	Name   pgtype.Text `json:"name"`
+	Color  pgtype.Text `json:"color"`
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"userId"`
+}
+
+type UpdateTagRow struct {
+	ID    pgtype.UUID `json:"id"`
+	Name  string      `json:"name"`
+	Color string      `json:"color"`
+}
+
+func (q *Queries) UpdateTag(ctx context.Context, arg UpdateTagParams) (UpdateTagRow, error) {
+	row := q.db.QueryRow(ctx, updateTag,
+		arg.Name,
+		arg.Color,
+		arg.ID,
+		arg.UserID,
+	)
+	var i UpdateTagRow
+	err := row.Scan(&i.ID, &i.Name, &i.Color)
+	return i, err
+}
+
+const updateUserPreferences = `-- name: UpdateUserPreferences :one
+UPDATE users
+SET timezone = $1, locale = $2, week_start = $3, currency = $4
+WHERE id = $5
+RETURNING timezone, locale, week_start, currency
+`
+
+type UpdateUserPreferencesParams struct {
+	Timezone  string      `json:"timezone"`
+	Locale    string      `json:"locale"`
+	WeekStart WeekStart   `json:"weekStart"`
+	Currency  string      `json:"currency"`
+	ID        pgtype.UUID `json:"id"`
+}
+
+type UpdateUserPreferencesRow struct {
+	Timezone  string    `json:"timezone"`
+	Locale    string    `json:"locale"`
+	WeekStart WeekStart `json:"weekStart"`
+	Currency  string    `json:"currency"`
+}
+
+func (q *Queries) UpdateUserPreferences(ctx context.Context, arg UpdateUserPreferencesParams) (UpdateUserPreferencesRow, error) {
+	row := q.db.QueryRow(ctx, updateUserPreferences,
+		arg.Timezone,
+		arg.Locale,
+		arg.WeekStart,
+		arg.Currency,
+		arg.ID,
+	)
+	var i UpdateUserPreferencesRow
+	err := row.Scan(
+		&i.Timezone,
+		&i.Locale,
+		&i.WeekStart,
+		&i.Currency,
+	)
+	return i, err
+}
+
 const updateVerificationToken = `-- name: UpdateVerificationToken :one
 UPDATE verification_tokens SET
   token = encode(gen_random_bytes(32), 'hex'),
@@ -590,6 +3998,101 @@ func (q *Queries) UpdateVerificationToken(ctx context.Context, userID pgtype.UUI
 	return i, err
 }
 
+const updateView = `-- name: UpdateView :one
+UPDATE views
+SET
+  name = coalesce(nullif($1::text, ''), name),
+  query = coalesce($2::text, query),
+  is_default = coalesce($3::bool, is_default)
+WHERE id = $4 AND user_id = $5
+RETURNING id, name, query, is_default
+`
+
+type UpdateViewParams struct {
+	Name      pgtype.Text `json:"name"`
+	Query     pgtype.Text `json:"query"`
+	IsDefault pgtype.Bool `json:"isDefault"`
+	ID        pgtype.UUID `json:"id"`
+	UserID    pgtype.UUID `json:"userId"`
+}
+
+type UpdateViewRow struct {
+	ID        pgtype.UUID `json:"id"`
+	Name      string      `json:"name"`
+	Query     string      `json:"query"`
+	IsDefault bool        `json:"isDefault"`
+}
+
+func (q *Queries) UpdateView(ctx context.Context, arg UpdateViewParams) (UpdateViewRow, error) {
+	row := q.db.QueryRow(ctx, updateView,
+		arg.Name,
+		arg.Query,
+		arg.IsDefault,
+		arg.ID,
+		arg.UserID,
+	)
+	var i UpdateViewRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Query,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const upsertExchangeRate = `-- name: UpsertExchangeRate :exec
+INSERT INTO exchange_rates (currency, rate) VALUES ($1::text, $2::numeric)
+ON CONFLICT (currency) DO UPDATE SET rate = EXCLUDED.rate, updated_at = NOW()
+`
+
+type UpsertExchangeRateParams struct {
+	Currency string        `json:"currency"`
+	Rate     pgtype.Float8 `json:"rate"`
+}
+
+func (q *Queries) UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRateParams) error {
+	_, err := q.db.Exec(ctx, upsertExchangeRate, arg.Currency, arg.Rate)
+	return err
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :one
+UPDATE recovery_codes SET used_at = NOW()
+WHERE user_id = $1 AND code_hash = encode(digest($2::text, 'sha256'), 'hex') AND used_at IS NULL
+RETURNING id
+`
+
+type UseRecoveryCodeParams struct {
+	UserID pgtype.UUID `json:"userId"`
+	Code   string      `json:"code"`
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, useRecoveryCode, arg.UserID, arg.Code)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const useTOTPStep = `-- name: UseTOTPStep :one
+UPDATE users SET totp_last_step = $1::bigint
+WHERE id = $2 AND (totp_last_step IS NULL OR totp_last_step < $1::bigint)
+RETURNING id
+`
+
+type UseTOTPStepParams struct {
+	Step int64       `json:"step"`
+	ID   pgtype.UUID `json:"id"`
+}
+
+// NOTE: Fails for a code at or before the last accepted time step, so that each code is accepted once
+func (q *Queries) UseTOTPStep(ctx context.Context, arg UseTOTPStepParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, useTOTPStep, arg.Step, arg.ID)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const verifyEmail = `-- name: VerifyEmail :one
 UPDATE users SET is_email_verified = true WHERE id = $1 RETURNING id, first_name, last_name, email, is_email_verified
 `
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE email_status AS ENUM ('PENDING', 'SENT', 'DEAD');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE email_outbox (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  recipient       TEXT NOT NULL,
+  subject         TEXT NOT NULL,
+  body            TEXT NOT NULL,
+  status          email_status NOT NULL DEFAULT 'PENDING',
+  attempts        INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  last_error      TEXT,
+  sent_at         TIMESTAMPTZ,
+  created_at      TIMESTAMPTZ DEFAULT NOW(),
+  updated_at      TIMESTAMPTZ DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX email_outbox_pending_idx ON email_outbox (next_attempt_at) WHERE status = 'PENDING';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER set_email_outbox_updated_at_timestamp
+BEFORE UPDATE ON email_outbox
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS email_outbox;
+DROP TYPE IF EXISTS email_status;
+-- +goose StatementEnd
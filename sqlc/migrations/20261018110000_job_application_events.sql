@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE job_application_events (
+  id                 UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  field              TEXT NOT NULL,
+  old_value          TEXT,
+  new_value          TEXT,
+  created_at         TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX job_application_events_job_application_id_idx ON job_application_events (job_application_id, created_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_job_application_events()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' THEN
+    INSERT INTO job_application_events (job_application_id, field, new_value)
+    VALUES (NEW.id, 'status', NEW.status::text);
+    RETURN NEW;
+  END IF;
+
+  INSERT INTO job_application_events (job_application_id, field, old_value, new_value)
+  SELECT NEW.id, changes.field, changes.old_value, changes.new_value
+  FROM (VALUES
+    ('company_name', OLD.company_name, NEW.company_name),
+    ('job_title', OLD.job_title, NEW.job_title),
+    ('date_applied', OLD.date_applied::text, NEW.date_applied::text),
+    ('status', OLD.status::text, NEW.status::text),
+    ('is_replied', OLD.is_replied::text, NEW.is_replied::text),
+    ('min_salary', OLD.min_salary::text, NEW.min_salary::text),
+    ('max_salary', OLD.max_salary::text, NEW.max_salary::text),
+    ('job_posting_url', OLD.job_posting_url, NEW.job_posting_url),
+    ('notes', OLD.notes, NEW.notes)
+  ) AS changes (field, old_value, new_value)
+  WHERE changes.old_value IS DISTINCT FROM changes.new_value;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER record_job_application_events
+AFTER INSERT OR UPDATE ON job_applications
+FOR EACH ROW
+EXECUTE FUNCTION record_job_application_events();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO job_application_events (job_application_id, field, new_value, created_at)
+SELECT id, 'status', status::text, coalesce(created_at, NOW()) FROM job_applications;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS record_job_application_events ON job_applications;
+DROP FUNCTION IF EXISTS record_job_application_events;
+DROP TABLE IF EXISTS job_application_events;
+-- +goose StatementEnd
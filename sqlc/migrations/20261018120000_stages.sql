@@ -0,0 +1,213 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE stage_outcome AS ENUM ('NEUTRAL', 'POSITIVE', 'NEGATIVE');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE stages (
+  id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name        TEXT NOT NULL,
+  position    INTEGER NOT NULL DEFAULT 0,
+  color       TEXT NOT NULL DEFAULT '#64748b',
+  is_terminal BOOLEAN NOT NULL DEFAULT false,
+  outcome     stage_outcome NOT NULL DEFAULT 'NEUTRAL',
+  created_at  TIMESTAMPTZ DEFAULT NOW(),
+  updated_at  TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT unique_stage_name UNIQUE (user_id, name),
+  CONSTRAINT unique_stage_user UNIQUE (id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER set_stage_updated_at_timestamp
+BEFORE UPDATE ON stages
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION create_default_stages()
+RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO stages (user_id, name, position, color, is_terminal, outcome)
+  VALUES
+    (NEW.id, 'In progress', 0, '#0284c7', false, 'NEUTRAL'),
+    (NEW.id, 'Rejected', 1, '#dc2626', true, 'NEGATIVE'),
+    (NEW.id, 'Accepted', 2, '#16a34a', true, 'POSITIVE');
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER create_default_stages
+AFTER INSERT ON users
+FOR EACH ROW
+EXECUTE FUNCTION create_default_stages();
+-- +goose StatementEnd
+
+-- NOTE: Every existing user gets the stages matching the old status enum values
+-- +goose StatementBegin
+INSERT INTO stages (user_id, name, position, color, is_terminal, outcome)
+SELECT u.id, d.name, d.position, d.color, d.is_terminal, d.outcome::stage_outcome
+FROM users AS u, (VALUES
+  ('In progress', 0, '#0284c7', false, 'NEUTRAL'),
+  ('Rejected', 1, '#dc2626', true, 'NEGATIVE'),
+  ('Accepted', 2, '#16a34a', true, 'POSITIVE')
+) AS d (name, position, color, is_terminal, outcome);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION legacy_stage_id(user_id UUID, status TEXT)
+RETURNS UUID AS $$
+  SELECT id FROM stages
+  WHERE stages.user_id = legacy_stage_id.user_id
+    AND name = CASE status WHEN 'IN_PROGRESS' THEN 'In progress' WHEN 'REJECTED' THEN 'Rejected' WHEN 'ACCEPTED' THEN 'Accepted' END;
+$$ LANGUAGE sql;
+-- +goose StatementEnd
+
+-- NOTE: Applications without an owner can't be assigned a stage. Rather than losing them for good, the migration stops,
+-- so that they're given an owner, or deleted, on purpose before it's run again.
+-- +goose StatementBegin
+DO $$
+DECLARE
+  ownerless INTEGER;
+BEGIN
+  SELECT count(*) INTO ownerless FROM job_applications WHERE user_id IS NULL;
+  IF ownerless > 0 THEN
+    RAISE EXCEPTION '% job application(s) have no user_id, so they can''t be assigned a stage', ownerless
+      USING HINT = 'Set their user_id, or delete them, before migrating: SELECT id, company_name, job_title FROM job_applications WHERE user_id IS NULL;';
+  END IF;
+END;
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE job_applications ADD COLUMN stage_id UUID;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE job_applications SET stage_id = legacy_stage_id(user_id, status::text);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE job_application_events AS e SET
+  field = 'stage',
+  old_value = legacy_stage_id(j.user_id, e.old_value)::text,
+  new_value = legacy_stage_id(j.user_id, e.new_value)::text
+FROM job_applications AS j
+WHERE j.id = e.job_application_id AND e.field = 'status';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP FUNCTION legacy_stage_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE job_applications
+  ALTER COLUMN stage_id SET NOT NULL,
+  ADD CONSTRAINT job_applications_stage_id_fkey FOREIGN KEY (stage_id, user_id) REFERENCES stages(id, user_id),
+  DROP COLUMN status;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TYPE status;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX job_applications_stage_id_idx ON job_applications (stage_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_job_application_events()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' THEN
+    INSERT INTO job_application_events (job_application_id, field, new_value)
+    VALUES (NEW.id, 'stage', NEW.stage_id::text);
+    RETURN NEW;
+  END IF;
+
+  INSERT INTO job_application_events (job_application_id, field, old_value, new_value)
+  SELECT NEW.id, changes.field, changes.old_value, changes.new_value
+  FROM (VALUES
+    ('company_name', OLD.company_name, NEW.company_name),
+    ('job_title', OLD.job_title, NEW.job_title),
+    ('date_applied', OLD.date_applied::text, NEW.date_applied::text),
+    ('stage', OLD.stage_id::text, NEW.stage_id::text),
+    ('is_replied', OLD.is_replied::text, NEW.is_replied::text),
+    ('min_salary', OLD.min_salary::text, NEW.min_salary::text),
+    ('max_salary', OLD.max_salary::text, NEW.max_salary::text),
+    ('job_posting_url', OLD.job_posting_url, NEW.job_posting_url),
+    ('notes', OLD.notes, NEW.notes)
+  ) AS changes (field, old_value, new_value)
+  WHERE changes.old_value IS DISTINCT FROM changes.new_value;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TYPE status AS ENUM ('IN_PROGRESS', 'REJECTED', 'ACCEPTED');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE job_applications ADD COLUMN status status NOT NULL DEFAULT 'IN_PROGRESS';
+-- +goose StatementEnd
+
+-- NOTE: Custom stages are collapsed into the closest status by their outcome
+-- +goose StatementBegin
+UPDATE job_applications AS j SET status = CASE s.outcome WHEN 'POSITIVE' THEN 'ACCEPTED' WHEN 'NEGATIVE' THEN 'REJECTED' ELSE 'IN_PROGRESS' END::status
+FROM stages AS s
+WHERE s.id = j.stage_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE job_application_events AS e SET
+  field = 'status',
+  old_value = (SELECT CASE s.outcome WHEN 'POSITIVE' THEN 'ACCEPTED' WHEN 'NEGATIVE' THEN 'REJECTED' ELSE 'IN_PROGRESS' END FROM stages AS s WHERE s.id::text = e.old_value),
+  new_value = (SELECT CASE s.outcome WHEN 'POSITIVE' THEN 'ACCEPTED' WHEN 'NEGATIVE' THEN 'REJECTED' ELSE 'IN_PROGRESS' END FROM stages AS s WHERE s.id::text = e.new_value)
+WHERE e.field = 'stage';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_job_application_events()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' THEN
+    INSERT INTO job_application_events (job_application_id, field, new_value)
+    VALUES (NEW.id, 'status', NEW.status::text);
+    RETURN NEW;
+  END IF;
+
+  INSERT INTO job_application_events (job_application_id, field, old_value, new_value)
+  SELECT NEW.id, changes.field, changes.old_value, changes.new_value
+  FROM (VALUES
+    ('company_name', OLD.company_name, NEW.company_name),
+    ('job_title', OLD.job_title, NEW.job_title),
+    ('date_applied', OLD.date_applied::text, NEW.date_applied::text),
+    ('status', OLD.status::text, NEW.status::text),
+    ('is_replied', OLD.is_replied::text, NEW.is_replied::text),
+    ('min_salary', OLD.min_salary::text, NEW.min_salary::text),
+    ('max_salary', OLD.max_salary::text, NEW.max_salary::text),
+    ('job_posting_url', OLD.job_posting_url, NEW.job_posting_url),
+    ('notes', OLD.notes, NEW.notes)
+  ) AS changes (field, old_value, new_value)
+  WHERE changes.old_value IS DISTINCT FROM changes.new_value;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS job_applications_stage_id_idx;
+ALTER TABLE job_applications DROP COLUMN IF EXISTS stage_id;
+DROP TRIGGER IF EXISTS create_default_stages ON users;
+DROP FUNCTION IF EXISTS create_default_stages;
+DROP TABLE IF EXISTS stages;
+DROP TYPE IF EXISTS stage_outcome;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE interview_type AS ENUM ('PHONE', 'ONSITE', 'TECHNICAL', 'HR');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TYPE interview_outcome AS ENUM ('PENDING', 'PASSED', 'FAILED', 'CANCELLED');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE interviews (
+  id                 UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  scheduled_at       TIMESTAMPTZ NOT NULL,
+  timezone           TEXT NOT NULL DEFAULT 'UTC',
+  duration           INTEGER CHECK (duration > 0), -- NOTE: Minutes
+  type               interview_type NOT NULL,
+  location           TEXT,
+  meeting_url        TEXT,
+  interviewers       TEXT[] NOT NULL DEFAULT '{}',
+  preparation_notes  TEXT,
+  outcome            interview_outcome NOT NULL DEFAULT 'PENDING',
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX interviews_job_application_id_idx ON interviews (job_application_id);
+CREATE INDEX interviews_scheduled_at_idx ON interviews (scheduled_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER set_interview_updated_at_timestamp
+BEFORE UPDATE ON interviews
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS interviews;
+DROP TYPE IF EXISTS interview_outcome;
+DROP TYPE IF EXISTS interview_type;
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE contacts (
+  id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id      UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name         TEXT NOT NULL,
+  email        TEXT,
+  phone        TEXT,
+  linkedin_url TEXT,
+  company      TEXT,
+  role         TEXT,
+  notes        TEXT,
+  created_at   TIMESTAMPTZ DEFAULT NOW(),
+  updated_at   TIMESTAMPTZ DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX contacts_user_id_idx ON contacts (user_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER set_contact_updated_at_timestamp
+BEFORE UPDATE ON contacts
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE job_application_contacts (
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  contact_id         UUID NOT NULL REFERENCES contacts(id) ON DELETE CASCADE,
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  PRIMARY KEY (job_application_id, contact_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX job_application_contacts_contact_id_idx ON job_application_contacts (contact_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS job_application_contacts;
+DROP TABLE IF EXISTS contacts;
+-- +goose StatementEnd
@@ -0,0 +1,103 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION normalize_company_name(name TEXT)
+RETURNS TEXT AS $$
+  -- NOTE: "Evil Corp", "evil corp." and "Evil Corp Inc." all normalise to "evil"
+  SELECT trim(regexp_replace(
+    trim(regexp_replace(lower(name), '[^[:alnum:]]+', ' ', 'g')),
+    '( (inc|incorporated|corp|corporation|co|company|llc|ltd|limited|plc|gmbh|ag|sa|bv|sp z o o))+$',
+    ''
+  ));
+$$ LANGUAGE sql IMMUTABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE companies (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name            TEXT NOT NULL,
+  normalized_name TEXT GENERATED ALWAYS AS (normalize_company_name(name)) STORED,
+  website         TEXT,
+  industry        TEXT,
+  size            TEXT,
+  location        TEXT,
+  notes           TEXT,
+  created_at      TIMESTAMPTZ DEFAULT NOW(),
+  updated_at      TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT unique_company_name UNIQUE (user_id, normalized_name),
+  CONSTRAINT unique_company_user UNIQUE (id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER set_company_updated_at_timestamp
+BEFORE UPDATE ON companies
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO companies (user_id, name)
+SELECT DISTINCT ON (user_id, normalize_company_name(company_name)) user_id, company_name
+FROM job_applications
+ORDER BY user_id, normalize_company_name(company_name), created_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE job_applications ADD COLUMN company_id UUID;
+-- +goose StatementEnd
+
+-- NOTE: Spelling variants are collapsed into a single company, which isn't a change made by the user
+-- +goose StatementBegin
+ALTER TABLE job_applications DISABLE TRIGGER record_job_application_events;
+
+UPDATE job_applications AS j SET
+  company_id = c.id,
+  company_name = c.name
+FROM companies AS c
+WHERE c.user_id = j.user_id AND c.normalized_name = normalize_company_name(j.company_name);
+
+ALTER TABLE job_applications ENABLE TRIGGER record_job_application_events;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE job_applications ALTER COLUMN company_id SET NOT NULL;
+ALTER TABLE job_applications ADD CONSTRAINT job_applications_company_id_fkey FOREIGN KEY (company_id, user_id) REFERENCES companies(id, user_id);
+CREATE INDEX job_applications_company_id_idx ON job_applications (company_id);
+-- +goose StatementEnd
+
+-- NOTE: job_applications.company_name is kept as a denormalised copy of companies.name, so filtering, sorting and searching by company stay single-table
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION sync_job_application_company_name()
+RETURNS TRIGGER AS $$
+BEGIN
+  UPDATE job_applications SET company_name = NEW.name WHERE company_id = NEW.id;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER sync_job_application_company_name
+AFTER UPDATE OF name ON companies
+FOR EACH ROW
+WHEN (OLD.name IS DISTINCT FROM NEW.name)
+EXECUTE FUNCTION sync_job_application_company_name();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS sync_job_application_company_name ON companies;
+DROP FUNCTION IF EXISTS sync_job_application_company_name();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS job_applications_company_id_idx;
+ALTER TABLE job_applications DROP CONSTRAINT IF EXISTS job_applications_company_id_fkey;
+ALTER TABLE job_applications DROP COLUMN IF EXISTS company_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS companies;
+DROP FUNCTION IF EXISTS normalize_company_name(TEXT);
+-- +goose StatementEnd
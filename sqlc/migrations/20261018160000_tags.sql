@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tags (
+  id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name       TEXT NOT NULL,
+  color      TEXT NOT NULL DEFAULT '#64748b',
+  created_at TIMESTAMPTZ DEFAULT NOW(),
+  updated_at TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT unique_tag_name UNIQUE (user_id, name)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER set_tag_updated_at_timestamp
+BEFORE UPDATE ON tags
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE job_application_tags (
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  tag_id             UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  PRIMARY KEY (job_application_id, tag_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX job_application_tags_tag_id_idx ON job_application_tags (tag_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS job_application_tags;
+DROP TABLE IF EXISTS tags;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- NOTE: The language agnostic `simple` config, since notes are written in whatever language the job ad was.
+-- Punctuation in urls is turned into spaces, so that e.g. "glassbore" matches https://glassbore.com/jobs/swe420692137
+ALTER TABLE job_applications ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', company_name), 'A') ||
+  setweight(to_tsvector('simple', job_title), 'A') ||
+  setweight(to_tsvector('simple', coalesce(notes, '')), 'B') ||
+  setweight(to_tsvector('simple', regexp_replace(coalesce(job_posting_url, ''), '[^[:alnum:]]+', ' ', 'g')), 'C')
+) STORED;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX job_applications_search_vector_idx ON job_applications USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS job_applications_search_vector_idx;
+ALTER TABLE job_applications DROP COLUMN IF EXISTS search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE views (
+  id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name       TEXT NOT NULL,
+  query      TEXT NOT NULL DEFAULT '', -- NOTE: Job applications list query string, e.g. outcome=NEUTRAL&is_replied=false
+  is_default BOOLEAN NOT NULL DEFAULT false,
+  created_at TIMESTAMPTZ DEFAULT NOW(),
+  updated_at TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT unique_view_name UNIQUE (user_id, name)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX unique_default_view ON views (user_id) WHERE is_default;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER set_view_updated_at_timestamp
+BEFORE UPDATE ON views
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS views;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE reminder_status AS ENUM ('PENDING', 'DELIVERED', 'DISMISSED');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE reminders (
+  id                 UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  remind_at          TIMESTAMPTZ NOT NULL,
+  note               TEXT,
+  unless_replied     BOOLEAN NOT NULL DEFAULT false, -- NOTE: Dismissed instead of delivered when the application got a reply in the meantime
+  is_suggested       BOOLEAN NOT NULL DEFAULT false, -- NOTE: Created by the scheduler rather than the user
+  status             reminder_status NOT NULL DEFAULT 'PENDING',
+  delivered_at       TIMESTAMPTZ,
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX reminders_job_application_id_idx ON reminders (job_application_id);
+CREATE INDEX reminders_pending_idx ON reminders (remind_at) WHERE status = 'PENDING';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- NOTE: At most one follow-up is ever suggested per application, even after it's been dismissed
+CREATE UNIQUE INDEX unique_suggested_reminder ON reminders (job_application_id) WHERE is_suggested;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER set_reminder_updated_at_timestamp
+BEFORE UPDATE ON reminders
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reminders;
+DROP TYPE IF EXISTS reminder_status;
+-- +goose StatementEnd
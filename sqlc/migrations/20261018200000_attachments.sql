@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE attachment_kind AS ENUM ('CV', 'COVER_LETTER', 'OTHER');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE attachments (
+  id                 UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  kind               attachment_kind NOT NULL DEFAULT 'OTHER',
+  filename           TEXT NOT NULL,
+  content_type       TEXT NOT NULL,
+  size               BIGINT NOT NULL CHECK (size >= 0), -- NOTE: Bytes
+  checksum           TEXT NOT NULL, -- NOTE: Hex-encoded SHA-256 of the contents, shared by every copy of the same file
+  storage_key        TEXT NOT NULL UNIQUE,
+  created_at         TIMESTAMPTZ DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX attachments_job_application_id_idx ON attachments (job_application_id);
+CREATE INDEX attachments_checksum_idx ON attachments (checksum);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS attachments;
+DROP TYPE IF EXISTS attachment_kind;
+-- +goose StatementEnd
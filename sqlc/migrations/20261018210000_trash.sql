@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE job_applications ADD COLUMN deleted_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX job_applications_deleted_at_idx ON job_applications (deleted_at) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS job_applications_deleted_at_idx;
+ALTER TABLE job_applications DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE job_applications ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION increment_version()
+RETURNS TRIGGER AS $$
+BEGIN
+  NEW.version = OLD.version + 1;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER increment_job_application_version
+BEFORE UPDATE ON job_applications
+FOR EACH ROW
+EXECUTE FUNCTION increment_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS increment_job_application_version ON job_applications;
+DROP FUNCTION IF EXISTS increment_version;
+ALTER TABLE job_applications DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
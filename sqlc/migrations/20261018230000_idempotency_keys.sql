@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE idempotency_keys (
+  id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id      UUID REFERENCES users(id) ON DELETE CASCADE, -- NOTE: NULL for requests made before signing in, e.g. sign-up
+  key          TEXT NOT NULL,
+  request_hash TEXT NOT NULL, -- NOTE: Hex-encoded HMAC-SHA256 of the method, path and body, since the body may contain a password
+  status       INTEGER, -- NOTE: NULL while the original request is still being handled
+  response     BYTEA,
+  created_at   TIMESTAMPTZ DEFAULT NOW(),
+  expires_at   TIMESTAMPTZ NOT NULL,
+  CONSTRAINT unique_idempotency_key UNIQUE NULLS NOT DISTINCT (user_id, key)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementEnd
@@ -0,0 +1,134 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE salary_period AS ENUM ('HOUR', 'MONTH', 'YEAR');
+CREATE TYPE salary_type AS ENUM ('GROSS', 'NET');
+CREATE TYPE contract_type AS ENUM ('UOP', 'B2B', 'UZ', 'UOD', 'OTHER');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- NOTE: Salaries entered so far had no unit, so they are taken as gross monthly amounts in PLN
+ALTER TABLE job_applications
+  ALTER COLUMN min_salary TYPE NUMERIC(14, 2),
+  ALTER COLUMN max_salary TYPE NUMERIC(14, 2),
+  ADD COLUMN salary_currency TEXT NOT NULL DEFAULT 'PLN' CHECK (salary_currency ~ '^[A-Z]{3}$'),
+  ADD COLUMN salary_period salary_period NOT NULL DEFAULT 'MONTH',
+  ADD COLUMN salary_type salary_type NOT NULL DEFAULT 'GROSS',
+  ADD COLUMN contract_type contract_type;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE exchange_rates (
+  currency   TEXT PRIMARY KEY CHECK (currency ~ '^[A-Z]{3}$'),
+  rate       NUMERIC NOT NULL CHECK (rate > 0), -- NOTE: Units of the currency per euro
+  updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- NOTE: Rough defaults, meant to be overridden with the EXCHANGE_RATES env var
+INSERT INTO exchange_rates (currency, rate) VALUES ('EUR', 1), ('PLN', 4.25), ('USD', 1.08), ('GBP', 0.85), ('CHF', 0.94);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- NOTE: Working hours in a salary period, assuming full-time work of 168 hours a month
+CREATE OR REPLACE FUNCTION salary_period_hours(period salary_period)
+RETURNS NUMERIC AS $$
+  SELECT CASE period WHEN 'HOUR' THEN 1 WHEN 'MONTH' THEN 168 WHEN 'YEAR' THEN 12 * 168 END;
+$$ LANGUAGE sql IMMUTABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- NOTE: NULL when either currency has no exchange rate. Only the currency and period are converted, so gross and net amounts stay as they are and have to be told apart by the salary type.
+CREATE OR REPLACE FUNCTION normalize_salary(amount NUMERIC, from_currency TEXT, from_period salary_period, to_currency TEXT, to_period salary_period)
+RETURNS NUMERIC AS $$
+  SELECT round(
+    amount
+    * CASE WHEN from_currency = to_currency THEN 1 ELSE
+        (SELECT rate FROM exchange_rates WHERE currency = to_currency) / (SELECT rate FROM exchange_rates WHERE currency = from_currency)
+      END
+    * salary_period_hours(to_period) / salary_period_hours(from_period),
+    2
+  );
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_job_application_events()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' THEN
+    INSERT INTO job_application_events (job_application_id, field, new_value)
+    VALUES (NEW.id, 'stage', NEW.stage_id::text);
+    RETURN NEW;
+  END IF;
+
+  INSERT INTO job_application_events (job_application_id, field, old_value, new_value)
+  SELECT NEW.id, changes.field, changes.old_value, changes.new_value
+  FROM (VALUES
+    ('company_name', OLD.company_name, NEW.company_name),
+    ('job_title', OLD.job_title, NEW.job_title),
+    ('date_applied', OLD.date_applied::text, NEW.date_applied::text),
+    ('stage', OLD.stage_id::text, NEW.stage_id::text),
+    ('is_replied', OLD.is_replied::text, NEW.is_replied::text),
+    ('min_salary', OLD.min_salary::text, NEW.min_salary::text),
+    ('max_salary', OLD.max_salary::text, NEW.max_salary::text),
+    ('salary_currency', OLD.salary_currency, NEW.salary_currency),
+    ('salary_period', OLD.salary_period::text, NEW.salary_period::text),
+    ('salary_type', OLD.salary_type::text, NEW.salary_type::text),
+    ('contract_type', OLD.contract_type::text, NEW.contract_type::text),
+    ('job_posting_url', OLD.job_posting_url, NEW.job_posting_url),
+    ('notes', OLD.notes, NEW.notes)
+  ) AS changes (field, old_value, new_value)
+  WHERE changes.old_value IS DISTINCT FROM changes.new_value;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_job_application_events()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' THEN
+    INSERT INTO job_application_events (job_application_id, field, new_value)
+    VALUES (NEW.id, 'stage', NEW.stage_id::text);
+    RETURN NEW;
+  END IF;
+
+  INSERT INTO job_application_events (job_application_id, field, old_value, new_value)
+  SELECT NEW.id, changes.field, changes.old_value, changes.new_value
+  FROM (VALUES
+    ('company_name', OLD.company_name, NEW.company_name),
+    ('job_title', OLD.job_title, NEW.job_title),
+    ('date_applied', OLD.date_applied::text, NEW.date_applied::text),
+    ('stage', OLD.stage_id::text, NEW.stage_id::text),
+    ('is_replied', OLD.is_replied::text, NEW.is_replied::text),
+    ('min_salary', OLD.min_salary::text, NEW.min_salary::text),
+    ('max_salary', OLD.max_salary::text, NEW.max_salary::text),
+    ('job_posting_url', OLD.job_posting_url, NEW.job_posting_url),
+    ('notes', OLD.notes, NEW.notes)
+  ) AS changes (field, old_value, new_value)
+  WHERE changes.old_value IS DISTINCT FROM changes.new_value;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS normalize_salary;
+DROP FUNCTION IF EXISTS salary_period_hours;
+DROP TABLE IF EXISTS exchange_rates;
+ALTER TABLE job_applications
+  DROP COLUMN IF EXISTS contract_type,
+  DROP COLUMN IF EXISTS salary_type,
+  DROP COLUMN IF EXISTS salary_period,
+  DROP COLUMN IF EXISTS salary_currency,
+  ALTER COLUMN max_salary TYPE DOUBLE PRECISION,
+  ALTER COLUMN min_salary TYPE DOUBLE PRECISION;
+DROP TYPE IF EXISTS contract_type;
+DROP TYPE IF EXISTS salary_type;
+DROP TYPE IF EXISTS salary_period;
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE week_start AS ENUM ('MONDAY', 'SATURDAY', 'SUNDAY');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- NOTE: The defaults match what was hard-coded so far, so nothing changes for existing users until they pick their own
+ALTER TABLE users
+  ADD COLUMN timezone TEXT NOT NULL DEFAULT 'Europe/Warsaw',
+  ADD COLUMN locale TEXT NOT NULL DEFAULT 'en-US',
+  ADD COLUMN week_start week_start NOT NULL DEFAULT 'MONDAY',
+  ADD COLUMN currency TEXT NOT NULL DEFAULT 'PLN' CHECK (currency ~ '^[A-Z]{3}$');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- NOTE: Every user is in Europe/Warsaw at this point, the time zone dates applied were compared in so far,
+-- so each application keeps the day it was already filtered and grouped under
+ALTER TABLE job_applications ALTER COLUMN date_applied TYPE DATE USING (date_applied AT TIME ZONE 'Europe/Warsaw')::date;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- NOTE: First day of the week the given day falls in
+CREATE OR REPLACE FUNCTION week_start_date(day DATE, start week_start)
+RETURNS DATE AS $$
+  SELECT day - (extract(isodow FROM day)::integer - CASE start WHEN 'MONDAY' THEN 1 WHEN 'SATURDAY' THEN 6 WHEN 'SUNDAY' THEN 7 END + 7) % 7;
+$$ LANGUAGE sql IMMUTABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS week_start_date;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE job_applications ALTER COLUMN date_applied TYPE TIMESTAMPTZ USING date_applied::timestamp AT TIME ZONE 'Europe/Warsaw';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE users
+  DROP COLUMN IF EXISTS timezone,
+  DROP COLUMN IF EXISTS locale,
+  DROP COLUMN IF EXISTS week_start,
+  DROP COLUMN IF EXISTS currency;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TYPE IF EXISTS week_start;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+-- NOTE: The last accepted TOTP time step, so that a code can't be replayed while it's still valid,
+-- and the attempts made since the last accepted code, so that codes can't be guessed across many challenges
+ALTER TABLE users
+  ADD COLUMN totp_last_step BIGINT,
+  ADD COLUMN mfa_attempts INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN mfa_attempts_since TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE mfa_challenges (
+  id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  attempts   INTEGER NOT NULL DEFAULT 0,
+  expires_at TIMESTAMPTZ NOT NULL DEFAULT NOW() + INTERVAL '5 minutes',
+  created_at TIMESTAMPTZ DEFAULT NOW(),
+  updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX mfa_challenges_user_id_idx ON mfa_challenges (user_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER set_mfa_challenge_updated_at_timestamp
+BEFORE UPDATE ON mfa_challenges
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS mfa_challenges;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE users
+  DROP COLUMN IF EXISTS totp_last_step,
+  DROP COLUMN IF EXISTS mfa_attempts,
+  DROP COLUMN IF EXISTS mfa_attempts_since;
+-- +goose StatementEnd
@@ -1,5 +1,5 @@
 -- name: Purge :exec
-TRUNCATE TABLE users, verification_tokens, password_reset_tokens, job_applications;
+TRUNCATE TABLE users, verification_tokens, password_reset_tokens, sessions, recovery_codes, mfa_challenges, email_outbox, stages, job_applications, job_application_events, interviews, contacts, job_application_contacts, companies, tags, job_application_tags, views, reminders, attachments, idempotency_keys;
 
 -- name: CreateUser :one
 WITH new_user AS (
@@ -25,7 +25,7 @@ SELECT
 FROM new_user, new_token;
 
 -- name: GetUserOnSignIn :one
-SELECT id, first_name, last_name, email, password, is_email_verified FROM users WHERE email = $1;
+SELECT id, first_name, last_name, email, password, is_email_verified, is_totp_enabled FROM users WHERE email = $1;
 
 -- name: GetUserById :one
 SELECT id, first_name, last_name, email, is_email_verified FROM users WHERE id = $1;
@@ -36,6 +36,15 @@ FROM users AS u
 JOIN verification_tokens as v ON u.id = v.user_id
 WHERE u.email = $1;
 
+-- name: GetUserPreferences :one
+SELECT timezone, locale, week_start, currency FROM users WHERE id = $1;
+
+-- name: UpdateUserPreferences :one
+UPDATE users
+SET timezone = @timezone, locale = @locale, week_start = @week_start, currency = @currency
+WHERE id = @id
+RETURNING timezone, locale, week_start, currency;
+
 -- name: GetVerificationToken :one
 SELECT token, expires_at FROM verification_tokens WHERE user_id = $1;
 
@@ -65,63 +74,832 @@ SELECT token, expires_at, user_id FROM password_reset_tokens WHERE token = $1;
 -- name: UpdatePassword :exec
 UPDATE users SET password = $2 WHERE id = $1;
 
--- name: DeletePasswordResetToken :exec
-DELETE FROM password_reset_tokens WHERE token = $1;
-
--- name: GetJobApplications :many
-WITH user_job_applications AS (
-  SELECT id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url
-  FROM job_applications
-  WHERE user_id = $3
-), 
-filtered_job_applications AS (
-  SELECT id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, COUNT(*) OVER() AS total
-  FROM user_job_applications
-  WHERE 
-    (company_name ILIKE '%' || @company_name_or_job_title::text || '%' OR job_title ILIKE '%' || @company_name_or_job_title::text || '%' OR @company_name_or_job_title::text IS NULL)
-    AND (((date_applied AT TIME ZONE 'Europe/Warsaw')::date = ((@date_applied) AT TIME ZONE 'Europe/Warsaw')::date) OR @date_applied IS NULL)
-    AND (status = nullif(@status, '')::status OR nullif(@status, '') IS NULL)
-  ORDER BY
-    CASE WHEN @company_name_asc::bool THEN company_name END ASC,
-    CASE WHEN @company_name_desc::bool THEN company_name END DESC,
-    CASE WHEN @job_title_asc::bool THEN job_title END ASC,
-    CASE WHEN @job_title_desc::bool THEN job_title END DESC,
-    CASE WHEN @date_applied_asc::bool THEN date_applied END ASC,
-    CASE WHEN @date_applied_desc::bool THEN date_applied END DESC,
-    CASE WHEN @status_asc::bool THEN status END ASC,
-    CASE WHEN @status_desc::bool THEN status END DESC,
-    CASE WHEN @salary_asc::bool THEN greatest(min_salary, max_salary) END ASC,
-    CASE WHEN @salary_desc::bool THEN greatest(min_salary, max_salary) END DESC,
-    CASE WHEN @is_replied_asc::bool THEN is_replied END ASC,
-    CASE WHEN @is_replied_desc::bool THEN is_replied END DESC
+-- name: DeletePasswordResetToken :one
+DELETE FROM password_reset_tokens WHERE token = $1 RETURNING token, expires_at, user_id;
+
+-- name: CreateSession :one
+INSERT INTO sessions (user_id) VALUES ($1) RETURNING id, refresh_token;
+
+-- name: GetActiveSession :one
+SELECT id, user_id FROM sessions WHERE id = $1 AND revoked_at IS NULL AND expires_at > NOW();
+
+-- name: RotateRefreshToken :one
+UPDATE sessions AS s SET
+  refresh_token = encode(gen_random_bytes(32), 'hex'),
+  expires_at = NOW() + INTERVAL '30 days'
+FROM users AS u
+WHERE s.refresh_token = $1 AND s.revoked_at IS NULL AND s.expires_at > NOW() AND u.id = s.user_id
+RETURNING s.id, s.user_id, u.email, s.refresh_token;
+
+-- name: RevokeSession :exec
+UPDATE sessions SET revoked_at = NOW() WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL;
+
+-- name: RevokeSessions :exec
+UPDATE sessions SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL;
+
+-- name: GetTOTP :one
+SELECT totp_secret, is_totp_enabled FROM users WHERE id = $1;
+
+-- name: UpdateTOTPSecret :exec
+UPDATE users SET totp_secret = $2, totp_last_step = NULL WHERE id = $1 AND is_totp_enabled = false;
+
+-- name: EnableTOTP :exec
+UPDATE users SET is_totp_enabled = true WHERE id = $1 AND totp_secret IS NOT NULL;
+
+-- name: DisableTOTP :exec
+UPDATE users SET totp_secret = NULL, is_totp_enabled = false, totp_last_step = NULL WHERE id = $1;
+
+-- name: UseTOTPStep :one
+-- NOTE: Fails for a code at or before the last accepted time step, so that each code is accepted once
+UPDATE users SET totp_last_step = @step::bigint
+WHERE id = @id AND (totp_last_step IS NULL OR totp_last_step < @step::bigint)
+RETURNING id;
+
+-- name: AttemptMFA :one
+-- NOTE: Fails once the user has tried too many codes in the lockout window without one being accepted
+UPDATE users SET
+  mfa_attempts = CASE WHEN mfa_attempts_since IS NULL OR mfa_attempts_since <= NOW() - make_interval(mins => @lockout_minutes::int) THEN 1 ELSE mfa_attempts + 1 END,
+  mfa_attempts_since = CASE WHEN mfa_attempts_since IS NULL OR mfa_attempts_since <= NOW() - make_interval(mins => @lockout_minutes::int) THEN NOW() ELSE mfa_attempts_since END
+WHERE id = @id AND (mfa_attempts_since IS NULL OR mfa_attempts_since <= NOW() - make_interval(mins => @lockout_minutes::int) OR mfa_attempts < @max_attempts::int)
+RETURNING id;
+
+-- name: ResetMFAAttempts :exec
+UPDATE users SET mfa_attempts = 0, mfa_attempts_since = NULL WHERE id = $1;
+
+-- name: CreateMFAChallenge :one
+-- NOTE: Expired challenges of the user are cleared on the way
+WITH expired AS (
+  DELETE FROM mfa_challenges WHERE user_id = $1 AND expires_at <= NOW()
 )
-SELECT id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, total
-FROM filtered_job_applications
-LIMIT $1 OFFSET $2;
+INSERT INTO mfa_challenges (user_id) VALUES ($1) RETURNING id, expires_at;
+
+-- name: AttemptMFAChallenge :one
+-- NOTE: Fails for a challenge that is expired, completed or out of attempts
+UPDATE mfa_challenges SET attempts = attempts + 1
+WHERE id = @id AND user_id = @user_id AND expires_at > NOW() AND attempts < @max_attempts::int
+RETURNING id;
+
+-- name: CompleteMFAChallenge :one
+DELETE FROM mfa_challenges WHERE id = $1 AND user_id = $2 RETURNING id;
+
+-- name: CreateRecoveryCodes :exec
+INSERT INTO recovery_codes (user_id, code_hash)
+SELECT $1, encode(digest(code, 'sha256'), 'hex') FROM unnest(@codes::text[]) AS code;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM recovery_codes WHERE user_id = $1;
+
+-- name: UseRecoveryCode :one
+UPDATE recovery_codes SET used_at = NOW()
+WHERE user_id = $1 AND code_hash = encode(digest(sqlc.arg(code)::text, 'sha256'), 'hex') AND used_at IS NULL
+RETURNING id;
+
+-- name: EnqueueEmail :one
+INSERT INTO email_outbox (recipient, subject, body) VALUES ($1, $2, $3) RETURNING id;
+
+-- name: ClaimEmails :many
+-- NOTE: Leased until the whole batch could have been sent, so that no other worker picks up an email still waiting its turn
+UPDATE email_outbox SET next_attempt_at = NOW() + make_interval(secs => @lease_seconds::int)
+WHERE id IN (
+  SELECT id FROM email_outbox
+  WHERE status = 'PENDING' AND next_attempt_at <= NOW()
+  ORDER BY next_attempt_at
+  LIMIT @batch_size::int
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, recipient, subject, body, attempts;
+
+-- name: MarkEmailSent :exec
+UPDATE email_outbox SET status = 'SENT', attempts = attempts + 1, last_error = NULL, sent_at = NOW() WHERE id = $1;
+
+-- name: MarkEmailFailed :exec
+UPDATE email_outbox SET status = $2, attempts = attempts + 1, last_error = $3, next_attempt_at = $4 WHERE id = $1;
+
+-- name: GetEmails :many
+SELECT id, recipient, subject, status, attempts, next_attempt_at, last_error, sent_at FROM email_outbox ORDER BY created_at;
+
+-- name: GetStages :many
+SELECT id, name, position, color, is_terminal, outcome FROM stages WHERE user_id = $1 ORDER BY position, created_at;
+
+-- name: GetStage :one
+SELECT id, name, position, color, is_terminal, outcome FROM stages WHERE id = $1 AND user_id = $2;
+
+-- name: CreateStage :one
+INSERT INTO stages (user_id, name, position, color, is_terminal, outcome)
+VALUES (
+  @user_id,
+  @name,
+  coalesce(sqlc.narg('position')::integer, (SELECT coalesce(max(position) + 1, 0) FROM stages WHERE user_id = @user_id)),
+  coalesce(nullif(sqlc.narg('color')::text, ''), '#64748b'),
+  @is_terminal,
+  @outcome
+)
+RETURNING id, name, position, color, is_terminal, outcome;
+
+-- name: UpdateStage :one
+UPDATE stages
+SET
+  name = coalesce(nullif(sqlc.narg('name')::text, ''), name),
+  position = coalesce(sqlc.narg('position'), position),
+  color = coalesce(nullif(sqlc.narg('color')::text, ''), color),
+  is_terminal = coalesce(sqlc.narg('is_terminal'), is_terminal),
+  outcome = coalesce(sqlc.narg('outcome'), outcome)
+WHERE id = $1 AND user_id = $2
+RETURNING id, name, position, color, is_terminal, outcome;
+
+-- name: DeleteStage :one
+DELETE FROM stages WHERE id = $1 AND user_id = $2
+RETURNING id, name, position, color, is_terminal, outcome;
 
 -- name: GetJobApplication :one
-SELECT id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, notes FROM job_applications WHERE id = $1 AND user_id = $2;
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes, j.version
+FROM job_applications AS j
+JOIN stages AS s ON s.id = j.stage_id
+WHERE j.id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL;
+
+-- name: LockJobApplication :one
+SELECT version FROM job_applications WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL FOR UPDATE;
 
 -- name: CreateJobApplication :one
-INSERT INTO job_applications (user_id, company_name, job_title, date_applied, status, min_salary, max_salary, job_posting_url, notes)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-RETURNING id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, notes;
+WITH new_company AS (
+  INSERT INTO companies (user_id, name)
+  SELECT @user_id::uuid, @company_name::text
+  WHERE sqlc.narg('company_id')::uuid IS NULL
+  ON CONFLICT ON CONSTRAINT unique_company_name DO UPDATE SET name = companies.name
+  RETURNING id, name
+),
+company AS (
+  SELECT id, name FROM new_company
+  UNION ALL
+  SELECT id, name FROM companies WHERE id = sqlc.narg('company_id')::uuid AND user_id = @user_id::uuid
+),
+new_job_application AS (
+  INSERT INTO job_applications (user_id, company_id, company_name, job_title, date_applied, stage_id, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, job_posting_url, notes)
+  VALUES (
+    @user_id::uuid,
+    coalesce((SELECT id FROM company), sqlc.narg('company_id')::uuid),
+    coalesce((SELECT name FROM company), @company_name::text),
+    @job_title::text,
+    @date_applied::date,
+    coalesce(sqlc.narg('stage_id')::uuid, (SELECT id FROM stages WHERE user_id = @user_id::uuid ORDER BY position, created_at LIMIT 1)),
+    sqlc.narg('min_salary')::numeric,
+    sqlc.narg('max_salary')::numeric,
+    -- NOTE: The same defaults as the columns have
+    coalesce(nullif(sqlc.narg('salary_currency')::text, ''), 'PLN'),
+    coalesce(sqlc.narg('salary_period')::salary_period, 'MONTH'),
+    coalesce(sqlc.narg('salary_type')::salary_type, 'GROSS'),
+    sqlc.narg('contract_type')::contract_type,
+    sqlc.narg('job_posting_url')::text,
+    sqlc.narg('notes')::text
+  )
+  RETURNING id, company_id, company_name, job_title, date_applied, stage_id, is_replied, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, job_posting_url, notes
+)
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes
+FROM new_job_application AS j
+JOIN stages AS s ON s.id = j.stage_id;
 
 -- name: UpdateJobApplication :one
-UPDATE job_applications
-SET 
-  company_name = coalesce(nullif(sqlc.narg('company_name'), ''), company_name),
-  job_title = coalesce(nullif(sqlc.narg('job_title'), ''), job_title),
-  date_applied = coalesce(sqlc.narg('date_applied'), date_applied),
-  status = coalesce(sqlc.narg('status'), status),
-  is_replied = coalesce(sqlc.narg('is_replied'), is_replied),
-  min_salary = coalesce(sqlc.narg('min_salary'), min_salary),
-  max_salary = coalesce(sqlc.narg('max_salary'), max_salary),
-  job_posting_url = coalesce(nullif(sqlc.narg('job_posting_url'), ''), job_posting_url),
-  notes = coalesce(nullif(sqlc.narg('notes'), ''), notes)
-WHERE id = $1 AND user_id = $2
-RETURNING id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, notes;
+WITH new_company AS (
+  INSERT INTO companies (user_id, name)
+  SELECT @user_id::uuid, sqlc.narg('company_name')::text
+  WHERE nullif(sqlc.narg('company_name')::text, '') IS NOT NULL AND sqlc.narg('company_id')::uuid IS NULL
+  ON CONFLICT ON CONSTRAINT unique_company_name DO UPDATE SET name = companies.name
+  RETURNING id, name
+),
+company AS (
+  SELECT id, name FROM new_company
+  UNION ALL
+  SELECT id, name FROM companies WHERE id = sqlc.narg('company_id')::uuid AND user_id = @user_id::uuid
+),
+updated_job_application AS (
+  UPDATE job_applications
+  SET 
+    company_id = coalesce((SELECT id FROM company), sqlc.narg('company_id')::uuid, company_id),
+    company_name = coalesce((SELECT name FROM company), company_name),
+    job_title = coalesce(nullif(sqlc.narg('job_title')::text, ''), job_title),
+    date_applied = coalesce(sqlc.narg('date_applied')::date, date_applied),
+    stage_id = coalesce(sqlc.narg('stage_id')::uuid, stage_id),
+    is_replied = coalesce(sqlc.narg('is_replied')::boolean, is_replied),
+    min_salary = CASE WHEN @clear_min_salary::boolean THEN NULL ELSE coalesce(sqlc.narg('min_salary')::numeric, min_salary) END,
+    max_salary = CASE WHEN @clear_max_salary::boolean THEN NULL ELSE coalesce(sqlc.narg('max_salary')::numeric, max_salary) END,
+    salary_currency = coalesce(nullif(sqlc.narg('salary_currency')::text, ''), salary_currency),
+    salary_period = coalesce(sqlc.narg('salary_period')::salary_period, salary_period),
+    salary_type = coalesce(sqlc.narg('salary_type')::salary_type, salary_type),
+    contract_type = CASE WHEN @clear_contract_type::boolean THEN NULL ELSE coalesce(sqlc.narg('contract_type')::contract_type, contract_type) END,
+    job_posting_url = CASE WHEN @clear_job_posting_url::boolean THEN NULL ELSE coalesce(nullif(sqlc.narg('job_posting_url')::text, ''), job_posting_url) END,
+    notes = CASE WHEN @clear_notes::boolean THEN NULL ELSE coalesce(nullif(sqlc.narg('notes')::text, ''), notes) END
+  WHERE id = @id::uuid AND user_id = @user_id::uuid AND deleted_at IS NULL
+  RETURNING id, company_id, company_name, job_title, date_applied, stage_id, is_replied, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, job_posting_url, notes, version
+)
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes, j.version
+FROM updated_job_application AS j
+JOIN stages AS s ON s.id = j.stage_id;
 
 -- name: DeleteJobApplication :one
-DELETE FROM job_applications WHERE id = $1 AND user_id = $2
-RETURNING id, company_name, job_title, date_applied, status, is_replied, min_salary, max_salary, job_posting_url, notes;
+WITH deleted_job_application AS (
+  UPDATE job_applications SET deleted_at = NOW()
+  WHERE id = @id::uuid AND user_id = @user_id::uuid AND deleted_at IS NULL
+  RETURNING id, company_id, company_name, job_title, date_applied, stage_id, is_replied, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, job_posting_url, notes, deleted_at
+)
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes, j.deleted_at
+FROM deleted_job_application AS j
+JOIN stages AS s ON s.id = j.stage_id;
+
+-- name: GetTrashedJobApplications :many
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes, j.deleted_at
+FROM job_applications AS j
+JOIN stages AS s ON s.id = j.stage_id
+WHERE j.user_id = $1 AND j.deleted_at IS NOT NULL
+ORDER BY j.deleted_at DESC, j.id;
+
+-- name: RestoreJobApplication :one
+WITH restored_job_application AS (
+  UPDATE job_applications SET deleted_at = NULL
+  WHERE id = @id::uuid AND user_id = @user_id::uuid AND deleted_at IS NOT NULL
+  RETURNING id, company_id, company_name, job_title, date_applied, stage_id, is_replied, min_salary, max_salary, salary_currency, salary_period, salary_type, contract_type, job_posting_url, notes
+)
+SELECT
+  j.id, j.company_id, j.company_name, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome,
+  j.is_replied, j.min_salary, j.max_salary, j.salary_currency, j.salary_period, j.salary_type, j.contract_type, j.job_posting_url, j.notes
+FROM restored_job_application AS j
+JOIN stages AS s ON s.id = j.stage_id;
+
+-- name: PurgeJobApplications :many
+WITH purged_job_applications AS (
+  DELETE FROM job_applications WHERE deleted_at <= @deleted_before::timestamptz
+  RETURNING id
+)
+-- NOTE: This statement still sees the attachments removed by the cascade, so their storage keys can be returned
+SELECT a.storage_key
+FROM attachments AS a
+JOIN purged_job_applications AS p ON p.id = a.job_application_id;
+
+-- name: GetJobApplicationEvents :many
+SELECT e.id, e.field, e.old_value, e.new_value, s.name AS stage_name, e.created_at
+FROM job_application_events AS e
+JOIN job_applications AS j ON j.id = e.job_application_id
+LEFT JOIN stages AS s ON e.field = 'stage' AND s.id::text = e.new_value
+WHERE e.job_application_id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL
+ORDER BY e.created_at, e.id;
+
+-- name: GetInterviews :many
+SELECT i.id, i.scheduled_at, i.timezone, i.duration, i.type, i.location, i.meeting_url, i.interviewers, i.preparation_notes, i.outcome
+FROM interviews AS i
+JOIN job_applications AS j ON j.id = i.job_application_id
+WHERE i.job_application_id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL
+ORDER BY i.scheduled_at;
+
+-- name: GetInterview :one
+SELECT i.id, i.scheduled_at, i.timezone, i.duration, i.type, i.location, i.meeting_url, i.interviewers, i.preparation_notes, i.outcome
+FROM interviews AS i
+JOIN job_applications AS j ON j.id = i.job_application_id
+WHERE i.id = $1 AND i.job_application_id = $2 AND j.user_id = $3 AND j.deleted_at IS NULL;
+
+-- name: CreateInterview :one
+INSERT INTO interviews (job_application_id, scheduled_at, timezone, duration, type, location, meeting_url, interviewers, preparation_notes, outcome)
+SELECT
+  j.id,
+  @scheduled_at::timestamptz,
+  @timezone::text,
+  sqlc.narg('duration')::integer,
+  @type::interview_type,
+  sqlc.narg('location')::text,
+  sqlc.narg('meeting_url')::text,
+  @interviewers::text[],
+  sqlc.narg('preparation_notes')::text,
+  @outcome::interview_outcome
+FROM job_applications AS j
+WHERE j.id = @job_application_id AND j.user_id = @user_id AND j.deleted_at IS NULL
+RETURNING id, scheduled_at, timezone, duration, type, location, meeting_url, interviewers, preparation_notes, outcome;
+
+-- name: UpdateInterview :one
+UPDATE interviews AS i
+SET
+  scheduled_at = coalesce(sqlc.narg('scheduled_at'), i.scheduled_at),
+  timezone = coalesce(nullif(sqlc.narg('timezone')::text, ''), i.timezone),
+  duration = coalesce(sqlc.narg('duration'), i.duration),
+  type = coalesce(sqlc.narg('type'), i.type),
+  location = coalesce(nullif(sqlc.narg('location')::text, ''), i.location),
+  meeting_url = coalesce(nullif(sqlc.narg('meeting_url')::text, ''), i.meeting_url),
+  interviewers = coalesce(sqlc.narg('interviewers'), i.interviewers),
+  preparation_notes = coalesce(nullif(sqlc.narg('preparation_notes')::text, ''), i.preparation_notes),
+  outcome = coalesce(sqlc.narg('outcome'), i.outcome)
+FROM job_applications AS j
+WHERE i.id = @id AND i.job_application_id = @job_application_id AND j.id = i.job_application_id AND j.user_id = @user_id AND j.deleted_at IS NULL
+RETURNING i.id, i.scheduled_at, i.timezone, i.duration, i.type, i.location, i.meeting_url, i.interviewers, i.preparation_notes, i.outcome;
+
+-- name: DeleteInterview :one
+DELETE FROM interviews AS i
+USING job_applications AS j
+WHERE i.id = $1 AND i.job_application_id = $2 AND j.id = i.job_application_id AND j.user_id = $3 AND j.deleted_at IS NULL
+RETURNING i.id, i.scheduled_at, i.timezone, i.duration, i.type, i.location, i.meeting_url, i.interviewers, i.preparation_notes, i.outcome;
+
+-- name: GetUpcomingInterviews :many
+SELECT
+  i.id, i.job_application_id, j.company_name, j.job_title,
+  i.scheduled_at, i.timezone, i.duration, i.type, i.location, i.meeting_url, i.interviewers, i.preparation_notes, i.outcome
+FROM interviews AS i
+JOIN job_applications AS j ON j.id = i.job_application_id
+WHERE
+  j.user_id = @user_id
+  AND j.deleted_at IS NULL
+  AND i.scheduled_at >= @scheduled_from::timestamptz
+  AND (i.scheduled_at < sqlc.narg('scheduled_to')::timestamptz OR sqlc.narg('scheduled_to')::timestamptz IS NULL)
+ORDER BY i.scheduled_at;
+
+-- name: GetContacts :many
+SELECT id, name, email, phone, linkedin_url, company, role, COUNT(*) OVER() AS total
+FROM contacts
+WHERE
+  user_id = $3
+  AND (
+    @search::text IS NULL
+    OR name ILIKE '%' || @search::text || '%'
+    OR email ILIKE '%' || @search::text || '%'
+    OR company ILIKE '%' || @search::text || '%'
+    OR role ILIKE '%' || @search::text || '%'
+  )
+ORDER BY name, created_at
+LIMIT $1 OFFSET $2;
+
+-- name: GetContact :one
+SELECT id, name, email, phone, linkedin_url, company, role, notes FROM contacts WHERE id = $1 AND user_id = $2;
+
+-- name: CreateContact :one
+INSERT INTO contacts (user_id, name, email, phone, linkedin_url, company, role, notes)
+VALUES (@user_id, @name, sqlc.narg('email'), sqlc.narg('phone'), sqlc.narg('linkedin_url'), sqlc.narg('company'), sqlc.narg('role'), sqlc.narg('notes'))
+RETURNING id, name, email, phone, linkedin_url, company, role, notes;
+
+-- name: UpdateContact :one
+UPDATE contacts
+SET
+  name = coalesce(nullif(sqlc.narg('name')::text, ''), name),
+  email = coalesce(nullif(sqlc.narg('email')::text, ''), email),
+  phone = coalesce(nullif(sqlc.narg('phone')::text, ''), phone),
+  linkedin_url = coalesce(nullif(sqlc.narg('linkedin_url')::text, ''), linkedin_url),
+  company = coalesce(nullif(sqlc.narg('company')::text, ''), company),
+  role = coalesce(nullif(sqlc.narg('role')::text, ''), role),
+  notes = coalesce(nullif(sqlc.narg('notes')::text, ''), notes)
+WHERE id = @id AND user_id = @user_id
+RETURNING id, name, email, phone, linkedin_url, company, role, notes;
+
+-- name: DeleteContact :one
+DELETE FROM contacts WHERE id = $1 AND user_id = $2
+RETURNING id, name, email, phone, linkedin_url, company, role, notes;
+
+-- name: GetContactJobApplications :many
+SELECT j.id, j.company_name, j.job_title, j.date_applied
+FROM job_application_contacts AS jc
+JOIN job_applications AS j ON j.id = jc.job_application_id
+WHERE jc.contact_id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL
+ORDER BY j.date_applied DESC;
+
+-- name: GetJobApplicationContacts :many
+SELECT c.id, c.name, c.email, c.phone, c.linkedin_url, c.company, c.role
+FROM job_application_contacts AS jc
+JOIN contacts AS c ON c.id = jc.contact_id
+WHERE jc.job_application_id = $1 AND c.user_id = $2
+ORDER BY c.name, jc.created_at;
+
+-- name: LinkJobApplicationContact :execrows
+INSERT INTO job_application_contacts (job_application_id, contact_id)
+SELECT j.id, c.id
+FROM job_applications AS j, contacts AS c
+WHERE j.id = @job_application_id AND j.user_id = @user_id AND j.deleted_at IS NULL AND c.id = @contact_id AND c.user_id = @user_id
+ON CONFLICT (job_application_id, contact_id) DO NOTHING;
+
+-- name: UnlinkJobApplicationContact :one
+DELETE FROM job_application_contacts AS jc
+USING job_applications AS j
+WHERE jc.job_application_id = $1 AND jc.contact_id = $2 AND j.id = jc.job_application_id AND j.user_id = $3 AND j.deleted_at IS NULL
+RETURNING jc.contact_id;
+
+-- name: GetCompanies :many
+SELECT c.id, c.name, c.website, c.industry, c.size, c.location, count(j.id) AS job_application_count, COUNT(*) OVER() AS total
+FROM companies AS c
+LEFT JOIN job_applications AS j ON j.company_id = c.id AND j.deleted_at IS NULL
+WHERE
+  c.user_id = $3
+  -- NOTE: A search normalising to nothing, e.g. punctuation alone, is only matched against the name, rather than against every company
+  AND (
+    c.name ILIKE '%' || @search::text || '%'
+    OR (normalize_company_name(@search::text) <> '' AND c.normalized_name LIKE '%' || normalize_company_name(@search::text) || '%')
+  )
+GROUP BY c.id
+ORDER BY c.name, c.created_at
+LIMIT $1 OFFSET $2;
+
+-- name: GetCompany :one
+SELECT id, name, website, industry, size, location, notes FROM companies WHERE id = $1 AND user_id = $2;
+
+-- name: GetCompanyJobApplications :many
+SELECT
+  j.id, j.job_title, j.date_applied,
+  j.stage_id, s.name AS stage_name, s.color AS stage_color, s.is_terminal AS stage_is_terminal, s.outcome AS stage_outcome
+FROM job_applications AS j
+JOIN stages AS s ON s.id = j.stage_id
+WHERE j.company_id = $1 AND j.user_id = $2 AND j.deleted_at IS NULL
+ORDER BY j.date_applied DESC;
+
+-- name: GetCompaniesByIds :many
+SELECT id FROM companies WHERE user_id = $1 AND id = ANY(@ids::uuid[]);
+
+-- name: CreateCompany :one
+INSERT INTO companies (user_id, name, website, industry, size, location, notes)
+VALUES (@user_id, @name, sqlc.narg('website'), sqlc.narg('industry'), sqlc.narg('size'), sqlc.narg('location'), sqlc.narg('notes'))
+RETURNING id, name, website, industry, size, location, notes;
+
+-- name: UpdateCompany :one
+UPDATE companies
+SET
+  name = coalesce(nullif(sqlc.narg('name')::text, ''), name),
+  website = coalesce(nullif(sqlc.narg('website')::text, ''), website),
+  industry = coalesce(nullif(sqlc.narg('industry')::text, ''), industry),
+  size = coalesce(nullif(sqlc.narg('size')::text, ''), size),
+  location = coalesce(nullif(sqlc.narg('location')::text, ''), location),
+  notes = coalesce(nullif(sqlc.narg('notes')::text, ''), notes)
+WHERE id = @id AND user_id = @user_id
+RETURNING id, name, website, industry, size, location, notes;
+
+-- name: DeleteCompany :one
+DELETE FROM companies WHERE id = $1 AND user_id = $2
+RETURNING id, name, website, industry, size, location, notes;
+
+-- name: MergeCompanies :one
+WITH target AS (
+  SELECT id, name FROM companies WHERE id = @id::uuid AND user_id = @user_id::uuid
+),
+sources AS (
+  SELECT c.id, c.website, c.industry, c.size, c.location, c.notes
+  FROM companies AS c, target AS t
+  WHERE c.user_id = @user_id::uuid AND c.id = ANY(@source_ids::uuid[]) AND c.id <> t.id
+),
+-- NOTE: Trashed applications are moved too, so that they still point at an existing company once restored
+moved_job_applications AS (
+  UPDATE job_applications AS j
+  SET company_id = t.id, company_name = t.name
+  FROM target AS t
+  WHERE j.user_id = @user_id::uuid AND j.company_id IN (SELECT id FROM sources)
+  RETURNING j.id
+),
+merged_company AS (
+  UPDATE companies AS c
+  SET
+    website = coalesce(c.website, (SELECT website FROM sources WHERE website IS NOT NULL LIMIT 1)),
+    industry = coalesce(c.industry, (SELECT industry FROM sources WHERE industry IS NOT NULL LIMIT 1)),
+    size = coalesce(c.size, (SELECT size FROM sources WHERE size IS NOT NULL LIMIT 1)),
+    location = coalesce(c.location, (SELECT location FROM sources WHERE location IS NOT NULL LIMIT 1)),
+    notes = coalesce(c.notes, (SELECT notes FROM sources WHERE notes IS NOT NULL LIMIT 1))
+  FROM target AS t
+  WHERE c.id = t.id
+  RETURNING c.id
+),
+deleted_companies AS (
+  DELETE FROM companies WHERE id IN (SELECT id FROM sources)
+  RETURNING id
+)
+SELECT
+  (SELECT count(*) FROM merged_company) AS merged,
+  (SELECT count(*) FROM deleted_companies) AS deleted,
+  (SELECT count(*) FROM moved_job_applications) AS moved;
+
+-- name: GetTags :many
+SELECT id, name, color FROM tags WHERE user_id = $1 ORDER BY name;
+
+-- name: GetTag :one
+SELECT id, name, color FROM tags WHERE id = $1 AND user_id = $2;
+
+-- name: CreateTag :one
+INSERT INTO tags (user_id, name, color)
+VALUES (@user_id, @name, coalesce(nullif(sqlc.narg('color')::text, ''), '#64748b'))
+RETURNING id, name, color;
+
+-- name: UpdateTag :one
+UPDATE tags
+SET
+  name = coalesce(nullif(sqlc.narg('name')::text, ''), name),
+  color = coalesce(nullif(sqlc.narg('color')::text, ''), color)
+WHERE id = @id AND user_id = @user_id
+RETURNING id, name, color;
+
+-- name: DeleteTag :one
+DELETE FROM tags WHERE id = $1 AND user_id = $2
+RETURNING id, name, color;
+
+-- name: GetJobApplicationTags :many
+SELECT jt.job_application_id, t.id, t.name, t.color
+FROM job_application_tags AS jt
+JOIN tags AS t ON t.id = jt.tag_id
+WHERE jt.job_application_id = ANY(@job_application_ids::uuid[]) AND t.user_id = @user_id
+ORDER BY t.name;
+
+-- name: TagJobApplication :execrows
+INSERT INTO job_application_tags (job_application_id, tag_id)
+SELECT j.id, t.id
+FROM job_applications AS j, tags AS t
+WHERE j.id = @job_application_id AND j.user_id = @user_id AND j.deleted_at IS NULL AND t.id = @tag_id AND t.user_id = @user_id
+ON CONFLICT (job_application_id, tag_id) DO NOTHING;
+
+-- name: UntagJobApplication :one
+DELETE FROM job_application_tags AS jt
+USING job_applications AS j
+WHERE jt.job_application_id = $1 AND jt.tag_id = $2 AND j.id = jt.job_application_id AND j.user_id = $3 AND j.deleted_at IS NULL
+RETURNING jt.tag_id;
+
+-- name: GetJobApplicationStats :many
+WITH filtered_job_applications AS (
+  SELECT
+    j.id, j.date_applied, j.is_replied, s.outcome AS stage_outcome,
+    -- NOTE: Midpoint of the salary range, or whichever end of it was given, in the requested currency and period,
+    -- left out for a salary of another type than the requested one, if any
+    CASE WHEN sqlc.narg('salary_type')::salary_type IS NULL OR j.salary_type = sqlc.narg('salary_type')::salary_type THEN
+      normalize_salary(
+        CASE WHEN j.min_salary IS NOT NULL AND j.max_salary IS NOT NULL THEN (j.min_salary + j.max_salary) / 2 ELSE coalesce(j.min_salary, j.max_salary) END,
+        j.salary_currency, j.salary_period, @salary_currency::text, @salary_period::salary_period
+      )
+    END::float8 AS salary,
+    CASE @group_by::text WHEN 'company' THEN j.company_id::text WHEN 'job_title' THEN lower(j.job_title) END AS group_key,
+    CASE @group_by::text WHEN 'company' THEN j.company_name WHEN 'job_title' THEN j.job_title END AS group_name
+  FROM job_applications AS j
+  JOIN stages AS s ON s.id = j.stage_id
+  WHERE
+    j.user_id = @user_id
+    AND j.deleted_at IS NULL
+    AND (j.date_applied >= sqlc.narg('from')::date OR sqlc.narg('from')::date IS NULL)
+    AND (j.date_applied <= sqlc.narg('to')::date OR sqlc.narg('to')::date IS NULL)
+),
+-- NOTE: The first time an application got a reply, or moved to a stage that is terminal or has an outcome
+first_responses AS (
+  SELECT e.job_application_id, min(e.created_at) AS responded_at
+  FROM job_application_events AS e
+  JOIN filtered_job_applications AS f ON f.id = e.job_application_id
+  LEFT JOIN stages AS s ON s.id = CASE WHEN e.field = 'stage' THEN e.new_value::uuid END
+  WHERE (e.field = 'is_replied' AND e.new_value = 'true') OR s.is_terminal OR s.outcome <> 'NEUTRAL'
+  GROUP BY e.job_application_id
+)
+SELECT
+  (GROUPING(f.group_key) = 1)::bool AS is_overall,
+  coalesce(f.group_key, '')::text AS group_key,
+  coalesce(min(f.group_name), '')::text AS group_name,
+  count(*) AS total,
+  count(*) FILTER (WHERE f.is_replied) AS replied,
+  count(*) FILTER (WHERE f.stage_outcome = 'POSITIVE') AS accepted,
+  count(*) FILTER (WHERE f.stage_outcome = 'NEGATIVE') AS rejected,
+  coalesce((count(*) FILTER (WHERE f.is_replied))::float8 / nullif(count(*), 0), 0)::float8 AS reply_rate,
+  coalesce((count(*) FILTER (WHERE f.stage_outcome = 'POSITIVE'))::float8 / nullif(count(*), 0), 0)::float8 AS acceptance_rate,
+  -- NOTE: Zero whenever there's nothing to aggregate, which the counts tell apart
+  count(r.responded_at) AS responded,
+  -- NOTE: Counted from the start of the day applied, in the time zone of the user
+  coalesce(percentile_cont(0.5) WITHIN GROUP (ORDER BY greatest(extract(epoch FROM r.responded_at - (f.date_applied::timestamp AT TIME ZONE @timezone::text)), 0) / 86400), 0)::float8 AS median_days_to_response,
+  count(f.salary) AS salary_count,
+  coalesce(min(f.salary), 0)::float8 AS min_salary,
+  coalesce(percentile_cont(0.25) WITHIN GROUP (ORDER BY f.salary), 0)::float8 AS salary_p25,
+  coalesce(percentile_cont(0.5) WITHIN GROUP (ORDER BY f.salary), 0)::float8 AS median_salary,
+  coalesce(percentile_cont(0.75) WITHIN GROUP (ORDER BY f.salary), 0)::float8 AS salary_p75,
+  coalesce(max(f.salary), 0)::float8 AS max_salary
+FROM filtered_job_applications AS f
+LEFT JOIN first_responses AS r ON r.job_application_id = f.id
+GROUP BY GROUPING SETS ((), (f.group_key))
+-- NOTE: Without grouping every group key is NULL, which would just repeat the overall row
+HAVING GROUPING(f.group_key) = 1 OR @group_by::text <> ''
+ORDER BY is_overall DESC, total DESC, group_name;
+
+-- name: GetJobApplicationStageStats :many
+WITH filtered_job_applications AS (
+  SELECT
+    j.id, j.stage_id,
+    CASE @group_by::text WHEN 'company' THEN j.company_id::text WHEN 'job_title' THEN lower(j.job_title) END AS group_key
+  FROM job_applications AS j
+  WHERE
+    j.user_id = @user_id
+    AND j.deleted_at IS NULL
+    AND (j.date_applied >= sqlc.narg('from')::date OR sqlc.narg('from')::date IS NULL)
+    AND (j.date_applied <= sqlc.narg('to')::date OR sqlc.narg('to')::date IS NULL)
+),
+-- NOTE: Every stage an application has ever been in, according to its history, plus the current one
+reached_stages AS (
+  SELECT f.id, f.group_key, e.new_value::uuid AS stage_id, false AS is_current
+  FROM filtered_job_applications AS f
+  JOIN job_application_events AS e ON e.job_application_id = f.id AND e.field = 'stage'
+  UNION ALL
+  SELECT f.id, f.group_key, f.stage_id, true AS is_current
+  FROM filtered_job_applications AS f
+)
+SELECT
+  (GROUPING(group_key) = 1)::bool AS is_overall,
+  coalesce(group_key, '')::text AS group_key,
+  stage_id,
+  count(DISTINCT id) FILTER (WHERE is_current) AS current,
+  count(DISTINCT id) AS reached
+FROM reached_stages
+GROUP BY GROUPING SETS ((stage_id), (group_key, stage_id))
+HAVING GROUPING(group_key) = 1 OR @group_by::text <> '';
+
+-- name: GetJobApplicationWeeklyStats :many
+WITH filtered_job_applications AS (
+  SELECT
+    week_start_date(j.date_applied, @week_start::week_start) AS week,
+    CASE @group_by::text WHEN 'company' THEN j.company_id::text WHEN 'job_title' THEN lower(j.job_title) END AS group_key
+  FROM job_applications AS j
+  WHERE
+    j.user_id = @user_id
+    AND j.deleted_at IS NULL
+    AND (j.date_applied >= sqlc.narg('from')::date OR sqlc.narg('from')::date IS NULL)
+    AND (j.date_applied <= sqlc.narg('to')::date OR sqlc.narg('to')::date IS NULL)
+)
+SELECT
+  (GROUPING(group_key) = 1)::bool AS is_overall,
+  coalesce(group_key, '')::text AS group_key,
+  week,
+  count(*) AS count
+FROM filtered_job_applications
+GROUP BY GROUPING SETS ((week), (group_key, week))
+HAVING GROUPING(group_key) = 1 OR @group_by::text <> ''
+ORDER BY week;
+
+-- name: GetViews :many
+SELECT id, name, query, is_default FROM views WHERE user_id = $1 ORDER BY is_default DESC, name;
+
+-- name: GetView :one
+SELECT id, name, query, is_default FROM views WHERE id = $1 AND user_id = $2;
+
+-- name: GetDefaultView :one
+SELECT id, name, query, is_default FROM views WHERE user_id = $1 AND is_default;
+
+-- name: ClearDefaultView :exec
+UPDATE views SET is_default = false WHERE user_id = $1 AND is_default;
+
+-- name: CreateView :one
+INSERT INTO views (user_id, name, query, is_default)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, query, is_default;
+
+-- name: UpdateView :one
+UPDATE views
+SET
+  name = coalesce(nullif(sqlc.narg('name')::text, ''), name),
+  query = coalesce(sqlc.narg('query')::text, query),
+  is_default = coalesce(sqlc.narg('is_default')::bool, is_default)
+WHERE id = @id AND user_id = @user_id
+RETURNING id, name, query, is_default;
+
+-- name: DeleteView :one
+DELETE FROM views WHERE id = $1 AND user_id = $2
+RETURNING id, name, query, is_default;
+
+-- name: GetReminders :many
+SELECT r.id, r.job_application_id, j.company_name, j.job_title, r.remind_at, r.note, r.unless_replied, r.is_suggested, r.status, r.delivered_at
+FROM reminders AS r
+JOIN job_applications AS j ON j.id = r.job_application_id
+WHERE
+  j.user_id = @user_id
+  AND j.deleted_at IS NULL
+  AND (r.status = sqlc.narg('status')::reminder_status OR sqlc.narg('status')::reminder_status IS NULL)
+  AND (r.job_application_id = sqlc.narg('job_application_id')::uuid OR sqlc.narg('job_application_id')::uuid IS NULL)
+ORDER BY r.remind_at, r.id;
+
+-- name: CreateReminder :one
+INSERT INTO reminders (job_application_id, remind_at, note, unless_replied)
+SELECT j.id, @remind_at::timestamptz, sqlc.narg('note')::text, @unless_replied::boolean
+FROM job_applications AS j
+WHERE j.id = @job_application_id AND j.user_id = @user_id AND j.deleted_at IS NULL
+RETURNING id, job_application_id, remind_at, note, unless_replied, is_suggested, status, delivered_at;
+
+-- name: SnoozeReminder :one
+UPDATE reminders AS r
+SET remind_at = @remind_at::timestamptz, status = 'PENDING', delivered_at = NULL
+FROM job_applications AS j
+WHERE r.id = @id AND j.id = r.job_application_id AND j.user_id = @user_id AND j.deleted_at IS NULL
+RETURNING r.id, r.job_application_id, r.remind_at, r.note, r.unless_replied, r.is_suggested, r.status, r.delivered_at;
+
+-- name: DismissReminder :one
+UPDATE reminders AS r
+SET status = 'DISMISSED'
+FROM job_applications AS j
+WHERE r.id = @id AND j.id = r.job_application_id AND j.user_id = @user_id AND j.deleted_at IS NULL
+RETURNING r.id, r.job_application_id, r.remind_at, r.note, r.unless_replied, r.is_suggested, r.status, r.delivered_at;
+
+-- name: SuggestFollowUpReminders :execrows
+INSERT INTO reminders (job_application_id, remind_at, unless_replied, is_suggested)
+SELECT j.id, NOW(), true, true
+FROM job_applications AS j
+JOIN stages AS s ON s.id = j.stage_id
+JOIN users AS u ON u.id = j.user_id
+WHERE
+  j.deleted_at IS NULL
+  AND NOT j.is_replied
+  AND NOT s.is_terminal
+  -- NOTE: Days are counted from today in the time zone of the user
+  AND j.date_applied <= (NOW() AT TIME ZONE u.timezone)::date - sqlc.arg('after_days')::integer
+  -- NOTE: Applications long past the threshold, e.g. imported ones, are left alone, so that turning suggestions on doesn't flood anyone's inbox
+  AND j.date_applied > (NOW() AT TIME ZONE u.timezone)::date - 2 * sqlc.arg('after_days')::integer
+ON CONFLICT (job_application_id) WHERE is_suggested DO NOTHING;
+
+-- name: ClaimDueReminders :many
+SELECT
+  r.id, r.remind_at, r.note, r.unless_replied, r.is_suggested,
+  j.id AS job_application_id, j.company_name, j.job_title, j.date_applied, j.is_replied,
+  u.email, u.first_name, u.locale
+FROM reminders AS r
+JOIN job_applications AS j ON j.id = r.job_application_id
+JOIN users AS u ON u.id = j.user_id
+WHERE r.status = 'PENDING' AND r.remind_at <= NOW() AND j.deleted_at IS NULL
+ORDER BY r.remind_at
+LIMIT $1
+FOR UPDATE OF r SKIP LOCKED;
+
+-- name: MarkReminderDelivered :exec
+UPDATE reminders SET status = 'DELIVERED', delivered_at = NOW() WHERE id = $1;
+
+-- name: MarkReminderDismissed :exec
+UPDATE reminders SET status = 'DISMISSED' WHERE id = $1;
+
+-- name: GetAttachments :many
+SELECT a.id, a.job_application_id, j.company_name, j.job_title, a.kind, a.filename, a.content_type, a.size, a.checksum, a.created_at
+FROM attachments AS a
+JOIN job_applications AS j ON j.id = a.job_application_id
+WHERE
+  j.user_id = @user_id
+  AND j.deleted_at IS NULL
+  AND (a.job_application_id = sqlc.narg('job_application_id')::uuid OR sqlc.narg('job_application_id')::uuid IS NULL)
+  AND (a.kind = sqlc.narg('kind')::attachment_kind OR sqlc.narg('kind')::attachment_kind IS NULL)
+  AND (a.checksum = sqlc.narg('checksum')::text OR sqlc.narg('checksum')::text IS NULL)
+ORDER BY a.created_at DESC, a.id;
+
+-- name: GetAttachment :one
+SELECT a.id, a.job_application_id, a.kind, a.filename, a.content_type, a.size, a.checksum, a.storage_key, a.created_at
+FROM attachments AS a
+JOIN job_applications AS j ON j.id = a.job_application_id
+WHERE a.id = $1 AND a.job_application_id = $2 AND j.user_id = $3 AND j.deleted_at IS NULL;
+
+-- name: LockUser :exec
+SELECT id FROM users WHERE id = $1 FOR UPDATE;
+
+-- name: GetAttachmentsUsage :one
+SELECT coalesce(sum(a.size), 0)::bigint AS usage
+FROM attachments AS a
+JOIN job_applications AS j ON j.id = a.job_application_id
+-- NOTE: Attachments of trashed applications still take up space until they are purged
+WHERE j.user_id = $1;
+
+-- name: CreateAttachment :one
+INSERT INTO attachments (job_application_id, kind, filename, content_type, size, checksum, storage_key)
+SELECT j.id, @kind::attachment_kind, @filename::text, @content_type::text, @size::bigint, @checksum::text, @storage_key::text
+FROM job_applications AS j
+WHERE j.id = @job_application_id AND j.user_id = @user_id AND j.deleted_at IS NULL
+RETURNING id, job_application_id, kind, filename, content_type, size, checksum, created_at;
+
+-- name: DeleteAttachment :one
+DELETE FROM attachments AS a
+USING job_applications AS j
+WHERE a.id = $1 AND a.job_application_id = $2 AND j.id = a.job_application_id AND j.user_id = $3 AND j.deleted_at IS NULL
+RETURNING a.id, a.job_application_id, a.kind, a.filename, a.content_type, a.size, a.checksum, a.storage_key, a.created_at;
+
+
+-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM idempotency_keys WHERE expires_at <= NOW();
+
+-- name: CreateIdempotencyKey :execrows
+-- NOTE: An expired key is taken over, since the sweep deleting it may not have run yet
+INSERT INTO idempotency_keys (user_id, key, request_hash, expires_at)
+VALUES (sqlc.narg('user_id')::uuid, @key::text, @request_hash::text, @expires_at::timestamptz)
+ON CONFLICT ON CONSTRAINT unique_idempotency_key DO UPDATE
+SET request_hash = EXCLUDED.request_hash, status = NULL, response = NULL, created_at = NOW(), expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW();
+
+-- name: GetIdempotencyKey :one
+SELECT request_hash, status, response
+FROM idempotency_keys
+WHERE user_id IS NOT DISTINCT FROM sqlc.narg('user_id')::uuid AND key = @key::text;
+
+-- name: SaveIdempotencyKeyResponse :exec
+UPDATE idempotency_keys
+SET status = @status::integer, response = @response::bytea
+WHERE user_id IS NOT DISTINCT FROM sqlc.narg('user_id')::uuid AND key = @key::text;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE user_id IS NOT DISTINCT FROM sqlc.narg('user_id')::uuid AND key = @key::text;
+
+-- name: GetExchangeRate :one
+SELECT currency, rate, updated_at FROM exchange_rates WHERE currency = $1;
+
+-- name: UpsertExchangeRate :exec
+INSERT INTO exchange_rates (currency, rate) VALUES (@currency::text, @rate::numeric)
+ON CONFLICT (currency) DO UPDATE SET rate = EXCLUDED.rate, updated_at = NOW();
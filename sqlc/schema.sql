@@ -10,6 +10,8 @@ END;
 $$ LANGUAGE plpgsql;
 
 -- Users
+CREATE TYPE week_start AS ENUM ('MONDAY', 'SATURDAY', 'SUNDAY');
+
 CREATE TABLE users (
   id                UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
   first_name        TEXT NOT NULL,
@@ -17,6 +19,15 @@ CREATE TABLE users (
   email             TEXT NOT NULL UNIQUE,
   password          TEXT NOT NULL,
   is_email_verified BOOLEAN DEFAULT false,
+  totp_secret       TEXT,
+  is_totp_enabled   BOOLEAN NOT NULL DEFAULT false,
+  totp_last_step    BIGINT, -- NOTE: Time step of the last accepted TOTP code, codes at or before it are replays
+  mfa_attempts      INTEGER NOT NULL DEFAULT 0, -- NOTE: Codes tried since the last accepted one
+  mfa_attempts_since TIMESTAMPTZ,
+  timezone          TEXT NOT NULL DEFAULT 'Europe/Warsaw', -- NOTE: IANA time zone dates are compared in
+  locale            TEXT NOT NULL DEFAULT 'en-US', -- NOTE: BCP 47 language tag dates are formatted for
+  week_start        week_start NOT NULL DEFAULT 'MONDAY',
+  currency          TEXT NOT NULL DEFAULT 'PLN' CHECK (currency ~ '^[A-Z]{3}$'), -- NOTE: ISO 4217 code salaries default to
   created_at        TIMESTAMPTZ DEFAULT NOW(),
   updated_at        TIMESTAMPTZ DEFAULT NOW()
 );
@@ -26,6 +37,12 @@ BEFORE UPDATE ON users
 FOR EACH ROW
 EXECUTE FUNCTION set_updated_at_timestamp();
 
+-- NOTE: First day of the week the given day falls in
+CREATE OR REPLACE FUNCTION week_start_date(day DATE, start week_start)
+RETURNS DATE AS $$
+  SELECT day - (extract(isodow FROM day)::integer - CASE start WHEN 'MONDAY' THEN 1 WHEN 'SATURDAY' THEN 6 WHEN 'SUNDAY' THEN 7 END + 7) % 7;
+$$ LANGUAGE sql IMMUTABLE;
+
 -- Verification tokens
 CREATE TABLE verification_tokens (
   id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
@@ -56,26 +73,468 @@ BEFORE UPDATE ON password_reset_tokens
 FOR EACH ROW
 EXECUTE FUNCTION set_updated_at_timestamp();
 
+-- Stages
+CREATE TYPE stage_outcome AS ENUM ('NEUTRAL', 'POSITIVE', 'NEGATIVE');
+
+CREATE TABLE stages (
+  id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name        TEXT NOT NULL,
+  position    INTEGER NOT NULL DEFAULT 0,
+  color       TEXT NOT NULL DEFAULT '#64748b',
+  is_terminal BOOLEAN NOT NULL DEFAULT false,
+  outcome     stage_outcome NOT NULL DEFAULT 'NEUTRAL',
+  created_at  TIMESTAMPTZ DEFAULT NOW(),
+  updated_at  TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT unique_stage_name UNIQUE (user_id, name),
+  CONSTRAINT unique_stage_user UNIQUE (id, user_id)
+);
+
+CREATE TRIGGER set_stage_updated_at_timestamp
+BEFORE UPDATE ON stages
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
+CREATE OR REPLACE FUNCTION create_default_stages()
+RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO stages (user_id, name, position, color, is_terminal, outcome)
+  VALUES
+    (NEW.id, 'In progress', 0, '#0284c7', false, 'NEUTRAL'),
+    (NEW.id, 'Rejected', 1, '#dc2626', true, 'NEGATIVE'),
+    (NEW.id, 'Accepted', 2, '#16a34a', true, 'POSITIVE');
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER create_default_stages
+AFTER INSERT ON users
+FOR EACH ROW
+EXECUTE FUNCTION create_default_stages();
+
+-- Companies
+CREATE OR REPLACE FUNCTION normalize_company_name(name TEXT)
+RETURNS TEXT AS $$
+  -- NOTE: "Evil Corp", "evil corp." and "Evil Corp Inc." all normalise to "evil"
+  SELECT trim(regexp_replace(
+    trim(regexp_replace(lower(name), '[^[:alnum:]]+', ' ', 'g')),
+    '( (inc|incorporated|corp|corporation|co|company|llc|ltd|limited|plc|gmbh|ag|sa|bv|sp z o o))+$',
+    ''
+  ));
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE TABLE companies (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name            TEXT NOT NULL,
+  normalized_name TEXT GENERATED ALWAYS AS (normalize_company_name(name)) STORED,
+  website         TEXT,
+  industry        TEXT,
+  size            TEXT,
+  location        TEXT,
+  notes           TEXT,
+  created_at      TIMESTAMPTZ DEFAULT NOW(),
+  updated_at      TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT unique_company_name UNIQUE (user_id, normalized_name),
+  CONSTRAINT unique_company_user UNIQUE (id, user_id)
+);
+
+CREATE TRIGGER set_company_updated_at_timestamp
+BEFORE UPDATE ON companies
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
 -- Job applications
-CREATE TYPE status AS ENUM ('IN_PROGRESS', 'REJECTED', 'ACCEPTED');
+CREATE TYPE salary_period AS ENUM ('HOUR', 'MONTH', 'YEAR');
+
+CREATE TYPE salary_type AS ENUM ('GROSS', 'NET');
+
+CREATE TYPE contract_type AS ENUM ('UOP', 'B2B', 'UZ', 'UOD', 'OTHER');
 
 CREATE TABLE job_applications (
   id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
   user_id         UUID REFERENCES users(id) ON DELETE CASCADE,
-  company_name    TEXT NOT NULL,
+  company_id      UUID NOT NULL,
+  company_name    TEXT NOT NULL, -- NOTE: Denormalised copy of companies.name
   job_title       TEXT NOT NULL,
-  date_applied    TIMESTAMPTZ NOT NULL, -- TODO: Update to `DATE` type
-  status          status NOT NULL DEFAULT 'IN_PROGRESS',
+  date_applied    DATE NOT NULL,
+  stage_id        UUID NOT NULL,
   is_replied      BOOLEAN NOT NULL DEFAULT FALSE,
-  min_salary      DOUBLE PRECISION,
-  max_salary      DOUBLE PRECISION,
+  min_salary      NUMERIC(14, 2),
+  max_salary      NUMERIC(14, 2),
+  salary_currency TEXT NOT NULL DEFAULT 'PLN' CHECK (salary_currency ~ '^[A-Z]{3}$'), -- NOTE: ISO 4217 code
+  salary_period   salary_period NOT NULL DEFAULT 'MONTH',
+  salary_type     salary_type NOT NULL DEFAULT 'GROSS',
+  contract_type   contract_type,
   job_posting_url TEXT,
   notes           TEXT,
+  -- NOTE: The language agnostic `simple` config, since notes are written in whatever language the job ad was.
+  -- Punctuation in urls is turned into spaces, so that e.g. "glassbore" matches https://glassbore.com/jobs/swe420692137
+  search_vector   TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', company_name), 'A') ||
+    setweight(to_tsvector('simple', job_title), 'A') ||
+    setweight(to_tsvector('simple', coalesce(notes, '')), 'B') ||
+    setweight(to_tsvector('simple', regexp_replace(coalesce(job_posting_url, ''), '[^[:alnum:]]+', ' ', 'g')), 'C')
+  ) STORED,
+  deleted_at      TIMESTAMPTZ, -- NOTE: Set while the application is in the trash
+  version         INTEGER NOT NULL DEFAULT 1, -- NOTE: Incremented on every update, backs the ETag
   created_at      TIMESTAMPTZ DEFAULT NOW(),
-  updated_at      TIMESTAMPTZ DEFAULT NOW()
+  updated_at      TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT job_applications_stage_id_fkey FOREIGN KEY (stage_id, user_id) REFERENCES stages(id, user_id),
+  CONSTRAINT job_applications_company_id_fkey FOREIGN KEY (company_id, user_id) REFERENCES companies(id, user_id)
 );
 
+CREATE INDEX job_applications_stage_id_idx ON job_applications (stage_id);
+CREATE INDEX job_applications_company_id_idx ON job_applications (company_id);
+CREATE INDEX job_applications_search_vector_idx ON job_applications USING GIN (search_vector);
+CREATE INDEX job_applications_deleted_at_idx ON job_applications (deleted_at) WHERE deleted_at IS NOT NULL;
+
 CREATE TRIGGER set_job_application_updated_at_timestamp
 BEFORE UPDATE ON job_applications
 FOR EACH ROW
 EXECUTE FUNCTION set_updated_at_timestamp();
+
+CREATE OR REPLACE FUNCTION increment_version()
+RETURNS TRIGGER AS $$
+BEGIN
+  NEW.version = OLD.version + 1;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER increment_job_application_version
+BEFORE UPDATE ON job_applications
+FOR EACH ROW
+EXECUTE FUNCTION increment_version();
+
+-- Exchange rates
+CREATE TABLE exchange_rates (
+  currency   TEXT PRIMARY KEY CHECK (currency ~ '^[A-Z]{3}$'),
+  rate       NUMERIC NOT NULL CHECK (rate > 0), -- NOTE: Units of the currency per euro
+  updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- NOTE: Rough defaults, meant to be overridden with the EXCHANGE_RATES env var
+INSERT INTO exchange_rates (currency, rate) VALUES ('EUR', 1), ('PLN', 4.25), ('USD', 1.08), ('GBP', 0.85), ('CHF', 0.94);
+
+-- NOTE: Working hours in a salary period, assuming full-time work of 168 hours a month
+CREATE OR REPLACE FUNCTION salary_period_hours(period salary_period)
+RETURNS NUMERIC AS $$
+  SELECT CASE period WHEN 'HOUR' THEN 1 WHEN 'MONTH' THEN 168 WHEN 'YEAR' THEN 12 * 168 END;
+$$ LANGUAGE sql IMMUTABLE;
+
+-- NOTE: NULL when either currency has no exchange rate. Only the currency and period are converted, so gross and net amounts stay as they are and have to be told apart by the salary type.
+CREATE OR REPLACE FUNCTION normalize_salary(amount NUMERIC, from_currency TEXT, from_period salary_period, to_currency TEXT, to_period salary_period)
+RETURNS NUMERIC AS $$
+  SELECT round(
+    amount
+    * CASE WHEN from_currency = to_currency THEN 1 ELSE
+        (SELECT rate FROM exchange_rates WHERE currency = to_currency) / (SELECT rate FROM exchange_rates WHERE currency = from_currency)
+      END
+    * salary_period_hours(to_period) / salary_period_hours(from_period),
+    2
+  );
+$$ LANGUAGE sql STABLE;
+
+-- Sessions
+CREATE TABLE sessions (
+  id            UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id       UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  refresh_token TEXT NOT NULL UNIQUE DEFAULT encode(gen_random_bytes(32), 'hex'),
+  expires_at    TIMESTAMPTZ NOT NULL DEFAULT NOW() + INTERVAL '30 days',
+  revoked_at    TIMESTAMPTZ,
+  created_at    TIMESTAMPTZ DEFAULT NOW(),
+  updated_at    TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX sessions_user_id_idx ON sessions (user_id);
+
+CREATE TRIGGER set_session_updated_at_timestamp
+BEFORE UPDATE ON sessions
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
+-- Recovery codes
+CREATE TABLE recovery_codes (
+  id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  code_hash  TEXT NOT NULL,
+  used_at    TIMESTAMPTZ,
+  created_at TIMESTAMPTZ DEFAULT NOW(),
+  updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX recovery_codes_user_id_idx ON recovery_codes (user_id);
+
+CREATE TRIGGER set_recovery_code_updated_at_timestamp
+BEFORE UPDATE ON recovery_codes
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
+-- MFA challenges
+CREATE TABLE mfa_challenges (
+  id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  attempts   INTEGER NOT NULL DEFAULT 0,
+  expires_at TIMESTAMPTZ NOT NULL DEFAULT NOW() + INTERVAL '5 minutes',
+  created_at TIMESTAMPTZ DEFAULT NOW(),
+  updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX mfa_challenges_user_id_idx ON mfa_challenges (user_id);
+
+CREATE TRIGGER set_mfa_challenge_updated_at_timestamp
+BEFORE UPDATE ON mfa_challenges
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
+-- Email outbox
+CREATE TYPE email_status AS ENUM ('PENDING', 'SENT', 'DEAD');
+
+CREATE TABLE email_outbox (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  recipient       TEXT NOT NULL,
+  subject         TEXT NOT NULL,
+  body            TEXT NOT NULL,
+  status          email_status NOT NULL DEFAULT 'PENDING',
+  attempts        INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  last_error      TEXT,
+  sent_at         TIMESTAMPTZ,
+  created_at      TIMESTAMPTZ DEFAULT NOW(),
+  updated_at      TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX email_outbox_pending_idx ON email_outbox (next_attempt_at) WHERE status = 'PENDING';
+
+CREATE TRIGGER set_email_outbox_updated_at_timestamp
+BEFORE UPDATE ON email_outbox
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
+-- Job application events
+CREATE TABLE job_application_events (
+  id                 UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  field              TEXT NOT NULL,
+  old_value          TEXT,
+  new_value          TEXT,
+  created_at         TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp()
+);
+
+CREATE INDEX job_application_events_job_application_id_idx ON job_application_events (job_application_id, created_at);
+
+CREATE OR REPLACE FUNCTION record_job_application_events()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' THEN
+    INSERT INTO job_application_events (job_application_id, field, new_value)
+    VALUES (NEW.id, 'stage', NEW.stage_id::text);
+    RETURN NEW;
+  END IF;
+
+  INSERT INTO job_application_events (job_application_id, field, old_value, new_value)
+  SELECT NEW.id, changes.field, changes.old_value, changes.new_value
+  FROM (VALUES
+    ('company_name', OLD.company_name, NEW.company_name),
+    ('job_title', OLD.job_title, NEW.job_title),
+    ('date_applied', OLD.date_applied::text, NEW.date_applied::text),
+    ('stage', OLD.stage_id::text, NEW.stage_id::text),
+    ('is_replied', OLD.is_replied::text, NEW.is_replied::text),
+    ('min_salary', OLD.min_salary::text, NEW.min_salary::text),
+    ('max_salary', OLD.max_salary::text, NEW.max_salary::text),
+    ('salary_currency', OLD.salary_currency, NEW.salary_currency),
+    ('salary_period', OLD.salary_period::text, NEW.salary_period::text),
+    ('salary_type', OLD.salary_type::text, NEW.salary_type::text),
+    ('contract_type', OLD.contract_type::text, NEW.contract_type::text),
+    ('job_posting_url', OLD.job_posting_url, NEW.job_posting_url),
+    ('notes', OLD.notes, NEW.notes)
+  ) AS changes (field, old_value, new_value)
+  WHERE changes.old_value IS DISTINCT FROM changes.new_value;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_job_application_events
+AFTER INSERT OR UPDATE ON job_applications
+FOR EACH ROW
+EXECUTE FUNCTION record_job_application_events();
+
+CREATE OR REPLACE FUNCTION sync_job_application_company_name()
+RETURNS TRIGGER AS $$
+BEGIN
+  UPDATE job_applications SET company_name = NEW.name WHERE company_id = NEW.id;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER sync_job_application_company_name
+AFTER UPDATE OF name ON companies
+FOR EACH ROW
+WHEN (OLD.name IS DISTINCT FROM NEW.name)
+EXECUTE FUNCTION sync_job_application_company_name();
+
+-- Interviews
+CREATE TYPE interview_type AS ENUM ('PHONE', 'ONSITE', 'TECHNICAL', 'HR');
+
+CREATE TYPE interview_outcome AS ENUM ('PENDING', 'PASSED', 'FAILED', 'CANCELLED');
+
+CREATE TABLE interviews (
+  id                 UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  scheduled_at       TIMESTAMPTZ NOT NULL,
+  timezone           TEXT NOT NULL DEFAULT 'UTC',
+  duration           INTEGER CHECK (duration > 0), -- NOTE: Minutes
+  type               interview_type NOT NULL,
+  location           TEXT,
+  meeting_url        TEXT,
+  interviewers       TEXT[] NOT NULL DEFAULT '{}',
+  preparation_notes  TEXT,
+  outcome            interview_outcome NOT NULL DEFAULT 'PENDING',
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX interviews_job_application_id_idx ON interviews (job_application_id);
+CREATE INDEX interviews_scheduled_at_idx ON interviews (scheduled_at);
+
+CREATE TRIGGER set_interview_updated_at_timestamp
+BEFORE UPDATE ON interviews
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
+-- Contacts
+CREATE TABLE contacts (
+  id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id      UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name         TEXT NOT NULL,
+  email        TEXT,
+  phone        TEXT,
+  linkedin_url TEXT,
+  company      TEXT,
+  role         TEXT,
+  notes        TEXT,
+  created_at   TIMESTAMPTZ DEFAULT NOW(),
+  updated_at   TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX contacts_user_id_idx ON contacts (user_id);
+
+CREATE TRIGGER set_contact_updated_at_timestamp
+BEFORE UPDATE ON contacts
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
+CREATE TABLE job_application_contacts (
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  contact_id         UUID NOT NULL REFERENCES contacts(id) ON DELETE CASCADE,
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  PRIMARY KEY (job_application_id, contact_id)
+);
+
+CREATE INDEX job_application_contacts_contact_id_idx ON job_application_contacts (contact_id);
+
+-- Tags
+CREATE TABLE tags (
+  id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name       TEXT NOT NULL,
+  color      TEXT NOT NULL DEFAULT '#64748b',
+  created_at TIMESTAMPTZ DEFAULT NOW(),
+  updated_at TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT unique_tag_name UNIQUE (user_id, name)
+);
+
+CREATE TRIGGER set_tag_updated_at_timestamp
+BEFORE UPDATE ON tags
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
+CREATE TABLE job_application_tags (
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  tag_id             UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  PRIMARY KEY (job_application_id, tag_id)
+);
+
+CREATE INDEX job_application_tags_tag_id_idx ON job_application_tags (tag_id);
+
+-- Views
+CREATE TABLE views (
+  id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name       TEXT NOT NULL,
+  query      TEXT NOT NULL DEFAULT '', -- NOTE: Job applications list query string, e.g. outcome=NEUTRAL&is_replied=false
+  is_default BOOLEAN NOT NULL DEFAULT false,
+  created_at TIMESTAMPTZ DEFAULT NOW(),
+  updated_at TIMESTAMPTZ DEFAULT NOW(),
+  CONSTRAINT unique_view_name UNIQUE (user_id, name)
+);
+
+CREATE UNIQUE INDEX unique_default_view ON views (user_id) WHERE is_default;
+
+CREATE TRIGGER set_view_updated_at_timestamp
+BEFORE UPDATE ON views
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
+-- Reminders
+CREATE TYPE reminder_status AS ENUM ('PENDING', 'DELIVERED', 'DISMISSED');
+
+CREATE TABLE reminders (
+  id                 UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  remind_at          TIMESTAMPTZ NOT NULL,
+  note               TEXT,
+  unless_replied     BOOLEAN NOT NULL DEFAULT false, -- NOTE: Dismissed instead of delivered when the application got a reply in the meantime
+  is_suggested       BOOLEAN NOT NULL DEFAULT false, -- NOTE: Created by the scheduler rather than the user
+  status             reminder_status NOT NULL DEFAULT 'PENDING',
+  delivered_at       TIMESTAMPTZ,
+  created_at         TIMESTAMPTZ DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX reminders_job_application_id_idx ON reminders (job_application_id);
+CREATE INDEX reminders_pending_idx ON reminders (remind_at) WHERE status = 'PENDING';
+
+-- NOTE: At most one follow-up is ever suggested per application, even after it's been dismissed
+CREATE UNIQUE INDEX unique_suggested_reminder ON reminders (job_application_id) WHERE is_suggested;
+
+CREATE TRIGGER set_reminder_updated_at_timestamp
+BEFORE UPDATE ON reminders
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at_timestamp();
+
+-- Attachments
+CREATE TYPE attachment_kind AS ENUM ('CV', 'COVER_LETTER', 'OTHER');
+
+CREATE TABLE attachments (
+  id                 UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  job_application_id UUID NOT NULL REFERENCES job_applications(id) ON DELETE CASCADE,
+  kind               attachment_kind NOT NULL DEFAULT 'OTHER',
+  filename           TEXT NOT NULL,
+  content_type       TEXT NOT NULL,
+  size               BIGINT NOT NULL CHECK (size >= 0), -- NOTE: Bytes
+  checksum           TEXT NOT NULL, -- NOTE: Hex-encoded SHA-256 of the contents, shared by every copy of the same file
+  storage_key        TEXT NOT NULL UNIQUE,
+  created_at         TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX attachments_job_application_id_idx ON attachments (job_application_id);
+CREATE INDEX attachments_checksum_idx ON attachments (checksum);
+
+-- Idempotency keys
+CREATE TABLE idempotency_keys (
+  id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id      UUID REFERENCES users(id) ON DELETE CASCADE, -- NOTE: NULL for requests made before signing in, e.g. sign-up
+  key          TEXT NOT NULL,
+  request_hash TEXT NOT NULL, -- NOTE: Hex-encoded HMAC-SHA256 of the method, path and body, since the body may contain a password
+  status       INTEGER, -- NOTE: NULL while the original request is still being handled
+  response     BYTEA,
+  created_at   TIMESTAMPTZ DEFAULT NOW(),
+  expires_at   TIMESTAMPTZ NOT NULL,
+  CONSTRAINT unique_idempotency_key UNIQUE NULLS NOT DISTINCT (user_id, key)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
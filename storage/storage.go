@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Storage keeps the contents of attachments under keys chosen by the caller. Objects are never overwritten, only created and deleted.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// NOTE: Returns ErrNotFound for keys that don't exist
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// NOTE: Deleting a key that doesn't exist isn't an error
+	Delete(ctx context.Context, key string) error
+}
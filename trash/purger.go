@@ -0,0 +1,68 @@
+package trash
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jakub-szewczyk/career-compass-gin/sqlc/db"
+	"github.com/jakub-szewczyk/career-compass-gin/storage"
+)
+
+const (
+	RetentionDays  = 30
+	purgerInterval = time.Hour
+)
+
+// Purger permanently deletes job applications that have been in the trash for longer than the retention period,
+// along with everything attached to them, including the contents of attachments kept in the storage.
+type Purger struct {
+	queries       *db.Queries
+	storage       storage.Storage
+	retentionDays int
+}
+
+func NewPurger(queries *db.Queries, storage storage.Storage, retentionDays int) *Purger {
+	return &Purger{
+		queries:       queries,
+		storage:       storage,
+		retentionDays: retentionDays,
+	}
+}
+
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(purgerInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Flush(ctx); err != nil {
+			log.Println("error purging trash:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush purges every job application whose retention period is over
+func (p *Purger) Flush(ctx context.Context) error {
+	deletedBefore := time.Now().AddDate(0, 0, -p.retentionDays)
+
+	storageKeys, err := p.queries.PurgeJobApplications(ctx, pgtype.Timestamptz{Time: deletedBefore, Valid: true})
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The rows are already gone, so a failure only leaves an orphaned object behind and the rest are still deleted
+	for _, key := range storageKeys {
+		if err := p.storage.Delete(ctx, key); err != nil {
+			log.Println("error deleting attachment contents:", err)
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,12 @@
 package utils
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -12,9 +17,100 @@ func ToUUID(s string) (pgtype.UUID, error) {
 	return uuid, err
 }
 
-func NullifyTime(date time.Time) interface{} {
-	if date.IsZero() {
-		return nil
+// ToPrefixTSQuery turns free text into a tsquery matching documents with words starting with every given word.
+// Anything but letters and digits is dropped, so that the result is always valid tsquery syntax.
+func ToPrefixTSQuery(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r)
+	})
+
+	for i, word := range words {
+		words[i] = strings.ToLower(word) + ":*"
+	}
+
+	return strings.Join(words, " & ")
+}
+
+// MatchETag reports whether an If-Match or If-None-Match header lists the given ETag, or is "*".
+// If-None-Match uses the weak comparison, which ignores the W/ prefix, while If-Match uses the strong one, where weak ETags never match.
+func MatchETag(header, etag string, weak bool) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+
+		if candidate == "*" {
+			return true
+		}
+
+		if weak {
+			candidate = strings.TrimPrefix(candidate, "W/")
+			etag = strings.TrimPrefix(etag, "W/")
+		}
+
+		if candidate == etag && !strings.HasPrefix(candidate, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ParseExchangeRates reads comma-separated CODE=RATE pairs, e.g. "EUR=1,PLN=4.25,USD=1.08", where each rate is the number of units of the currency per euro
+func ParseExchangeRates(s string) (map[string]float64, error) {
+	rates := map[string]float64{}
+
+	for _, pair := range strings.Split(s, ",") {
+		code, value, ok := strings.Cut(pair, "=")
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if !ok || !currencyCode.MatchString(code) {
+			return nil, fmt.Errorf("invalid exchange rate %q", pair)
+		}
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid exchange rate %q", pair)
+		}
+
+		rates[code] = rate
+	}
+
+	return rates, nil
+}
+
+// FormatDate spells a date out in the order the given BCP 47 locale puts its parts in, e.g. "March 14, 2025" for en-US and "14 March 2025" for en-GB or pl-PL.
+// Month names stay in English, the language every email is written in.
+func FormatDate(date time.Time, locale string) string {
+	subtags := strings.Split(locale, "-")
+
+	region := ""
+	for _, subtag := range subtags[1:] {
+		if len(subtag) == 2 {
+			region = strings.ToUpper(subtag)
+			break
+		}
+	}
+
+	// NOTE: Plain "en" is taken as American English, like most software does
+	if region == "US" || (region == "" && strings.EqualFold(subtags[0], "en")) {
+		return date.Format("January 2, 2006")
 	}
-	return date
+
+	return date.Format("2 January 2006")
+}
+
+// NOTE: Languages writing decimals with a point, every other one is taken to use a comma, like most of continental Europe does
+var decimalPointLanguages = []string{"en", "ga", "he", "hi", "ja", "ko", "ms", "mt", "th", "zh"}
+
+// DecimalSeparator tells the character the given BCP 47 locale separates decimals with, either '.' or ','
+func DecimalSeparator(locale string) byte {
+	language, _, _ := strings.Cut(locale, "-")
+
+	for _, decimalPointLanguage := range decimalPointLanguages {
+		if strings.EqualFold(language, decimalPointLanguage) {
+			return '.'
+		}
+	}
+
+	return ','
 }